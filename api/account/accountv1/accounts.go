@@ -33,6 +33,10 @@ type Accounts interface {
 	InviteAccountUser(accountGuid string, userEmail string) (AccountInviteResponse, error)
 	DeleteAccountUser(accountGuid string, userGuid string) error
 	FindAccountUserByUserId(accountGuid string, userId string) (*AccountUser, error)
+	GetAccountLinkages(accountGuid string) ([]AccountLinkage, error)
+	GetIMSAccountID(accountGuid string) (string, error)
+	GetSubscriptions(accountGuid string) ([]Subscription, error)
+	GetEntitlements(accountGuid string) ([]Entitlement, error)
 }
 
 type account struct {
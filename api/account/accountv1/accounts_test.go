@@ -8,6 +8,7 @@ import (
 
 	bluemix "github.com/IBM-Cloud/bluemix-go"
 	"github.com/IBM-Cloud/bluemix-go/client"
+	"github.com/IBM-Cloud/bluemix-go/helpers"
 	bluemixHttp "github.com/IBM-Cloud/bluemix-go/http"
 	"github.com/IBM-Cloud/bluemix-go/session"
 
@@ -88,6 +89,8 @@ func newAccounts(url string) Accounts {
 	conf := sess.Config.Copy()
 	conf.HTTPClient = bluemixHttp.NewHTTPClient(conf)
 	conf.Endpoint = &url
+	conf.MaxRetries = helpers.Int(0)
+	conf.RetryPolicy = nil
 
 	client := client.Client{
 		Config:      conf,
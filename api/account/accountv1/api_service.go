@@ -14,6 +14,7 @@ import (
 //AccountServiceAPI is the accountv2 client ...
 type AccountServiceAPI interface {
 	Accounts() Accounts
+	ClassicAPIKeys() ClassicAPIKeys
 }
 
 //ErrCodeNoAccountExists ...
@@ -66,3 +67,8 @@ func New(sess *session.Session) (AccountServiceAPI, error) {
 func (a *accountService) Accounts() Accounts {
 	return newAccountAPI(a.Client)
 }
+
+//ClassicAPIKeys API
+func (a *accountService) ClassicAPIKeys() ClassicAPIKeys {
+	return newClassicAPIKeysAPI(a.Client, IMSEndpoint)
+}
@@ -0,0 +1,107 @@
+package accountv1
+
+import (
+	"fmt"
+
+	"github.com/IBM-Cloud/bluemix-go/client"
+	"github.com/IBM-Cloud/bluemix-go/helpers"
+	"github.com/IBM-Cloud/bluemix-go/rest"
+)
+
+//IMSEndpoint is the classic infrastructure (IMS) API endpoint
+const IMSEndpoint = "https://api.softlayer.com/rest/v3.1"
+
+//ClassicUser is a classic infrastructure user
+type ClassicUser struct {
+	ID        int    `json:"id"`
+	Username  string `json:"username"`
+	AccountID int    `json:"accountId"`
+}
+
+//ClassicAPIKey is a classic infrastructure API key
+type ClassicAPIKey struct {
+	ID                int    `json:"id"`
+	AuthenticationKey string `json:"authenticationKey"`
+	UserID            int    `json:"userId"`
+}
+
+//ClassicAPIKeys manages the classic infrastructure API keys used to set the
+//infrastructure credentials of classic clusters
+type ClassicAPIKeys interface {
+	GetCurrentUser() (ClassicUser, error)
+	ListAPIKeys(userID int) ([]ClassicAPIKey, error)
+	CreateAPIKey(userID int) (string, error)
+	DeleteAPIKey(userID, keyID int) error
+	RotateAPIKey(userID int) (string, error)
+}
+
+type classicAPIKeys struct {
+	client   *client.Client
+	endpoint string
+}
+
+func newClassicAPIKeysAPI(c *client.Client, endpoint string) ClassicAPIKeys {
+	return &classicAPIKeys{
+		client:   c,
+		endpoint: endpoint,
+	}
+}
+
+//GetCurrentUser returns the classic infrastructure user of the caller
+func (r *classicAPIKeys) GetCurrentUser() (ClassicUser, error) {
+	user := ClassicUser{}
+	req := rest.GetRequest(helpers.GetFullURL(r.endpoint, "/SoftLayer_Account/getCurrentUser.json"))
+	_, err := r.client.SendRequest(req, &user)
+	return user, err
+}
+
+//ListAPIKeys returns the API keys of a classic infrastructure user
+func (r *classicAPIKeys) ListAPIKeys(userID int) ([]ClassicAPIKey, error) {
+	keys := []ClassicAPIKey{}
+	req := rest.GetRequest(helpers.GetFullURL(r.endpoint, fmt.Sprintf("/SoftLayer_User_Customer/%d/getApiAuthenticationKeys.json", userID)))
+	_, err := r.client.SendRequest(req, &keys)
+	return keys, err
+}
+
+//CreateAPIKey generates a new API key for a classic infrastructure user. The
+//request is a GET but it is not retried, a retry could create a second key.
+func (r *classicAPIKeys) CreateAPIKey(userID int) (string, error) {
+	var key string
+	req := rest.GetRequest(helpers.GetFullURL(r.endpoint, fmt.Sprintf("/SoftLayer_User_Customer/%d/addApiAuthenticationKey.json", userID))).NoRetry()
+	_, err := r.client.SendRequest(req, &key)
+	return key, err
+}
+
+//DeleteAPIKey removes an API key of a classic infrastructure user
+func (r *classicAPIKeys) DeleteAPIKey(userID, keyID int) error {
+	payload := struct {
+		Parameters []int `json:"parameters"`
+	}{
+		Parameters: []int{keyID},
+	}
+	req := rest.PostRequest(helpers.GetFullURL(r.endpoint, fmt.Sprintf("/SoftLayer_User_Customer/%d/removeApiAuthenticationKey.json", userID))).Body(payload).NoRetry()
+	_, err := r.client.SendRequest(req, nil)
+	return err
+}
+
+//RotateAPIKey generates a new API key for a classic infrastructure user and
+//then removes the keys the user had before. The existing keys are left in
+//place when the new key can not be created, so that the infrastructure
+//credentials of classic clusters keep working. When removing an old key fails
+//the new key is returned along with the error.
+func (r *classicAPIKeys) RotateAPIKey(userID int) (string, error) {
+	keys, err := r.ListAPIKeys(userID)
+	if err != nil {
+		return "", err
+	}
+	newKey, err := r.CreateAPIKey(userID)
+	if err != nil {
+		return "", err
+	}
+	for _, k := range keys {
+		if err := r.DeleteAPIKey(userID, k.ID); err != nil {
+			return newKey, err
+		}
+	}
+	return newKey, nil
+}
@@ -0,0 +1,171 @@
+package accountv1
+
+import (
+	"log"
+	"net/http"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/client"
+	"github.com/IBM-Cloud/bluemix-go/helpers"
+	bluemixHttp "github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/session"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Classic infrastructure linkage", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("GetIMSAccountID", func() {
+		Context("When the account is linked", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v1/accounts/acc1/linkages"),
+						ghttp.RespondWith(http.StatusOK, `[{"origin": "IMS", "state": "COMPLETE", "id": "123456"}]`),
+					),
+				)
+			})
+
+			It("should return the IMS account ID", func() {
+				id, err := newAccounts(server.URL()).GetIMSAccountID("acc1")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(id).To(Equal("123456"))
+			})
+		})
+		Context("When the account is not linked", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v1/accounts/acc1/linkages"),
+						ghttp.RespondWith(http.StatusOK, `[]`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				_, err := newAccounts(server.URL()).GetIMSAccountID("acc1")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("RotateAPIKey", func() {
+		Context("When the user has an API key", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/SoftLayer_User_Customer/42/getApiAuthenticationKeys.json"),
+						ghttp.RespondWith(http.StatusOK, `[{"id": 7, "authenticationKey": "old", "userId": 42}]`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/SoftLayer_User_Customer/42/addApiAuthenticationKey.json"),
+						ghttp.RespondWith(http.StatusOK, `"new"`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/SoftLayer_User_Customer/42/removeApiAuthenticationKey.json"),
+						ghttp.VerifyJSON(`{"parameters": [7]}`),
+						ghttp.RespondWith(http.StatusOK, `true`),
+					),
+				)
+			})
+
+			It("should replace the API key", func() {
+				key, err := newClassicAPIKeys(server.URL()).RotateAPIKey(42)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(key).To(Equal("new"))
+			})
+		})
+		Context("When the new API key cannot be created", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/SoftLayer_User_Customer/42/getApiAuthenticationKeys.json"),
+						ghttp.RespondWith(http.StatusOK, `[{"id": 7, "authenticationKey": "old", "userId": 42}]`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/SoftLayer_User_Customer/42/addApiAuthenticationKey.json"),
+						ghttp.RespondWith(http.StatusInternalServerError, `{"error": "Failed to add key", "code": "SoftLayer_Exception"}`),
+					),
+				)
+			})
+
+			It("should keep the existing API key", func() {
+				_, err := newClassicAPIKeys(server.URL()).RotateAPIKey(42)
+				Expect(err).To(HaveOccurred())
+				Expect(server.ReceivedRequests()).To(HaveLen(2))
+			})
+		})
+		Context("When an old API key cannot be removed", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/SoftLayer_User_Customer/42/getApiAuthenticationKeys.json"),
+						ghttp.RespondWith(http.StatusOK, `[{"id": 7, "authenticationKey": "old", "userId": 42}]`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/SoftLayer_User_Customer/42/addApiAuthenticationKey.json"),
+						ghttp.RespondWith(http.StatusOK, `"new"`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/SoftLayer_User_Customer/42/removeApiAuthenticationKey.json"),
+						ghttp.RespondWith(http.StatusInternalServerError, `Failed to remove key`),
+					),
+				)
+			})
+
+			It("should return the new API key with the error", func() {
+				key, err := newClassicAPIKeys(server.URL()).RotateAPIKey(42)
+				Expect(err).To(HaveOccurred())
+				Expect(key).To(Equal("new"))
+			})
+		})
+		Context("When the API keys cannot be listed", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.SetAllowUnhandledRequests(true)
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/SoftLayer_User_Customer/42/getApiAuthenticationKeys.json"),
+						ghttp.RespondWith(http.StatusInternalServerError, `Failed to list keys`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				_, err := newClassicAPIKeys(server.URL()).RotateAPIKey(42)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
+
+func newClassicAPIKeys(url string) ClassicAPIKeys {
+
+	sess, err := session.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+	conf := sess.Config.Copy()
+	conf.HTTPClient = bluemixHttp.NewHTTPClient(conf)
+	conf.Endpoint = &url
+	conf.MaxRetries = helpers.Int(0)
+	conf.RetryPolicy = nil
+
+	client := client.Client{
+		Config:      conf,
+		ServiceName: bluemix.AccountServicev1,
+	}
+
+	return newClassicAPIKeysAPI(&client, url)
+}
@@ -0,0 +1,44 @@
+package accountv1
+
+import (
+	"fmt"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+)
+
+const (
+	//LinkageOriginIMS is the origin of a linkage to a classic infrastructure account
+	LinkageOriginIMS = "IMS"
+
+	//ErrCodeNoLinkageExists ...
+	ErrCodeNoLinkageExists = "NoLinkageExists"
+)
+
+//AccountLinkage links an IBM Cloud account to an account of another origin
+type AccountLinkage struct {
+	Origin string `json:"origin"`
+	State  string `json:"state"`
+	ID     string `json:"id"`
+}
+
+//GetAccountLinkages returns the linkages of an account
+func (a *account) GetAccountLinkages(accountGuid string) ([]AccountLinkage, error) {
+	linkages := []AccountLinkage{}
+	_, err := a.client.Get(fmt.Sprintf("/v1/accounts/%s/linkages", accountGuid), &linkages)
+	return linkages, err
+}
+
+//GetIMSAccountID returns the ID of the classic infrastructure account linked to an account
+func (a *account) GetIMSAccountID(accountGuid string) (string, error) {
+	linkages, err := a.GetAccountLinkages(accountGuid)
+	if err != nil {
+		return "", err
+	}
+	for _, l := range linkages {
+		if l.Origin == LinkageOriginIMS {
+			return l.ID, nil
+		}
+	}
+	return "", bmxerror.New(ErrCodeNoLinkageExists,
+		fmt.Sprintf("Account %q is not linked to a classic infrastructure account", accountGuid))
+}
@@ -0,0 +1,105 @@
+package accountv1
+
+import (
+	"fmt"
+)
+
+//SubscriptionTerm is one committed-use period of a subscription
+type SubscriptionTerm struct {
+	StartDate string  `json:"start_date"`
+	EndDate   string  `json:"end_date"`
+	Amount    float64 `json:"amount"`
+	Used      float64 `json:"used"`
+	Overage   float64 `json:"overage"`
+}
+
+//Remaining returns the part of the committed amount not consumed yet
+func (t SubscriptionTerm) Remaining() float64 {
+	if t.Used >= t.Amount {
+		return 0
+	}
+	return t.Amount - t.Used
+}
+
+//Subscription ...
+type Subscription struct {
+	ID             string             `json:"subscription_id"`
+	Type           string             `json:"type"`
+	State          string             `json:"state"`
+	PartNumber     string             `json:"part_number"`
+	Quantity       int                `json:"quantity"`
+	BillingUnitID  string             `json:"billing_unit_id"`
+	Currency       string             `json:"currency_code"`
+	StartDate      string             `json:"start_date"`
+	EndDate        string             `json:"end_date"`
+	Amount         float64            `json:"amount"`
+	Used           float64            `json:"used"`
+	Terms          []SubscriptionTerm `json:"terms"`
+	CreatedAt      string             `json:"created_at"`
+	LastModifiedAt string             `json:"last_modified_at"`
+}
+
+//Remaining returns the part of the committed amount not consumed yet
+func (s Subscription) Remaining() float64 {
+	if s.Used >= s.Amount {
+		return 0
+	}
+	return s.Amount - s.Used
+}
+
+//BurnDown returns the consumed percentage of the committed amount
+func (s Subscription) BurnDown() float64 {
+	if s.Amount == 0 {
+		return 0
+	}
+	return s.Used / s.Amount * 100
+}
+
+//Entitlement is a credit or promotion applied to an account
+type Entitlement struct {
+	ID          string  `json:"id"`
+	Type        string  `json:"type"`
+	Description string  `json:"description"`
+	State       string  `json:"state"`
+	Currency    string  `json:"currency_code"`
+	Amount      float64 `json:"amount"`
+	Balance     float64 `json:"balance"`
+	StartDate   string  `json:"start_date"`
+	ExpiryDate  string  `json:"expiry_date"`
+}
+
+//Used returns the consumed part of the entitlement
+func (e Entitlement) Used() float64 {
+	if e.Balance >= e.Amount {
+		return 0
+	}
+	return e.Amount - e.Balance
+}
+
+type subscriptionList struct {
+	Resources []Subscription `json:"resources"`
+}
+
+type entitlementList struct {
+	Resources []Entitlement `json:"resources"`
+}
+
+//GetSubscriptions returns the subscriptions of an account with their consumption
+func (a *account) GetSubscriptions(accountGuid string) ([]Subscription, error) {
+	res := subscriptionList{}
+	_, err := a.client.Get(fmt.Sprintf("/v1/accounts/%s/subscriptions", accountGuid), &res)
+	if err != nil {
+		return []Subscription{}, err
+	}
+	return res.Resources, nil
+}
+
+//GetEntitlements returns the credits and promotions of an account with their balance
+func (a *account) GetEntitlements(accountGuid string) ([]Entitlement, error) {
+	res := entitlementList{}
+	_, err := a.client.Get(fmt.Sprintf("/v1/accounts/%s/entitlements", accountGuid), &res)
+	if err != nil {
+		return []Entitlement{}, err
+	}
+	return res.Resources, nil
+}
@@ -0,0 +1,96 @@
+package accountv1
+
+import (
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Subscriptions", func() {
+	accountGuid := "9a0d1cdd086428060e43b333decd27dd"
+
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("Get account subscriptions", func() {
+		Context("Server returns subscriptions", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet,
+							fmt.Sprintf("/v1/accounts/%s/subscriptions", accountGuid)),
+						ghttp.RespondWith(http.StatusOK, `{
+							"resources": [{
+								"subscription_id": "sub-1",
+								"type": "SUBSCRIPTION",
+								"state": "ACTIVE",
+								"currency_code": "USD",
+								"amount": 12000,
+								"used": 3000,
+								"terms": [{"start_date": "2021-01-01", "end_date": "2021-12-31", "amount": 12000, "used": 3000}]
+							}]
+						}`),
+					),
+				)
+			})
+
+			It("Should return the subscriptions with their consumption", func() {
+				subs, err := newAccounts(server.URL()).GetSubscriptions(accountGuid)
+				Expect(err).To(Succeed())
+				Expect(subs).To(HaveLen(1))
+				Expect(subs[0].ID).To(Equal("sub-1"))
+				Expect(subs[0].Remaining()).To(Equal(9000.0))
+				Expect(subs[0].BurnDown()).To(Equal(25.0))
+				Expect(subs[0].Terms[0].Remaining()).To(Equal(9000.0))
+			})
+		})
+	})
+
+	Describe("Get account entitlements", func() {
+		Context("Server returns entitlements", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet,
+							fmt.Sprintf("/v1/accounts/%s/entitlements", accountGuid)),
+						ghttp.RespondWith(http.StatusOK, `{
+							"resources": [{"id": "promo-1", "type": "PROMOTION", "state": "ACTIVE", "amount": 200, "balance": 150}]
+						}`),
+					),
+				)
+			})
+
+			It("Should return the entitlements", func() {
+				entitlements, err := newAccounts(server.URL()).GetEntitlements(accountGuid)
+				Expect(err).To(Succeed())
+				Expect(entitlements).To(HaveLen(1))
+				Expect(entitlements[0].Used()).To(Equal(50.0))
+			})
+		})
+
+		Context("Server returns error", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet,
+							fmt.Sprintf("/v1/accounts/%s/entitlements", accountGuid)),
+						ghttp.RespondWith(http.StatusForbidden, `{"message": "not authorized"}`),
+					),
+				)
+			})
+
+			It("Should return error", func() {
+				_, err := newAccounts(server.URL()).GetEntitlements(accountGuid)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package activitytrackerv1
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestActivitytrackerv1(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Activitytrackerv1 Suite")
+}
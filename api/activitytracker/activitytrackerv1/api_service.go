@@ -0,0 +1,55 @@
+package activitytrackerv1
+
+import (
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/bluemix-go/client"
+	"github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/session"
+)
+
+//ActivityTrackerServiceAPI is the Activity Tracker client ...
+type ActivityTrackerServiceAPI interface {
+	Events() Events
+}
+
+//ErrCodeAPICreation ...
+const ErrCodeAPICreation = "APICreationError"
+
+//atService holds the client
+type atService struct {
+	*client.Client
+	serviceKey string
+}
+
+//New returns a client for the Activity Tracker instance owning the service key.
+//The instance API authenticates with the service key only, no IAM token is requested.
+func New(sess *session.Session, serviceKey string) (ActivityTrackerServiceAPI, error) {
+	config := sess.Config.Copy()
+	if serviceKey == "" {
+		return nil, bmxerror.New(ErrCodeAPICreation, "A service key of the Activity Tracker instance is required")
+	}
+	if config.Region == "" && (config.Endpoint == nil || *config.Endpoint == "") {
+		return nil, bmxerror.New(bluemix.ErrInvalidConfigurationCode, "Please provide region or endpoint")
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.NewHTTPClient(config)
+	}
+	if config.Endpoint == nil {
+		ep, err := config.EndpointLocator.ActivityTrackerEndpoint()
+		if err != nil {
+			return nil, err
+		}
+		config.Endpoint = &ep
+	}
+
+	return &atService{
+		Client:     client.New(config, bluemix.ActivityTrackerService, nil),
+		serviceKey: serviceKey,
+	}, nil
+}
+
+//Events implements the event export API
+func (c *atService) Events() Events {
+	return newEventsAPI(c.Client, c.serviceKey)
+}
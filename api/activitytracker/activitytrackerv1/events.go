@@ -0,0 +1,146 @@
+package activitytrackerv1
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/bluemix-go/client"
+)
+
+const (
+	serviceKeyHeader = "servicekey"
+
+	//DefaultEventPageSize is the number of events fetched per export request
+	DefaultEventPageSize = 1000
+)
+
+//EventQuery filters the events of the instance. From and To bound the time
+//window, the other filters are optional.
+type EventQuery struct {
+	From time.Time
+	To   time.Time
+	//TargetCRN matches the CRN of the resource the action was performed on
+	TargetCRN string
+	//Action matches the event action, e.g. containers-kubernetes.cluster.delete
+	Action string
+	//Query is an additional search expression combined with the filters
+	Query string
+	//Limit stops the search after that many events, 0 returns all of them
+	Limit    int
+	PageSize int
+}
+
+//EventInitiator is the identity that performed the action
+type EventInitiator struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	TypeURI string `json:"typeURI"`
+	Host    struct {
+		Address string `json:"address"`
+		Agent   string `json:"agent"`
+	} `json:"host"`
+}
+
+//EventTarget is the resource the action was performed on
+type EventTarget struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	TypeURI string `json:"typeURI"`
+}
+
+//EventReason ...
+type EventReason struct {
+	ReasonCode int    `json:"reasonCode"`
+	ReasonType string `json:"reasonType"`
+}
+
+//Event is an audit event
+type Event struct {
+	Timestamp     int64                  `json:"_ts"`
+	Line          string                 `json:"_line"`
+	Action        string                 `json:"action"`
+	Outcome       string                 `json:"outcome"`
+	Severity      string                 `json:"severity"`
+	Message       string                 `json:"message"`
+	EventTime     string                 `json:"eventTime"`
+	CorrelationID string                 `json:"correlationId"`
+	LogSourceCRN  string                 `json:"logSourceCRN"`
+	Initiator     EventInitiator         `json:"initiator"`
+	Target        EventTarget            `json:"target"`
+	Reason        EventReason            `json:"reason"`
+	RequestData   map[string]interface{} `json:"requestData,omitempty"`
+	ResponseData  map[string]interface{} `json:"responseData,omitempty"`
+}
+
+type eventPage struct {
+	Lines        []Event `json:"lines"`
+	PaginationID *string `json:"pagination_id"`
+}
+
+//Events ...
+type Events interface {
+	QueryEvents(query EventQuery) ([]Event, error)
+}
+
+type events struct {
+	client     *client.Client
+	serviceKey string
+}
+
+func newEventsAPI(c *client.Client, serviceKey string) Events {
+	return &events{
+		client:     c,
+		serviceKey: serviceKey,
+	}
+}
+
+//QueryEvents returns the events of the time window matching the query, oldest first
+func (r *events) QueryEvents(query EventQuery) ([]Event, error) {
+	pageSize := query.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultEventPageSize
+	}
+	params := url.Values{}
+	params.Set("from", strconv.FormatInt(query.From.Unix(), 10))
+	params.Set("to", strconv.FormatInt(query.To.Unix(), 10))
+	params.Set("size", strconv.Itoa(pageSize))
+	params.Set("prefer", "head")
+	if q := query.search(); q != "" {
+		params.Set("query", q)
+	}
+	header := map[string]string{serviceKeyHeader: r.serviceKey}
+
+	all := []Event{}
+	for {
+		var page eventPage
+		_, err := r.client.Get(fmt.Sprintf("/v2/export?%s", params.Encode()), &page, header)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page.Lines...)
+		if query.Limit > 0 && len(all) >= query.Limit {
+			return all[:query.Limit], nil
+		}
+		if page.PaginationID == nil || *page.PaginationID == "" || len(page.Lines) == 0 {
+			return all, nil
+		}
+		params.Set("pagination_id", *page.PaginationID)
+	}
+}
+
+func (q EventQuery) search() string {
+	var terms []string
+	if q.TargetCRN != "" {
+		terms = append(terms, fmt.Sprintf("target.id:%q", q.TargetCRN))
+	}
+	if q.Action != "" {
+		terms = append(terms, fmt.Sprintf("action:%q", q.Action))
+	}
+	if q.Query != "" {
+		terms = append(terms, q.Query)
+	}
+	return strings.Join(terms, " AND ")
+}
@@ -0,0 +1,121 @@
+package activitytrackerv1
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/client"
+	bluemixHttp "github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/session"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Events", func() {
+	var server *ghttp.Server
+	from := time.Unix(1600000000, 0)
+	to := time.Unix(1600003600, 0)
+	crn := "crn:v1:bluemix:public:containers-kubernetes:us-south:a/acc:c1::"
+
+	AfterEach(func() {
+		server.Close()
+	})
+	Describe("QueryEvents", func() {
+		Context("When the events span two pages", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/export"),
+						ghttp.VerifyHeaderKV("servicekey", "key1"),
+						ghttp.VerifyForm(map[string][]string{
+							"from":   {"1600000000"},
+							"to":     {"1600003600"},
+							"size":   {"2"},
+							"prefer": {"head"},
+							"query":  {`target.id:"` + crn + `" AND action:"containers-kubernetes.cluster.delete"`},
+						}),
+						ghttp.RespondWith(http.StatusOK, `{"pagination_id": "p2", "lines": [
+							{"_ts": 1600000100000, "action": "containers-kubernetes.cluster.delete", "outcome": "success", "initiator": {"id": "IBMid-1", "name": "jane@example.com"}, "target": {"id": "`+crn+`"}},
+							{"_ts": 1600000200000, "action": "containers-kubernetes.cluster.delete", "outcome": "failure", "reason": {"reasonCode": 409}}]}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/export"),
+						ghttp.VerifyForm(map[string][]string{"pagination_id": {"p2"}}),
+						ghttp.RespondWith(http.StatusOK, `{"pagination_id": null, "lines": [{"_ts": 1600000300000, "action": "containers-kubernetes.cluster.delete", "outcome": "success"}]}`),
+					),
+				)
+			})
+
+			It("should return the events of all the pages", func() {
+				events, err := newEvents(server.URL()).QueryEvents(EventQuery{
+					From:      from,
+					To:        to,
+					TargetCRN: crn,
+					Action:    "containers-kubernetes.cluster.delete",
+					PageSize:  2,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(events).Should(HaveLen(3))
+				Expect(events[0].Initiator.Name).Should(Equal("jane@example.com"))
+				Expect(events[0].Target.ID).Should(Equal(crn))
+				Expect(events[1].Reason.ReasonCode).Should(Equal(409))
+			})
+		})
+		Context("When a limit is set", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/export"),
+						ghttp.RespondWith(http.StatusOK, `{"pagination_id": "p2", "lines": [{"action": "a1"}, {"action": "a2"}]}`),
+					),
+				)
+			})
+
+			It("should stop at the limit", func() {
+				events, err := newEvents(server.URL()).QueryEvents(EventQuery{From: from, To: to, Limit: 1})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(events).Should(HaveLen(1))
+				Expect(events[0].Action).Should(Equal("a1"))
+			})
+		})
+		Context("When the service key is rejected", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/export"),
+						ghttp.RespondWith(http.StatusUnauthorized, `{"error": "Unauthorized"}`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				_, err := newEvents(server.URL()).QueryEvents(EventQuery{From: from, To: to})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
+
+func newEvents(url string) Events {
+	sess, err := session.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+	conf := sess.Config.Copy()
+	conf.HTTPClient = bluemixHttp.NewHTTPClient(conf)
+	conf.Endpoint = &url
+
+	c := &client.Client{
+		Config:      conf,
+		ServiceName: bluemix.ActivityTrackerService,
+	}
+	return newEventsAPI(c, "key1")
+}
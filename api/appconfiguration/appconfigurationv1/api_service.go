@@ -0,0 +1,75 @@
+package appconfigurationv1
+
+import (
+	gohttp "net/http"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/authentication"
+	"github.com/IBM-Cloud/bluemix-go/client"
+	"github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/rest"
+	"github.com/IBM-Cloud/bluemix-go/session"
+)
+
+//AppConfigurationServiceAPI is the App Configuration client ...
+type AppConfigurationServiceAPI interface {
+	Features() Features
+	Segments() Segments
+}
+
+//ErrCodeAPICreation ...
+const ErrCodeAPICreation = "APICreationError"
+
+//acService holds the client
+type acService struct {
+	*client.Client
+}
+
+//New ...
+func New(sess *session.Session) (AppConfigurationServiceAPI, error) {
+	config := sess.Config.Copy()
+	err := config.ValidateConfigForService(bluemix.AppConfigurationService)
+	if err != nil {
+		return nil, err
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.NewHTTPClient(config)
+	}
+	tokenRefreher, err := authentication.NewIAMAuthRepository(config, &rest.Client{
+		DefaultHeader: gohttp.Header{
+			"X-Original-User-Agent": []string{config.UserAgent},
+			"User-Agent":            []string{http.UserAgent()},
+		},
+		HTTPClient: config.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if config.IAMAccessToken == "" {
+		err := authentication.PopulateTokens(tokenRefreher, config)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if config.Endpoint == nil {
+		ep, err := config.EndpointLocator.AppConfigurationEndpoint()
+		if err != nil {
+			return nil, err
+		}
+		config.Endpoint = &ep
+	}
+
+	return &acService{
+		Client: client.New(config, bluemix.AppConfigurationService, tokenRefreher),
+	}, nil
+}
+
+//Features implements the feature flags API
+func (c *acService) Features() Features {
+	return newFeaturesAPI(c.Client)
+}
+
+//Segments implements the segments API
+func (c *acService) Segments() Segments {
+	return newSegmentsAPI(c.Client)
+}
@@ -0,0 +1,13 @@
+package appconfigurationv1
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestAppconfigurationv1(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Appconfigurationv1 Suite")
+}
@@ -0,0 +1,228 @@
+package appconfigurationv1
+
+import (
+	"fmt"
+	"math/bits"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//Entity is the user or device a feature is evaluated for
+type Entity struct {
+	ID         string
+	Attributes map[string]interface{}
+}
+
+//SegmentIndex maps the segment IDs to the segments, as returned by ListSegments
+type SegmentIndex map[string]Segment
+
+//NewSegmentIndex ...
+func NewSegmentIndex(segments []Segment) SegmentIndex {
+	index := make(SegmentIndex, len(segments))
+	for _, s := range segments {
+		index[s.SegmentID] = s
+	}
+	return index
+}
+
+//Evaluate returns the value of the feature for the entity. The segment rules
+//are checked in order and the first one targeting a segment of the entity
+//wins; entities outside of its rollout percentage get the disabled value.
+//When no segment rule applies the feature rollout percentage decides
+//between the enabled and the disabled value.
+func (index SegmentIndex) Evaluate(feature Feature, entity Entity) interface{} {
+	if !feature.Enabled {
+		return feature.DisabledValue
+	}
+
+	rules := make([]SegmentRule, len(feature.SegmentRules))
+	copy(rules, feature.SegmentRules)
+	sort.SliceStable(rules, func(i, j int) bool { return rules[i].Order < rules[j].Order })
+
+	for _, rule := range rules {
+		if !index.matchesAny(rule, entity.Attributes) {
+			continue
+		}
+		if !inRollout(rule.rollout(feature), entity.ID, feature.FeatureID) {
+			return feature.DisabledValue
+		}
+		if s, ok := rule.Value.(string); ok && s == DefaultValue {
+			return feature.EnabledValue
+		}
+		return rule.Value
+	}
+
+	if inRollout(feature.RolloutPercentage, entity.ID, feature.FeatureID) {
+		return feature.EnabledValue
+	}
+	return feature.DisabledValue
+}
+
+//rollout returns the rollout percentage of the rule, the one of the feature
+//for DefaultValue
+func (rule SegmentRule) rollout(feature Feature) *int {
+	switch p := rule.RolloutPercentage; {
+	case p == nil:
+		return nil
+	case p.Default:
+		return feature.RolloutPercentage
+	default:
+		return &p.Percentage
+	}
+}
+
+func (index SegmentIndex) matchesAny(rule SegmentRule, attributes map[string]interface{}) bool {
+	for _, ref := range rule.Rules {
+		for _, id := range ref.Segments {
+			if s, ok := index[id]; ok && s.Matches(attributes) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+//Matches returns true when all the rules of the segment match the attributes
+func (s Segment) Matches(attributes map[string]interface{}) bool {
+	if len(s.Rules) == 0 {
+		return false
+	}
+	for _, r := range s.Rules {
+		if !r.Matches(attributes) {
+			return false
+		}
+	}
+	return true
+}
+
+//Matches returns true when the attribute matches any of the rule values
+func (r Rule) Matches(attributes map[string]interface{}) bool {
+	attr, ok := attributes[r.AttributeName]
+	if !ok {
+		return false
+	}
+	for _, v := range r.Values {
+		if matchValue(r.Operator, attr, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchValue(operator string, attr interface{}, value string) bool {
+	s := fmt.Sprint(attr)
+	switch operator {
+	case OperatorIs:
+		if n, ok := toFloat(attr); ok {
+			if v, err := strconv.ParseFloat(value, 64); err == nil {
+				return n == v
+			}
+		}
+		return s == value
+	case OperatorContains:
+		return strings.Contains(s, value)
+	case OperatorStartsWith:
+		return strings.HasPrefix(s, value)
+	case OperatorEndsWith:
+		return strings.HasSuffix(s, value)
+	}
+
+	n, ok := toFloat(attr)
+	if !ok {
+		return false
+	}
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return false
+	}
+	switch operator {
+	case OperatorGreaterThan:
+		return n > v
+	case OperatorGreaterThanEquals:
+		return n >= v
+	case OperatorLesserThan:
+		return n < v
+	case OperatorLesserThanEquals:
+		return n <= v
+	}
+	return false
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	case string:
+		f, err := strconv.ParseFloat(n, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+//inRollout buckets the entity in [0, 100) from the murmur3 hash of
+//"entityID:featureID", normalized the same way as the App Configuration SDKs,
+//so an entity gets the same value here and in the applications.
+func inRollout(percentage *int, entityID, featureID string) bool {
+	if percentage == nil || *percentage >= 100 {
+		return true
+	}
+	if *percentage <= 0 {
+		return false
+	}
+	return normalizedValue(entityID+":"+featureID) < *percentage
+}
+
+func normalizedValue(s string) int {
+	return int(float64(murmur3([]byte(s), 0)) / (1 << 32) * 100)
+}
+
+//murmur3 is the 32 bit MurmurHash3 of data
+func murmur3(data []byte, seed uint32) uint32 {
+	const (
+		c1 = 0xcc9e2d51
+		c2 = 0x1b873593
+	)
+	h := seed
+	n := len(data) / 4
+	for i := 0; i < n; i++ {
+		k := uint32(data[4*i]) | uint32(data[4*i+1])<<8 | uint32(data[4*i+2])<<16 | uint32(data[4*i+3])<<24
+		k *= c1
+		k = bits.RotateLeft32(k, 15)
+		k *= c2
+		h ^= k
+		h = bits.RotateLeft32(h, 13)
+		h = h*5 + 0xe6546b64
+	}
+	tail := data[4*n:]
+	var k uint32
+	switch len(tail) {
+	case 3:
+		k ^= uint32(tail[2]) << 16
+		fallthrough
+	case 2:
+		k ^= uint32(tail[1]) << 8
+		fallthrough
+	case 1:
+		k ^= uint32(tail[0])
+		k *= c1
+		k = bits.RotateLeft32(k, 15)
+		k *= c2
+		h ^= k
+	}
+	h ^= uint32(len(data))
+	h ^= h >> 16
+	h *= 0x85ebca6b
+	h ^= h >> 13
+	h *= 0xc2b2ae35
+	h ^= h >> 16
+	return h
+}
@@ -0,0 +1,87 @@
+package appconfigurationv1
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Evaluate", func() {
+	var index SegmentIndex
+	var feature Feature
+	percentage := func(p int) *int { return &p }
+
+	BeforeEach(func() {
+		index = NewSegmentIndex([]Segment{
+			{SegmentID: "ibmers", Rules: []Rule{{AttributeName: "email", Operator: OperatorEndsWith, Values: []string{"@ibm.com"}}}},
+			{SegmentID: "adults", Rules: []Rule{
+				{AttributeName: "age", Operator: OperatorGreaterThanEquals, Values: []string{"18"}},
+				{AttributeName: "country", Operator: OperatorIs, Values: []string{"IN", "US"}},
+			}},
+		})
+		feature = Feature{
+			FeatureID:     "discount",
+			Type:          FeatureTypeNumeric,
+			Enabled:       true,
+			EnabledValue:  float64(10),
+			DisabledValue: float64(0),
+			SegmentRules: []SegmentRule{
+				{Rules: []SegmentRef{{Segments: []string{"adults"}}}, Value: float64(20), Order: 2},
+				{Rules: []SegmentRef{{Segments: []string{"ibmers"}}}, Value: DefaultValue, Order: 1},
+			},
+		}
+	})
+
+	It("should return the disabled value of a disabled feature", func() {
+		feature.Enabled = false
+		Expect(index.Evaluate(feature, Entity{ID: "u1", Attributes: map[string]interface{}{"email": "a@ibm.com"}})).Should(Equal(float64(0)))
+	})
+	It("should apply the first matching segment rule by order", func() {
+		entity := Entity{ID: "u1", Attributes: map[string]interface{}{"email": "a@ibm.com", "age": 30, "country": "US"}}
+		Expect(index.Evaluate(feature, entity)).Should(Equal(float64(10)))
+	})
+	It("should require all the rules of a segment to match", func() {
+		Expect(index.Evaluate(feature, Entity{ID: "u1", Attributes: map[string]interface{}{"age": 30, "country": "IN"}})).Should(Equal(float64(20)))
+		Expect(index.Evaluate(feature, Entity{ID: "u1", Attributes: map[string]interface{}{"age": 30, "country": "FR"}})).Should(Equal(float64(10)))
+		Expect(index.Evaluate(feature, Entity{ID: "u1", Attributes: map[string]interface{}{"age": "17", "country": "IN"}})).Should(Equal(float64(10)))
+	})
+	It("should return the disabled value outside of a segment rollout", func() {
+		feature.SegmentRules[1].RolloutPercentage = Percentage(0)
+		Expect(index.Evaluate(feature, Entity{ID: "u1", Attributes: map[string]interface{}{"email": "a@ibm.com"}})).Should(Equal(float64(0)))
+	})
+	It("should apply the feature rollout to a segment rule with the default rollout", func() {
+		feature.RolloutPercentage = percentage(0)
+		feature.SegmentRules[1].RolloutPercentage = &RolloutPercentage{Default: true}
+		Expect(index.Evaluate(feature, Entity{ID: "u1", Attributes: map[string]interface{}{"email": "a@ibm.com"}})).Should(Equal(float64(0)))
+		feature.RolloutPercentage = percentage(100)
+		Expect(index.Evaluate(feature, Entity{ID: "u1", Attributes: map[string]interface{}{"email": "a@ibm.com"}})).Should(Equal(float64(10)))
+	})
+	It("should bucket entities consistently on the feature rollout", func() {
+		feature.RolloutPercentage = percentage(50)
+		enabled := 0
+		for i := 0; i < 1000; i++ {
+			entity := Entity{ID: fmt.Sprintf("user-%d", i)}
+			v := index.Evaluate(feature, entity)
+			Expect(index.Evaluate(feature, entity)).Should(Equal(v))
+			if v == float64(10) {
+				enabled++
+			}
+		}
+		Expect(enabled).Should(BeNumerically("~", 500, 75))
+	})
+	It("should hash like the App Configuration SDKs", func() {
+		Expect(murmur3([]byte(""), 0)).Should(Equal(uint32(0)))
+		Expect(murmur3([]byte("hello"), 0)).Should(Equal(uint32(0x248bfa47)))
+		Expect(murmur3([]byte("Hello, world!"), 0)).Should(Equal(uint32(0xc0363e43)))
+		Expect(murmur3([]byte("The quick brown fox jumps over the lazy dog"), 0)).Should(Equal(uint32(0x2e4ff723)))
+		Expect(normalizedValue("hello")).Should(Equal(14))
+	})
+	It("should match string operators", func() {
+		attrs := map[string]interface{}{"plan": "enterprise-annual"}
+		Expect(Rule{AttributeName: "plan", Operator: OperatorStartsWith, Values: []string{"enterprise"}}.Matches(attrs)).Should(BeTrue())
+		Expect(Rule{AttributeName: "plan", Operator: OperatorContains, Values: []string{"annual"}}.Matches(attrs)).Should(BeTrue())
+		Expect(Rule{AttributeName: "plan", Operator: OperatorLesserThan, Values: []string{"5"}}.Matches(attrs)).Should(BeFalse())
+		Expect(Rule{AttributeName: "missing", Operator: OperatorIs, Values: []string{""}}.Matches(attrs)).Should(BeFalse())
+	})
+})
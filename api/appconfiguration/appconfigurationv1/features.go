@@ -0,0 +1,159 @@
+package appconfigurationv1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/IBM-Cloud/bluemix-go/client"
+)
+
+//Feature types
+const (
+	FeatureTypeBoolean = "BOOLEAN"
+	FeatureTypeString  = "STRING"
+	FeatureTypeNumeric = "NUMERIC"
+)
+
+//DefaultValue in a segment rule stands for the enabled value of the feature
+const DefaultValue = "$default"
+
+//SegmentRef lists the segments targeted by a segment rule, any of them has to match
+type SegmentRef struct {
+	Segments []string `json:"segments"`
+}
+
+//RolloutPercentage is the rollout percentage of a segment rule, either a
+//number or DefaultValue to apply the rollout percentage of the feature
+type RolloutPercentage struct {
+	Percentage int
+	Default    bool
+}
+
+//Percentage returns the rollout percentage p for a segment rule
+func Percentage(p int) *RolloutPercentage {
+	return &RolloutPercentage{Percentage: p}
+}
+
+//MarshalJSON ...
+func (p RolloutPercentage) MarshalJSON() ([]byte, error) {
+	if p.Default {
+		return json.Marshal(DefaultValue)
+	}
+	return json.Marshal(p.Percentage)
+}
+
+//UnmarshalJSON accepts a number or DefaultValue
+func (p *RolloutPercentage) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		if s == DefaultValue {
+			*p = RolloutPercentage{Default: true}
+			return nil
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return fmt.Errorf("invalid rollout percentage %q", s)
+		}
+		*p = RolloutPercentage{Percentage: n}
+		return nil
+	}
+	var n int
+	if err := json.Unmarshal(b, &n); err != nil {
+		return fmt.Errorf("invalid rollout percentage %s", b)
+	}
+	*p = RolloutPercentage{Percentage: n}
+	return nil
+}
+
+//SegmentRule overrides the value of the feature for the entities of the referenced segments
+type SegmentRule struct {
+	Rules             []SegmentRef       `json:"rules"`
+	Value             interface{}        `json:"value"`
+	Order             int                `json:"order"`
+	RolloutPercentage *RolloutPercentage `json:"rollout_percentage,omitempty"`
+}
+
+//Feature is a feature flag of an environment
+type Feature struct {
+	Name              string        `json:"name"`
+	FeatureID         string        `json:"feature_id"`
+	Description       string        `json:"description"`
+	Type              string        `json:"type"`
+	Format            string        `json:"format,omitempty"`
+	EnabledValue      interface{}   `json:"enabled_value"`
+	DisabledValue     interface{}   `json:"disabled_value"`
+	Enabled           bool          `json:"enabled"`
+	RolloutPercentage *int          `json:"rollout_percentage,omitempty"`
+	SegmentRules      []SegmentRule `json:"segment_rules"`
+	SegmentExists     bool          `json:"segment_exists"`
+	Tags              string        `json:"tags"`
+	CreatedTime       string        `json:"created_time"`
+	UpdatedTime       string        `json:"updated_time"`
+	Href              string        `json:"href"`
+}
+
+type segmentRulesPatch struct {
+	SegmentRules []SegmentRule `json:"segment_rules"`
+}
+
+type featureList struct {
+	Features   []Feature `json:"features"`
+	Limit      int       `json:"limit"`
+	Offset     int       `json:"offset"`
+	TotalCount int       `json:"total_count"`
+}
+
+//Features ...
+type Features interface {
+	GetFeature(instanceID, environmentID, featureID string) (Feature, error)
+	ListFeatures(instanceID, environmentID string) ([]Feature, error)
+	UpdateSegmentRules(instanceID, environmentID, featureID string, rules []SegmentRule) (Feature, error)
+}
+
+type features struct {
+	client *client.Client
+}
+
+func newFeaturesAPI(c *client.Client) Features {
+	return &features{
+		client: c,
+	}
+}
+
+func (r *features) GetFeature(instanceID, environmentID, featureID string) (Feature, error) {
+	var successV Feature
+	_, err := r.client.Get(fmt.Sprintf("/apprapp/feature/v1/instances/%s/environments/%s/features/%s",
+		url.PathEscape(instanceID), url.PathEscape(environmentID), url.PathEscape(featureID)), &successV)
+	return successV, err
+}
+
+//ListFeatures returns the features of the environment with their segment rules
+func (r *features) ListFeatures(instanceID, environmentID string) ([]Feature, error) {
+	all := []Feature{}
+	for {
+		var list featureList
+		_, err := r.client.Get(fmt.Sprintf("/apprapp/feature/v1/instances/%s/environments/%s/features?expand=true&limit=100&offset=%d",
+			url.PathEscape(instanceID), url.PathEscape(environmentID), len(all)), &list)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, list.Features...)
+		if len(list.Features) == 0 || len(all) >= list.TotalCount {
+			return all, nil
+		}
+	}
+}
+
+//UpdateSegmentRules replaces the segment rules of the feature, rules are
+//added, changed or removed by sending the new list; no rules removes them all
+func (r *features) UpdateSegmentRules(instanceID, environmentID, featureID string, rules []SegmentRule) (Feature, error) {
+	if rules == nil {
+		rules = []SegmentRule{}
+	}
+	var successV Feature
+	_, err := r.client.Patch(fmt.Sprintf("/apprapp/feature/v1/instances/%s/environments/%s/features/%s",
+		url.PathEscape(instanceID), url.PathEscape(environmentID), url.PathEscape(featureID)), segmentRulesPatch{SegmentRules: rules}, &successV)
+	return successV, err
+}
@@ -0,0 +1,253 @@
+package appconfigurationv1
+
+import (
+	"log"
+	"net/http"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/client"
+	bluemixHttp "github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/session"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Features", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+	Describe("GetFeature", func() {
+		Context("When the feature exists", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/apprapp/feature/v1/instances/i1/environments/dev/features/dark-mode"),
+						ghttp.RespondWith(http.StatusOK, `{"feature_id": "dark-mode", "type": "BOOLEAN", "enabled": true, "enabled_value": true, "disabled_value": false, "rollout_percentage": 50,
+							"segment_rules": [{"rules": [{"segments": ["beta"]}], "value": "$default", "order": 1, "rollout_percentage": "$default"},
+								{"rules": [{"segments": ["ibmers"]}], "value": false, "order": 2, "rollout_percentage": 20}]}`),
+					),
+				)
+			})
+
+			It("should return the feature with its segment rules", func() {
+				feature, err := newFeatures(server.URL()).GetFeature("i1", "dev", "dark-mode")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(feature.Enabled).Should(BeTrue())
+				Expect(*feature.RolloutPercentage).Should(Equal(50))
+				Expect(feature.SegmentRules).Should(HaveLen(2))
+				Expect(feature.SegmentRules[0].Rules[0].Segments).Should(Equal([]string{"beta"}))
+				Expect(feature.SegmentRules[0].RolloutPercentage.Default).Should(BeTrue())
+				Expect(*feature.SegmentRules[1].RolloutPercentage).Should(Equal(RolloutPercentage{Percentage: 20}))
+			})
+		})
+		Context("When the feature does not exist", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/apprapp/feature/v1/instances/i1/environments/dev/features/unknown"),
+						ghttp.RespondWith(http.StatusNotFound, `{"message": "Feature not found"}`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				_, err := newFeatures(server.URL()).GetFeature("i1", "dev", "unknown")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+	Describe("ListFeatures", func() {
+		Context("When features span two pages", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/apprapp/feature/v1/instances/i1/environments/dev/features", "expand=true&limit=100&offset=0"),
+						ghttp.RespondWith(http.StatusOK, `{"total_count": 2, "features": [{"feature_id": "f1"}]}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/apprapp/feature/v1/instances/i1/environments/dev/features", "expand=true&limit=100&offset=1"),
+						ghttp.RespondWith(http.StatusOK, `{"total_count": 2, "features": [{"feature_id": "f2"}]}`),
+					),
+				)
+			})
+
+			It("should return all the features", func() {
+				features, err := newFeatures(server.URL()).ListFeatures("i1", "dev")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(features).Should(HaveLen(2))
+				Expect(features[1].FeatureID).Should(Equal("f2"))
+			})
+		})
+	})
+	Describe("UpdateSegmentRules", func() {
+		Context("When the segment rules are replaced", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPatch, "/apprapp/feature/v1/instances/i1/environments/dev/features/dark-mode"),
+						ghttp.VerifyJSON(`{"segment_rules": [
+							{"rules": [{"segments": ["beta"]}], "value": "$default", "order": 1, "rollout_percentage": "$default"},
+							{"rules": [{"segments": ["ibmers"]}], "value": false, "order": 2, "rollout_percentage": 20}]}`),
+						ghttp.RespondWith(http.StatusOK, `{"feature_id": "dark-mode", "segment_exists": true}`),
+					),
+				)
+			})
+
+			It("should send the new segment rules", func() {
+				feature, err := newFeatures(server.URL()).UpdateSegmentRules("i1", "dev", "dark-mode", []SegmentRule{
+					{Rules: []SegmentRef{{Segments: []string{"beta"}}}, Value: DefaultValue, Order: 1, RolloutPercentage: &RolloutPercentage{Default: true}},
+					{Rules: []SegmentRef{{Segments: []string{"ibmers"}}}, Value: false, Order: 2, RolloutPercentage: Percentage(20)},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(feature.SegmentExists).Should(BeTrue())
+			})
+		})
+		Context("When all the segment rules are removed", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPatch, "/apprapp/feature/v1/instances/i1/environments/dev/features/dark-mode"),
+						ghttp.VerifyJSON(`{"segment_rules": []}`),
+						ghttp.RespondWith(http.StatusOK, `{"feature_id": "dark-mode"}`),
+					),
+				)
+			})
+
+			It("should send an empty list", func() {
+				_, err := newFeatures(server.URL()).UpdateSegmentRules("i1", "dev", "dark-mode", nil)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+	Describe("ListSegments", func() {
+		Context("When segments are listed", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/apprapp/feature/v1/instances/i1/segments", "expand=true&limit=100&offset=0"),
+						ghttp.RespondWith(http.StatusOK, `{"total_count": 1, "segments": [{"segment_id": "beta", "rules": [{"attribute_name": "email", "operator": "endsWith", "values": ["@ibm.com"]}]}]}`),
+					),
+				)
+			})
+
+			It("should return the segments", func() {
+				segments, err := newSegmentsAPI(newTestClient(server.URL())).ListSegments("i1")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(segments).Should(HaveLen(1))
+				Expect(segments[0].Rules[0].Operator).Should(Equal(OperatorEndsWith))
+			})
+		})
+	})
+	Describe("CreateSegment", func() {
+		Context("When the segment is created", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/apprapp/feature/v1/instances/i1/segments"),
+						ghttp.VerifyJSON(`{"name": "Beta", "segment_id": "beta", "rules": [{"attribute_name": "email", "operator": "endsWith", "values": ["@ibm.com"]}]}`),
+						ghttp.RespondWith(http.StatusCreated, `{"name": "Beta", "segment_id": "beta", "href": "https://us-south.apprapp.cloud.ibm.com/apprapp/feature/v1/instances/i1/segments/beta"}`),
+					),
+				)
+			})
+
+			It("should return the segment", func() {
+				segment, err := newSegmentsAPI(newTestClient(server.URL())).CreateSegment("i1", SegmentReq{
+					Name:      "Beta",
+					SegmentID: "beta",
+					Rules:     []Rule{{AttributeName: "email", Operator: OperatorEndsWith, Values: []string{"@ibm.com"}}},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(segment.SegmentID).Should(Equal("beta"))
+			})
+		})
+	})
+	Describe("UpdateSegment", func() {
+		Context("When the segment is updated", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, "/apprapp/feature/v1/instances/i1/segments/beta"),
+						ghttp.VerifyJSON(`{"name": "Beta", "segment_id": "beta", "tags": "qa", "rules": [{"attribute_name": "age", "operator": "greaterThan", "values": ["18"]}]}`),
+						ghttp.RespondWith(http.StatusOK, `{"name": "Beta", "segment_id": "beta", "tags": "qa"}`),
+					),
+				)
+			})
+
+			It("should return the segment", func() {
+				segment, err := newSegmentsAPI(newTestClient(server.URL())).UpdateSegment("i1", "beta", SegmentReq{
+					Name:      "Beta",
+					SegmentID: "beta",
+					Tags:      "qa",
+					Rules:     []Rule{{AttributeName: "age", Operator: OperatorGreaterThan, Values: []string{"18"}}},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(segment.Tags).Should(Equal("qa"))
+			})
+		})
+	})
+	Describe("DeleteSegment", func() {
+		Context("When the segment is deleted", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, "/apprapp/feature/v1/instances/i1/segments/beta"),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("should delete the segment", func() {
+				err := newSegmentsAPI(newTestClient(server.URL())).DeleteSegment("i1", "beta")
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+		Context("When the segment is used by a feature", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, "/apprapp/feature/v1/instances/i1/segments/beta"),
+						ghttp.RespondWith(http.StatusConflict, `{"message": "Segment is in use"}`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				err := newSegmentsAPI(newTestClient(server.URL())).DeleteSegment("i1", "beta")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
+
+func newTestClient(url string) *client.Client {
+	sess, err := session.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+	conf := sess.Config.Copy()
+	conf.HTTPClient = bluemixHttp.NewHTTPClient(conf)
+	conf.Endpoint = &url
+
+	return &client.Client{
+		Config:      conf,
+		ServiceName: bluemix.AppConfigurationService,
+	}
+}
+
+func newFeatures(url string) Features {
+	return newFeaturesAPI(newTestClient(url))
+}
@@ -0,0 +1,117 @@
+package appconfigurationv1
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/IBM-Cloud/bluemix-go/client"
+)
+
+//Rule operators
+const (
+	OperatorIs                = "is"
+	OperatorContains          = "contains"
+	OperatorStartsWith        = "startsWith"
+	OperatorEndsWith          = "endsWith"
+	OperatorGreaterThan       = "greaterThan"
+	OperatorGreaterThanEquals = "greaterThanEquals"
+	OperatorLesserThan        = "lesserThan"
+	OperatorLesserThanEquals  = "lesserThanEquals"
+)
+
+//Rule matches an entity attribute against any of the values
+type Rule struct {
+	AttributeName string   `json:"attribute_name"`
+	Operator      string   `json:"operator"`
+	Values        []string `json:"values"`
+}
+
+//Segment is a group of entities, an entity is part of it when all the rules match
+type Segment struct {
+	Name        string `json:"name"`
+	SegmentID   string `json:"segment_id"`
+	Description string `json:"description"`
+	Tags        string `json:"tags"`
+	Rules       []Rule `json:"rules"`
+	CreatedTime string `json:"created_time"`
+	UpdatedTime string `json:"updated_time"`
+	Href        string `json:"href"`
+}
+
+//SegmentReq is the body to create or update a segment
+type SegmentReq struct {
+	Name        string `json:"name"`
+	SegmentID   string `json:"segment_id"`
+	Description string `json:"description,omitempty"`
+	Tags        string `json:"tags,omitempty"`
+	Rules       []Rule `json:"rules"`
+}
+
+type segmentList struct {
+	Segments   []Segment `json:"segments"`
+	Limit      int       `json:"limit"`
+	Offset     int       `json:"offset"`
+	TotalCount int       `json:"total_count"`
+}
+
+//Segments ...
+type Segments interface {
+	GetSegment(instanceID, segmentID string) (Segment, error)
+	ListSegments(instanceID string) ([]Segment, error)
+	CreateSegment(instanceID string, params SegmentReq) (Segment, error)
+	UpdateSegment(instanceID, segmentID string, params SegmentReq) (Segment, error)
+	DeleteSegment(instanceID, segmentID string) error
+}
+
+type segments struct {
+	client *client.Client
+}
+
+func newSegmentsAPI(c *client.Client) Segments {
+	return &segments{
+		client: c,
+	}
+}
+
+func (r *segments) GetSegment(instanceID, segmentID string) (Segment, error) {
+	var successV Segment
+	_, err := r.client.Get(fmt.Sprintf("/apprapp/feature/v1/instances/%s/segments/%s",
+		url.PathEscape(instanceID), url.PathEscape(segmentID)), &successV)
+	return successV, err
+}
+
+func (r *segments) ListSegments(instanceID string) ([]Segment, error) {
+	all := []Segment{}
+	for {
+		var list segmentList
+		_, err := r.client.Get(fmt.Sprintf("/apprapp/feature/v1/instances/%s/segments?expand=true&limit=100&offset=%d",
+			url.PathEscape(instanceID), len(all)), &list)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, list.Segments...)
+		if len(list.Segments) == 0 || len(all) >= list.TotalCount {
+			return all, nil
+		}
+	}
+}
+
+func (r *segments) CreateSegment(instanceID string, params SegmentReq) (Segment, error) {
+	var successV Segment
+	_, err := r.client.Post(fmt.Sprintf("/apprapp/feature/v1/instances/%s/segments",
+		url.PathEscape(instanceID)), params, &successV)
+	return successV, err
+}
+
+func (r *segments) UpdateSegment(instanceID, segmentID string, params SegmentReq) (Segment, error) {
+	var successV Segment
+	_, err := r.client.Put(fmt.Sprintf("/apprapp/feature/v1/instances/%s/segments/%s",
+		url.PathEscape(instanceID), url.PathEscape(segmentID)), params, &successV)
+	return successV, err
+}
+
+func (r *segments) DeleteSegment(instanceID, segmentID string) error {
+	_, err := r.client.Delete(fmt.Sprintf("/apprapp/feature/v1/instances/%s/segments/%s",
+		url.PathEscape(instanceID), url.PathEscape(segmentID)))
+	return err
+}
@@ -0,0 +1,85 @@
+package codeenginev2
+
+import (
+	gohttp "net/http"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/authentication"
+	"github.com/IBM-Cloud/bluemix-go/client"
+	"github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/rest"
+	"github.com/IBM-Cloud/bluemix-go/session"
+)
+
+//CodeEngineServiceAPI is the Code Engine client ...
+type CodeEngineServiceAPI interface {
+	Builds() Builds
+	JobRuns() JobRuns
+	DomainMappings() DomainMappings
+}
+
+//ErrCodeAPICreation ...
+const ErrCodeAPICreation = "APICreationError"
+
+//mergePatchContentType is the content type of the update operations, the
+//fields missing from the body are not changed
+const mergePatchContentType = "application/merge-patch+json"
+
+//ceService holds the client
+type ceService struct {
+	*client.Client
+}
+
+//New ...
+func New(sess *session.Session) (CodeEngineServiceAPI, error) {
+	config := sess.Config.Copy()
+	err := config.ValidateConfigForService(bluemix.CodeEngineService)
+	if err != nil {
+		return nil, err
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.NewHTTPClient(config)
+	}
+	tokenRefreher, err := authentication.NewIAMAuthRepository(config, &rest.Client{
+		DefaultHeader: gohttp.Header{
+			"X-Original-User-Agent": []string{config.UserAgent},
+			"User-Agent":            []string{http.UserAgent()},
+		},
+		HTTPClient: config.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if config.IAMAccessToken == "" {
+		err := authentication.PopulateTokens(tokenRefreher, config)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if config.Endpoint == nil {
+		ep, err := config.EndpointLocator.CodeEngineEndpoint()
+		if err != nil {
+			return nil, err
+		}
+		config.Endpoint = &ep
+	}
+
+	return &ceService{
+		Client: client.New(config, bluemix.CodeEngineService, tokenRefreher),
+	}, nil
+}
+
+//Builds implements the builds and build runs API
+func (c *ceService) Builds() Builds {
+	return newBuildsAPI(c.Client)
+}
+
+//JobRuns implements the job runs API
+func (c *ceService) JobRuns() JobRuns {
+	return newJobRunsAPI(c.Client)
+}
+
+//DomainMappings implements the domain mappings API
+func (c *ceService) DomainMappings() DomainMappings {
+	return newDomainMappingsAPI(c.Client)
+}
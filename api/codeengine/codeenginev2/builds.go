@@ -0,0 +1,180 @@
+package codeenginev2
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/IBM-Cloud/bluemix-go/client"
+)
+
+//Build strategies
+const (
+	BuildStrategyDockerfile = "dockerfile"
+	BuildStrategyBuildpacks = "buildpacks"
+)
+
+//BuildReq describes how to build an image from a source repository or a local upload
+type BuildReq struct {
+	Name             string `json:"name,omitempty"`
+	OutputImage      string `json:"output_image"`
+	OutputSecret     string `json:"output_secret"`
+	SourceType       string `json:"source_type,omitempty"`
+	SourceURL        string `json:"source_url,omitempty"`
+	SourceRevision   string `json:"source_revision,omitempty"`
+	SourceSecret     string `json:"source_secret,omitempty"`
+	SourceContextDir string `json:"source_context_dir,omitempty"`
+	StrategyType     string `json:"strategy_type"`
+	StrategySpecFile string `json:"strategy_spec_file,omitempty"`
+	StrategySize     string `json:"strategy_size,omitempty"`
+	TimeoutSeconds   int    `json:"timeout,omitempty"`
+}
+
+//BuildPatch holds the fields of a build to update, nil fields are not changed
+type BuildPatch struct {
+	OutputImage      *string `json:"output_image,omitempty"`
+	OutputSecret     *string `json:"output_secret,omitempty"`
+	SourceType       *string `json:"source_type,omitempty"`
+	SourceURL        *string `json:"source_url,omitempty"`
+	SourceRevision   *string `json:"source_revision,omitempty"`
+	SourceSecret     *string `json:"source_secret,omitempty"`
+	SourceContextDir *string `json:"source_context_dir,omitempty"`
+	StrategyType     *string `json:"strategy_type,omitempty"`
+	StrategySpecFile *string `json:"strategy_spec_file,omitempty"`
+	StrategySize     *string `json:"strategy_size,omitempty"`
+	TimeoutSeconds   *int    `json:"timeout,omitempty"`
+}
+
+type Build struct {
+	BuildReq
+	ID           string `json:"id"`
+	ProjectID    string `json:"project_id"`
+	Href         string `json:"href"`
+	Status       string `json:"status"`
+	CreatedAt    string `json:"created_at"`
+	EntityTag    string `json:"entity_tag"`
+	ResourceType string `json:"resource_type"`
+}
+
+//BuildRunReq runs a build, either by name or with an inline build definition
+type BuildRunReq struct {
+	Name      string `json:"name,omitempty"`
+	BuildName string `json:"build_name,omitempty"`
+	BuildReq
+}
+
+type BuildRunStatusDetails struct {
+	StartTime      string `json:"start_time"`
+	CompletionTime string `json:"completion_time"`
+	OutputDigest   string `json:"output_digest"`
+	Reason         string `json:"reason"`
+}
+
+type BuildRun struct {
+	BuildRunReq
+	ID            string                `json:"id"`
+	ProjectID     string                `json:"project_id"`
+	Href          string                `json:"href"`
+	Status        string                `json:"status"`
+	StatusDetails BuildRunStatusDetails `json:"status_details"`
+	CreatedAt     string                `json:"created_at"`
+}
+
+type Builds interface {
+	CreateBuild(projectID string, buildReq BuildReq) (Build, error)
+	GetBuild(projectID string, name string) (Build, error)
+	ListBuilds(projectID string) ([]Build, error)
+	UpdateBuild(projectID string, name string, patch BuildPatch, etag string) (Build, error)
+	DeleteBuild(projectID string, name string) error
+	CreateBuildRun(projectID string, buildRunReq BuildRunReq) (BuildRun, error)
+	GetBuildRun(projectID string, name string) (BuildRun, error)
+	ListBuildRuns(projectID string, buildName string) ([]BuildRun, error)
+	DeleteBuildRun(projectID string, name string) error
+}
+
+type builds struct {
+	client *client.Client
+}
+
+func newBuildsAPI(c *client.Client) Builds {
+	return &builds{
+		client: c,
+	}
+}
+
+func (r *builds) CreateBuild(projectID string, buildReq BuildReq) (Build, error) {
+	var successV Build
+	_, err := r.client.Post(fmt.Sprintf("/v2/projects/%s/builds", url.PathEscape(projectID)), buildReq, &successV)
+	return successV, err
+}
+
+func (r *builds) GetBuild(projectID string, name string) (Build, error) {
+	var successV Build
+	_, err := r.client.Get(fmt.Sprintf("/v2/projects/%s/builds/%s", url.PathEscape(projectID), url.PathEscape(name)), &successV)
+	return successV, err
+}
+
+func (r *builds) ListBuilds(projectID string) ([]Build, error) {
+	all := []Build{}
+	err := listAll(r.client, fmt.Sprintf("/v2/projects/%s/builds", url.PathEscape(projectID)), nil, func(raw []byte) error {
+		var list struct {
+			Builds []Build `json:"builds"`
+		}
+		if err := json.Unmarshal(raw, &list); err != nil {
+			return err
+		}
+		all = append(all, list.Builds...)
+		return nil
+	})
+	return all, err
+}
+
+//UpdateBuild updates the fields set in the patch, etag is the EntityTag of the build to update
+func (r *builds) UpdateBuild(projectID string, name string, patch BuildPatch, etag string) (Build, error) {
+	var successV Build
+	header := map[string]string{"If-Match": etag, "Content-Type": mergePatchContentType}
+	_, err := r.client.Patch(fmt.Sprintf("/v2/projects/%s/builds/%s", url.PathEscape(projectID), url.PathEscape(name)), patch, &successV, header)
+	return successV, err
+}
+
+func (r *builds) DeleteBuild(projectID string, name string) error {
+	_, err := r.client.Delete(fmt.Sprintf("/v2/projects/%s/builds/%s", url.PathEscape(projectID), url.PathEscape(name)))
+	return err
+}
+
+func (r *builds) CreateBuildRun(projectID string, buildRunReq BuildRunReq) (BuildRun, error) {
+	var successV BuildRun
+	_, err := r.client.Post(fmt.Sprintf("/v2/projects/%s/build_runs", url.PathEscape(projectID)), buildRunReq, &successV)
+	return successV, err
+}
+
+func (r *builds) GetBuildRun(projectID string, name string) (BuildRun, error) {
+	var successV BuildRun
+	_, err := r.client.Get(fmt.Sprintf("/v2/projects/%s/build_runs/%s", url.PathEscape(projectID), url.PathEscape(name)), &successV)
+	return successV, err
+}
+
+//ListBuildRuns lists the build runs of the project, only the runs of buildName when it is not empty
+func (r *builds) ListBuildRuns(projectID string, buildName string) ([]BuildRun, error) {
+	all := []BuildRun{}
+	filter := url.Values{}
+	if buildName != "" {
+		filter.Set("build_name", buildName)
+	}
+	err := listAll(r.client, fmt.Sprintf("/v2/projects/%s/build_runs", url.PathEscape(projectID)), filter, func(raw []byte) error {
+		var list struct {
+			BuildRuns []BuildRun `json:"build_runs"`
+		}
+		if err := json.Unmarshal(raw, &list); err != nil {
+			return err
+		}
+		all = append(all, list.BuildRuns...)
+		return nil
+	})
+	return all, err
+}
+
+func (r *builds) DeleteBuildRun(projectID string, name string) error {
+	_, err := r.client.Delete(fmt.Sprintf("/v2/projects/%s/build_runs/%s", url.PathEscape(projectID), url.PathEscape(name)))
+	return err
+}
@@ -0,0 +1,146 @@
+package codeenginev2
+
+import (
+	"log"
+	"net/http"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/client"
+	"github.com/IBM-Cloud/bluemix-go/helpers"
+	bluemixHttp "github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/session"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Builds", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+	Describe("CreateBuild", func() {
+		Context("When creation is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v2/projects/15314cc3-85b4-4338-903f-c28cdee6d005/builds"),
+						ghttp.VerifyJSON(`{"name":"my-build","output_image":"private.de.icr.io/icr_namespace/image-name","output_secret":"ce-auto-icr-private-eu-de","source_url":"https://github.com/IBM/CodeEngine","strategy_type":"dockerfile"}`),
+						ghttp.RespondWith(http.StatusCreated, `{"id": "b1", "name": "my-build", "status": "ready", "entity_tag": "2385407409", "strategy_type": "dockerfile"}`),
+					),
+				)
+			})
+
+			It("should return the build", func() {
+				build, err := newBuilds(server.URL()).CreateBuild("15314cc3-85b4-4338-903f-c28cdee6d005", BuildReq{
+					Name:         "my-build",
+					OutputImage:  "private.de.icr.io/icr_namespace/image-name",
+					OutputSecret: "ce-auto-icr-private-eu-de",
+					SourceURL:    "https://github.com/IBM/CodeEngine",
+					StrategyType: BuildStrategyDockerfile,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(build.Status).Should(Equal("ready"))
+				Expect(build.EntityTag).Should(Equal("2385407409"))
+			})
+		})
+	})
+	Describe("UpdateBuild", func() {
+		Context("When the source revision is changed", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPatch, "/v2/projects/p1/builds/my-build"),
+						ghttp.VerifyHeaderKV("If-Match", "2385407409"),
+						ghttp.VerifyContentType("application/merge-patch+json"),
+						ghttp.VerifyBody([]byte(`{"source_revision":"v2"}`)),
+						ghttp.RespondWith(http.StatusOK, `{"name": "my-build", "source_revision": "v2", "output_image": "private.de.icr.io/icr_namespace/image-name", "entity_tag": "2385407410"}`),
+					),
+				)
+			})
+
+			It("should send only the fields to change", func() {
+				build, err := newBuilds(server.URL()).UpdateBuild("p1", "my-build", BuildPatch{SourceRevision: helpers.String("v2")}, "2385407409")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(build.OutputImage).Should(Equal("private.de.icr.io/icr_namespace/image-name"))
+				Expect(build.EntityTag).Should(Equal("2385407410"))
+			})
+		})
+	})
+	Describe("ListBuildRuns", func() {
+		Context("When build runs span two pages", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/projects/p1/build_runs", "build_name=my-build&limit=100"),
+						ghttp.RespondWith(http.StatusOK, `{
+							"limit": 100,
+							"next": {"href": "https://api.eu-de.codeengine.cloud.ibm.com/v2/projects/p1/build_runs?build_name=my-build&limit=100&start=tok1", "start": "tok1"},
+							"build_runs": [
+								{"name": "my-build-run-1", "build_name": "my-build", "status": "succeeded"}
+							]
+						}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/projects/p1/build_runs", "build_name=my-build&limit=100&start=tok1"),
+						ghttp.RespondWith(http.StatusOK, `{
+							"limit": 100,
+							"build_runs": [
+								{"name": "my-build-run-2", "build_name": "my-build", "status": "failed", "status_details": {"reason": "ExceededEphemeralStorage"}}
+							]
+						}`),
+					),
+				)
+			})
+
+			It("should return the runs of the build from all the pages", func() {
+				runs, err := newBuilds(server.URL()).ListBuildRuns("p1", "my-build")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(runs).Should(HaveLen(2))
+				Expect(runs[1].StatusDetails.Reason).Should(Equal("ExceededEphemeralStorage"))
+			})
+		})
+	})
+	Describe("DeleteBuild", func() {
+		Context("When build is not found", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, "/v2/projects/p1/builds/missing"),
+						ghttp.RespondWith(http.StatusNotFound, `{"errors": [{"code": "not_found", "message": "Build not found"}]}`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				err := newBuilds(server.URL()).DeleteBuild("p1", "missing")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
+
+func newTestClient(url string) *client.Client {
+	sess, err := session.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+	conf := sess.Config.Copy()
+	conf.HTTPClient = bluemixHttp.NewHTTPClient(conf)
+	conf.Endpoint = &url
+
+	return &client.Client{
+		Config:      conf,
+		ServiceName: bluemix.CodeEngineService,
+	}
+}
+
+func newBuilds(url string) Builds {
+	return newBuildsAPI(newTestClient(url))
+}
@@ -0,0 +1,13 @@
+package codeenginev2
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestCodeenginev2(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Codeenginev2 Suite")
+}
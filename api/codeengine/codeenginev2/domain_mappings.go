@@ -0,0 +1,99 @@
+package codeenginev2
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/IBM-Cloud/bluemix-go/client"
+)
+
+//ComponentRef is the app the domain is routed to
+type ComponentRef struct {
+	Name         string `json:"name"`
+	ResourceType string `json:"resource_type"`
+}
+
+//DomainMappingReq maps a custom domain to an app, TLSSecret holds the certificate of the domain
+type DomainMappingReq struct {
+	Name      string       `json:"name"`
+	Component ComponentRef `json:"component"`
+	TLSSecret string       `json:"tls_secret"`
+}
+
+//DomainMappingPatch holds the fields of a domain mapping to update, nil fields are not changed
+type DomainMappingPatch struct {
+	Component *ComponentRef `json:"component,omitempty"`
+	TLSSecret *string       `json:"tls_secret,omitempty"`
+}
+
+type DomainMapping struct {
+	DomainMappingReq
+	ID          string `json:"id"`
+	ProjectID   string `json:"project_id"`
+	Href        string `json:"href"`
+	CnameTarget string `json:"cname_target"`
+	Status      string `json:"status"`
+	UserManaged bool   `json:"user_managed"`
+	Visibility  string `json:"visibility"`
+	CreatedAt   string `json:"created_at"`
+	EntityTag   string `json:"entity_tag"`
+}
+
+type DomainMappings interface {
+	CreateDomainMapping(projectID string, mappingReq DomainMappingReq) (DomainMapping, error)
+	GetDomainMapping(projectID string, name string) (DomainMapping, error)
+	ListDomainMappings(projectID string) ([]DomainMapping, error)
+	UpdateDomainMapping(projectID string, name string, patch DomainMappingPatch, etag string) (DomainMapping, error)
+	DeleteDomainMapping(projectID string, name string) error
+}
+
+type domainMappings struct {
+	client *client.Client
+}
+
+func newDomainMappingsAPI(c *client.Client) DomainMappings {
+	return &domainMappings{
+		client: c,
+	}
+}
+
+func (r *domainMappings) CreateDomainMapping(projectID string, mappingReq DomainMappingReq) (DomainMapping, error) {
+	var successV DomainMapping
+	_, err := r.client.Post(fmt.Sprintf("/v2/projects/%s/domain_mappings", url.PathEscape(projectID)), mappingReq, &successV)
+	return successV, err
+}
+
+func (r *domainMappings) GetDomainMapping(projectID string, name string) (DomainMapping, error) {
+	var successV DomainMapping
+	_, err := r.client.Get(fmt.Sprintf("/v2/projects/%s/domain_mappings/%s", url.PathEscape(projectID), url.PathEscape(name)), &successV)
+	return successV, err
+}
+
+func (r *domainMappings) ListDomainMappings(projectID string) ([]DomainMapping, error) {
+	all := []DomainMapping{}
+	err := listAll(r.client, fmt.Sprintf("/v2/projects/%s/domain_mappings", url.PathEscape(projectID)), nil, func(raw []byte) error {
+		var list struct {
+			DomainMappings []DomainMapping `json:"domain_mappings"`
+		}
+		if err := json.Unmarshal(raw, &list); err != nil {
+			return err
+		}
+		all = append(all, list.DomainMappings...)
+		return nil
+	})
+	return all, err
+}
+
+//UpdateDomainMapping updates the fields set in the patch, etag is the EntityTag of the mapping to update
+func (r *domainMappings) UpdateDomainMapping(projectID string, name string, patch DomainMappingPatch, etag string) (DomainMapping, error) {
+	var successV DomainMapping
+	header := map[string]string{"If-Match": etag, "Content-Type": mergePatchContentType}
+	_, err := r.client.Patch(fmt.Sprintf("/v2/projects/%s/domain_mappings/%s", url.PathEscape(projectID), url.PathEscape(name)), patch, &successV, header)
+	return successV, err
+}
+
+func (r *domainMappings) DeleteDomainMapping(projectID string, name string) error {
+	_, err := r.client.Delete(fmt.Sprintf("/v2/projects/%s/domain_mappings/%s", url.PathEscape(projectID), url.PathEscape(name)))
+	return err
+}
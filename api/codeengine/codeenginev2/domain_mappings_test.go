@@ -0,0 +1,86 @@
+package codeenginev2
+
+import (
+	"net/http"
+
+	"github.com/IBM-Cloud/bluemix-go/helpers"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DomainMappings", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+	Describe("CreateDomainMapping", func() {
+		Context("When creation is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v2/projects/p1/domain_mappings"),
+						ghttp.VerifyJSON(`{"name":"www.example.com","component":{"name":"my-app","resource_type":"app_v2"},"tls_secret":"my-tls-secret"}`),
+						ghttp.RespondWith(http.StatusCreated, `{"name": "www.example.com", "cname_target": "custom.abcdabcdabc.eu-de.codeengine.appdomain.cloud", "status": "deploying", "user_managed": true}`),
+					),
+				)
+			})
+
+			It("should return the CNAME target", func() {
+				mapping, err := newDomainMappings(server.URL()).CreateDomainMapping("p1", DomainMappingReq{
+					Name:      "www.example.com",
+					Component: ComponentRef{Name: "my-app", ResourceType: "app_v2"},
+					TLSSecret: "my-tls-secret",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mapping.CnameTarget).Should(Equal("custom.abcdabcdabc.eu-de.codeengine.appdomain.cloud"))
+			})
+		})
+	})
+	Describe("UpdateDomainMapping", func() {
+		Context("When the TLS secret is changed", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPatch, "/v2/projects/p1/domain_mappings/www.example.com"),
+						ghttp.VerifyHeaderKV("If-Match", "2"),
+						ghttp.VerifyContentType("application/merge-patch+json"),
+						ghttp.VerifyBody([]byte(`{"tls_secret":"renewed-tls-secret"}`)),
+						ghttp.RespondWith(http.StatusOK, `{"name": "www.example.com", "component": {"name": "my-app", "resource_type": "app_v2"}, "tls_secret": "renewed-tls-secret"}`),
+					),
+				)
+			})
+
+			It("should keep the component", func() {
+				mapping, err := newDomainMappings(server.URL()).UpdateDomainMapping("p1", "www.example.com", DomainMappingPatch{TLSSecret: helpers.String("renewed-tls-secret")}, "2")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mapping.Component.Name).Should(Equal("my-app"))
+			})
+		})
+		Context("When the entity tag is stale", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPatch, "/v2/projects/p1/domain_mappings/www.example.com"),
+						ghttp.VerifyHeaderKV("If-Match", "1"),
+						ghttp.RespondWith(http.StatusPreconditionFailed, `{"errors": [{"code": "precondition_failed", "message": "entity tag does not match"}]}`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				_, err := newDomainMappings(server.URL()).UpdateDomainMapping("p1", "www.example.com", DomainMappingPatch{}, "1")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
+
+func newDomainMappings(url string) DomainMappings {
+	return newDomainMappingsAPI(newTestClient(url))
+}
@@ -0,0 +1,111 @@
+package codeenginev2
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/IBM-Cloud/bluemix-go/client"
+)
+
+type EnvVar struct {
+	Type      string `json:"type"`
+	Name      string `json:"name,omitempty"`
+	Value     string `json:"value,omitempty"`
+	Reference string `json:"reference,omitempty"`
+}
+
+//JobRunReq runs a job, either by name or with an inline job definition
+type JobRunReq struct {
+	Name                  string   `json:"name,omitempty"`
+	JobName               string   `json:"job_name,omitempty"`
+	ImageReference        string   `json:"image_reference,omitempty"`
+	ImageSecret           string   `json:"image_secret,omitempty"`
+	RunCommands           []string `json:"run_commands,omitempty"`
+	RunArguments          []string `json:"run_arguments,omitempty"`
+	RunEnvVariables       []EnvVar `json:"run_env_variables,omitempty"`
+	ScaleArraySpec        string   `json:"scale_array_spec,omitempty"`
+	ScaleCPULimit         string   `json:"scale_cpu_limit,omitempty"`
+	ScaleMemoryLimit      string   `json:"scale_memory_limit,omitempty"`
+	ScaleMaxExecutionTime int      `json:"scale_max_execution_time,omitempty"`
+	ScaleRetryLimit       int      `json:"scale_retry_limit,omitempty"`
+}
+
+type JobRunStatusDetails struct {
+	StartTime      string `json:"start_time"`
+	CompletionTime string `json:"completion_time"`
+	Requested      int    `json:"requested"`
+	Running        int    `json:"running"`
+	Pending        int    `json:"pending"`
+	Succeeded      int    `json:"succeeded"`
+	Failed         int    `json:"failed"`
+	FailedIndices  string `json:"failed_indices"`
+}
+
+type JobRun struct {
+	JobRunReq
+	ID            string              `json:"id"`
+	ProjectID     string              `json:"project_id"`
+	Href          string              `json:"href"`
+	Status        string              `json:"status"`
+	StatusDetails JobRunStatusDetails `json:"status_details"`
+	CreatedAt     string              `json:"created_at"`
+}
+
+//JobRuns interface
+//
+//The Code Engine v2 API has no operation to read the logs of a job run, the
+//output of the instances is sent to the IBM Cloud Logs instance of the project
+type JobRuns interface {
+	CreateJobRun(projectID string, jobRunReq JobRunReq) (JobRun, error)
+	GetJobRun(projectID string, name string) (JobRun, error)
+	ListJobRuns(projectID string, jobName string) ([]JobRun, error)
+	DeleteJobRun(projectID string, name string) error
+}
+
+type jobRuns struct {
+	client *client.Client
+}
+
+func newJobRunsAPI(c *client.Client) JobRuns {
+	return &jobRuns{
+		client: c,
+	}
+}
+
+func (r *jobRuns) CreateJobRun(projectID string, jobRunReq JobRunReq) (JobRun, error) {
+	var successV JobRun
+	_, err := r.client.Post(fmt.Sprintf("/v2/projects/%s/job_runs", url.PathEscape(projectID)), jobRunReq, &successV)
+	return successV, err
+}
+
+func (r *jobRuns) GetJobRun(projectID string, name string) (JobRun, error) {
+	var successV JobRun
+	_, err := r.client.Get(fmt.Sprintf("/v2/projects/%s/job_runs/%s", url.PathEscape(projectID), url.PathEscape(name)), &successV)
+	return successV, err
+}
+
+//ListJobRuns lists the job runs of the project, only the runs of jobName when it is not empty
+func (r *jobRuns) ListJobRuns(projectID string, jobName string) ([]JobRun, error) {
+	all := []JobRun{}
+	filter := url.Values{}
+	if jobName != "" {
+		filter.Set("job_name", jobName)
+	}
+	err := listAll(r.client, fmt.Sprintf("/v2/projects/%s/job_runs", url.PathEscape(projectID)), filter, func(raw []byte) error {
+		var list struct {
+			JobRuns []JobRun `json:"job_runs"`
+		}
+		if err := json.Unmarshal(raw, &list); err != nil {
+			return err
+		}
+		all = append(all, list.JobRuns...)
+		return nil
+	})
+	return all, err
+}
+
+func (r *jobRuns) DeleteJobRun(projectID string, name string) error {
+	_, err := r.client.Delete(fmt.Sprintf("/v2/projects/%s/job_runs/%s", url.PathEscape(projectID), url.PathEscape(name)))
+	return err
+}
@@ -0,0 +1,81 @@
+package codeenginev2
+
+import (
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JobRuns", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+	Describe("CreateJobRun", func() {
+		Context("When the job is submitted", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v2/projects/p1/job_runs"),
+						ghttp.VerifyJSON(`{"job_name":"nightly","scale_array_spec":"0-4"}`),
+						ghttp.RespondWith(http.StatusAccepted, `{"name": "nightly-run-1", "job_name": "nightly", "status": "pending", "status_details": {"requested": 5, "pending": 5}}`),
+					),
+				)
+			})
+
+			It("should return the job run", func() {
+				run, err := newJobRuns(server.URL()).CreateJobRun("p1", JobRunReq{JobName: "nightly", ScaleArraySpec: "0-4"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(run.Name).Should(Equal("nightly-run-1"))
+				Expect(run.StatusDetails.Requested).Should(Equal(5))
+			})
+		})
+	})
+	Describe("GetJobRun", func() {
+		Context("When some instances failed", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/projects/p1/job_runs/nightly-run-1"),
+						ghttp.RespondWith(http.StatusOK, `{"name": "nightly-run-1", "status": "failed", "status_details": {"succeeded": 4, "failed": 1, "failed_indices": "3"}}`),
+					),
+				)
+			})
+
+			It("should return the failed indices", func() {
+				run, err := newJobRuns(server.URL()).GetJobRun("p1", "nightly-run-1")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(run.StatusDetails.FailedIndices).Should(Equal("3"))
+			})
+		})
+	})
+	Describe("ListJobRuns", func() {
+		Context("When the runs of a job are listed", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/projects/p1/job_runs", "job_name=nightly&limit=100"),
+						ghttp.RespondWith(http.StatusOK, `{"limit": 100, "job_runs": [{"name": "nightly-run-1", "job_name": "nightly", "status": "completed"}]}`),
+					),
+				)
+			})
+
+			It("should filter the runs on the job name", func() {
+				runs, err := newJobRuns(server.URL()).ListJobRuns("p1", "nightly")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(runs).Should(HaveLen(1))
+				Expect(runs[0].JobName).Should(Equal("nightly"))
+			})
+		})
+	})
+})
+
+func newJobRuns(url string) JobRuns {
+	return newJobRunsAPI(newTestClient(url))
+}
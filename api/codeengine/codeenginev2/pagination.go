@@ -0,0 +1,52 @@
+package codeenginev2
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/IBM-Cloud/bluemix-go/client"
+)
+
+const listLimit = "100"
+
+type pageToken struct {
+	Href  string `json:"href"`
+	Start string `json:"start"`
+}
+
+type page struct {
+	Limit int        `json:"limit"`
+	Next  *pageToken `json:"next,omitempty"`
+}
+
+//listAll follows the start tokens of a list operation and calls cb with the raw body of every page,
+//filter holds the query parameters filtering the list, it can be nil
+func listAll(c *client.Client, path string, filter url.Values, cb func(raw []byte) error) error {
+	start := ""
+	for {
+		query := url.Values{}
+		for k, v := range filter {
+			query[k] = v
+		}
+		query.Set("limit", listLimit)
+		if start != "" {
+			query.Set("start", start)
+		}
+		var raw json.RawMessage
+		_, err := c.Get(path+"?"+query.Encode(), &raw)
+		if err != nil {
+			return err
+		}
+		if err := cb(raw); err != nil {
+			return err
+		}
+		var p page
+		if err := json.Unmarshal(raw, &p); err != nil {
+			return err
+		}
+		if p.Next == nil || p.Next.Start == "" {
+			return nil
+		}
+		start = p.Next.Start
+	}
+}
@@ -1,11 +1,16 @@
 package containerv1
 
 import (
+	"encoding/json"
 	"fmt"
 
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/client"
 )
 
+//ErrCodeAddonNotFound is returned when an add-on or one of its versions is unknown
+const ErrCodeAddonNotFound = "AddonNotFound"
+
 //AddOn ...
 type AddOn struct {
 	AllowedUpgradeVersion []string    `json:"allowed_upgrade_versions,omitempty"`
@@ -20,6 +25,9 @@ type AddOn struct {
 	TargetVersion         string      `json:"targetVersion,omitempty"`
 	Version               string      `json:"version,omitempty"`
 	VlanSpanningRequired  bool        `json:"vlan_spanning_required"`
+
+	//Parameters configure the add-on when it is installed or updated, see GetAddonOptions
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
 }
 
 //GetAddOns ...
@@ -40,10 +48,23 @@ type AddOnsResponse struct {
 	OrphanedAddons interface{} `json:"orphanedAddons,omitempty"`
 }
 
+//AddOnOptions are the parameters of a version of an add-on
+type AddOnOptions struct {
+	Name    string
+	Version string
+	//Defaults are the configurable parameters of the version and their default values
+	Defaults map[string]interface{}
+	//Values are the parameters set on the cluster, nil when the add-on is not installed
+	Values map[string]interface{}
+}
+
 //AddOns ...
 type AddOns interface {
 	GetAddons(clusterName string, target ClusterTargetHeader) ([]AddOn, error)
 	ConfigureAddons(clusterName string, params *ConfigureAddOns, target ClusterTargetHeader) (AddOnsResponse, error)
+	GetAddonOptions(clusterName, addonName, version string, target ClusterTargetHeader) (AddOnOptions, error)
+	InstallAddon(clusterName, addonName, version string, parameters map[string]interface{}, target ClusterTargetHeader) (AddOnsResponse, error)
+	UpdateAddon(clusterName, addonName, version string, parameters map[string]interface{}, target ClusterTargetHeader) (AddOnsResponse, error)
 }
 
 type addons struct {
@@ -75,3 +96,75 @@ func (r *addons) ConfigureAddons(clusterName string, params *ConfigureAddOns, ta
 	_, err := r.client.Patch(rawURL, params, &resp, target.ToMap())
 	return resp, err
 }
+
+//InstallAddon enables the version of the add-on on the cluster, configured
+//with the parameters. An empty version installs the default version.
+func (r *addons) InstallAddon(clusterName, addonName, version string, parameters map[string]interface{}, target ClusterTargetHeader) (AddOnsResponse, error) {
+	return r.ConfigureAddons(clusterName, &ConfigureAddOns{
+		AddonsList: []AddOn{{Name: addonName, Version: version, Parameters: parameters}},
+		Enable:     true,
+	}, target)
+}
+
+//UpdateAddon updates the add-on installed on the cluster to the version and
+//the parameters
+func (r *addons) UpdateAddon(clusterName, addonName, version string, parameters map[string]interface{}, target ClusterTargetHeader) (AddOnsResponse, error) {
+	return r.ConfigureAddons(clusterName, &ConfigureAddOns{
+		AddonsList: []AddOn{{Name: addonName, Version: version, Parameters: parameters}},
+		Update:     true,
+	}, target)
+}
+
+//GetAddonOptions returns the parameters of the version of the add-on, the
+//version installed on the cluster when version is empty
+func (r *addons) GetAddonOptions(clusterName, addonName, version string, target ClusterTargetHeader) (AddOnOptions, error) {
+	options := AddOnOptions{Name: addonName, Version: version}
+	installed, err := r.GetAddons(clusterName, target)
+	if err != nil {
+		return options, err
+	}
+	for _, a := range installed {
+		if a.Name == addonName && (version == "" || a.Version == version) {
+			options.Version = a.Version
+			if options.Values, err = optionsMap(a.Options); err != nil {
+				return options, err
+			}
+		}
+	}
+	if options.Version == "" {
+		return options, bmxerror.New(ErrCodeAddonNotFound,
+			fmt.Sprintf("Add-on %s is not installed on cluster %s, give the version of its options", addonName, clusterName))
+	}
+
+	var supported []AddOn
+	if _, err := r.client.Get("/v1/addons", &supported, target.ToMap()); err != nil {
+		return options, err
+	}
+	for _, a := range supported {
+		if a.Name == addonName && a.Version == options.Version {
+			options.Defaults, err = optionsMap(a.Options)
+			return options, err
+		}
+	}
+	return options, bmxerror.New(ErrCodeAddonNotFound,
+		fmt.Sprintf("Version %s of add-on %s is not supported", options.Version, addonName))
+}
+
+//optionsMap converts the options of an add-on decoded as an interface{}
+func optionsMap(options interface{}) (map[string]interface{}, error) {
+	if options == nil {
+		return nil, nil
+	}
+	if m, ok := options.(map[string]interface{}); ok {
+		return m, nil
+	}
+	raw, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, fmt.Errorf("Invalid add-on options: %v", err)
+	}
+	return m, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/onsi/gomega/ghttp"
 
 	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/client"
 	bluemixHttp "github.com/IBM-Cloud/bluemix-go/http"
 	"github.com/IBM-Cloud/bluemix-go/session"
@@ -172,6 +173,115 @@ var _ = Describe("AddOns", func() {
 		})
 	})
 
+	Describe("InstallAddon", func() {
+		BeforeEach(func() {
+			server = ghttp.NewServer()
+		})
+
+		It("should enable the add-on with its parameters", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPatch, "/v1/clusters/testcluster/addons"),
+					ghttp.VerifyJSON(`{
+						"addons": [{
+							"deprecated": false,
+							"name": "openshift-data-foundation",
+							"version": "4.16.0",
+							"parameters": {"odfDeploy": "true", "numOfOsd": "3"},
+							"vlan_spanning_required": false
+						}],
+						"enable": true,
+						"update": false
+					}`),
+					ghttp.RespondWith(http.StatusOK, `{}`),
+				),
+			)
+			_, err := newAddOns(server.URL()).InstallAddon("testcluster", "openshift-data-foundation", "4.16.0",
+				map[string]interface{}{"odfDeploy": "true", "numOfOsd": "3"}, ClusterTargetHeader{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("UpdateAddon", func() {
+		BeforeEach(func() {
+			server = ghttp.NewServer()
+		})
+
+		It("should update the add-on with its parameters", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPatch, "/v1/clusters/testcluster/addons"),
+					ghttp.VerifyJSON(`{
+						"addons": [{
+							"deprecated": false,
+							"name": "openshift-data-foundation",
+							"version": "4.16.0",
+							"parameters": {"ocsUpgrade": "true"},
+							"vlan_spanning_required": false
+						}],
+						"enable": false,
+						"update": true
+					}`),
+					ghttp.RespondWith(http.StatusOK, `{}`),
+				),
+			)
+			_, err := newAddOns(server.URL()).UpdateAddon("testcluster", "openshift-data-foundation", "4.16.0",
+				map[string]interface{}{"ocsUpgrade": "true"}, ClusterTargetHeader{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+		It("should return the error of the service", func() {
+			server.AppendHandlers(ghttp.RespondWith(http.StatusBadRequest, `{"code":"E0186","description":"Invalid add-on parameter"}`))
+			_, err := newAddOns(server.URL()).UpdateAddon("testcluster", "openshift-data-foundation", "4.16.0",
+				map[string]interface{}{"unknown": "true"}, ClusterTargetHeader{})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("GetAddonOptions", func() {
+		BeforeEach(func() {
+			server = ghttp.NewServer()
+		})
+		installed := ghttp.CombineHandlers(
+			ghttp.VerifyRequest(http.MethodGet, "/v1/clusters/testcluster/addons"),
+			ghttp.RespondWith(http.StatusOK, `[{"name": "openshift-data-foundation", "version": "4.16.0", "options": {"odfDeploy": "true", "numOfOsd": "1"}}]`),
+		)
+		supported := ghttp.CombineHandlers(
+			ghttp.VerifyRequest(http.MethodGet, "/v1/addons"),
+			ghttp.RespondWith(http.StatusOK, `[
+				{"name": "openshift-data-foundation", "version": "4.15.0", "options": {"odfDeploy": "true"}},
+				{"name": "openshift-data-foundation", "version": "4.16.0", "options": {"odfDeploy": "true", "numOfOsd": "1", "ocsUpgrade": "false"}},
+				{"name": "istio", "version": "1.22"}
+			]`),
+		)
+
+		It("should return the defaults and the values of the installed version", func() {
+			server.AppendHandlers(installed, supported)
+			options, err := newAddOns(server.URL()).GetAddonOptions("testcluster", "openshift-data-foundation", "", ClusterTargetHeader{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(options.Version).To(Equal("4.16.0"))
+			Expect(options.Defaults).To(Equal(map[string]interface{}{"odfDeploy": "true", "numOfOsd": "1", "ocsUpgrade": "false"}))
+			Expect(options.Values).To(Equal(map[string]interface{}{"odfDeploy": "true", "numOfOsd": "1"}))
+		})
+		It("should return the defaults of a version not installed", func() {
+			server.AppendHandlers(installed, supported)
+			options, err := newAddOns(server.URL()).GetAddonOptions("testcluster", "openshift-data-foundation", "4.15.0", ClusterTargetHeader{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(options.Defaults).To(Equal(map[string]interface{}{"odfDeploy": "true"}))
+			Expect(options.Values).To(BeNil())
+		})
+		It("should fail for an add-on not installed without a version", func() {
+			server.AppendHandlers(installed)
+			_, err := newAddOns(server.URL()).GetAddonOptions("testcluster", "istio", "", ClusterTargetHeader{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.(bmxerror.Error).Code()).To(Equal(ErrCodeAddonNotFound))
+		})
+		It("should fail for a version not supported", func() {
+			server.AppendHandlers(installed, supported)
+			_, err := newAddOns(server.URL()).GetAddonOptions("testcluster", "istio", "1.10", ClusterTargetHeader{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.(bmxerror.Error).Code()).To(Equal(ErrCodeAddonNotFound))
+		})
+	})
 })
 
 func newAddOns(url string) AddOns {
@@ -1,6 +1,7 @@
 package containerv1
 
 import (
+	"context"
 	gohttp "net/http"
 
 	bluemix "github.com/IBM-Cloud/bluemix-go"
@@ -27,6 +28,9 @@ type ContainerServiceAPI interface {
 	Kms() Kms
 	AddOns() AddOns
 	Apikeys() Apikeys
+
+	//WithContext returns a client whose requests are canceled when ctx is done
+	WithContext(ctx context.Context) ContainerServiceAPI
 }
 
 //ContainerService holds the client
@@ -34,6 +38,13 @@ type csService struct {
 	*client.Client
 }
 
+//WithContext ...
+func (c *csService) WithContext(ctx context.Context) ContainerServiceAPI {
+	return &csService{
+		Client: c.Client.WithContext(ctx),
+	}
+}
+
 //New ...
 func New(sess *session.Session) (ContainerServiceAPI, error) {
 	config := sess.Config.Copy()
@@ -73,6 +84,14 @@ func New(sess *session.Session) (ContainerServiceAPI, error) {
 	}, nil
 }
 
+//NewWithClient returns the container service API backed by an existing client,
+//so that it shares the configuration, token refresh and transport of that client
+func NewWithClient(c *client.Client) ContainerServiceAPI {
+	return &csService{
+		Client: c,
+	}
+}
+
 //Albs implement albs API
 func (c *csService) Albs() Albs {
 	return newAlbAPI(c.Client)
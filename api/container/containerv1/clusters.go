@@ -15,7 +15,6 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/client"
 	"github.com/IBM-Cloud/bluemix-go/helpers"
-	"github.com/IBM-Cloud/bluemix-go/trace"
 )
 
 //ClusterInfo ...
@@ -410,7 +409,7 @@ func (r *clusters) GetClusterConfig(name, dir string, admin bool, target Cluster
 		return "", fmt.Errorf("Error creating directory to download the cluster config")
 	}
 	downloadPath := filepath.Join(resultDir, "config.zip")
-	trace.Logger.Println("Will download the kubeconfig at", downloadPath)
+	r.client.Logger().Debug("Will download the kubeconfig", "path", downloadPath)
 
 	var out *os.File
 	if out, err = os.Create(downloadPath); err != nil {
@@ -422,8 +421,8 @@ func (r *clusters) GetClusterConfig(name, dir string, admin bool, target Cluster
 	if err != nil {
 		return "", err
 	}
-	trace.Logger.Println("Downloaded the kubeconfig at", downloadPath)
-	if err = helpers.Unzip(downloadPath, resultDir); err != nil {
+	r.client.Logger().Debug("Downloaded the kubeconfig", "path", downloadPath)
+	if err = helpers.ExtractArchive(downloadPath, resultDir); err != nil {
 		return "", err
 	}
 	defer helpers.RemoveFilesWithPattern(resultDir, "[^(.yml)|(.pem)]$")
@@ -461,7 +460,7 @@ func (r *clusters) GetClusterConfig(name, dir string, admin bool, target Cluster
 	}
 
 	if clusterInfo.Type == "openshift" {
-		trace.Logger.Println("Debug: type is openshift trying login to get token")
+		r.client.Logger().Debug("Cluster type is openshift, trying login to get token")
 		var yamlConfig []byte
 		if yamlConfig, err = ioutil.ReadFile(kubeyml); err != nil {
 			return "", err
@@ -496,7 +495,7 @@ func (r *clusters) GetClusterConfigDetail(name, dir string, admin bool, target C
 		return clusterkey, fmt.Errorf("Error creating directory to download the cluster config")
 	}
 	downloadPath := filepath.Join(resultDir, "config.zip")
-	trace.Logger.Println("Will download the kubeconfig at", downloadPath)
+	r.client.Logger().Debug("Will download the kubeconfig", "path", downloadPath)
 
 	var out *os.File
 	if out, err = os.Create(downloadPath); err != nil {
@@ -508,8 +507,8 @@ func (r *clusters) GetClusterConfigDetail(name, dir string, admin bool, target C
 	if err != nil {
 		return clusterkey, err
 	}
-	trace.Logger.Println("Downloaded the kubeconfig at", downloadPath)
-	if err = helpers.Unzip(downloadPath, resultDir); err != nil {
+	r.client.Logger().Debug("Downloaded the kubeconfig", "path", downloadPath)
+	if err = helpers.ExtractArchive(downloadPath, resultDir); err != nil {
 		return clusterkey, err
 	}
 	defer helpers.RemoveFilesWithPattern(resultDir, "[^(.yml)|(.pem)]$")
@@ -571,7 +570,7 @@ func (r *clusters) GetClusterConfigDetail(name, dir string, admin bool, target C
 	}
 
 	if clusterInfo.Type == "openshift" {
-		trace.Logger.Println("Debug: type is openshift trying login to get token")
+		r.client.Logger().Debug("Cluster type is openshift, trying login to get token")
 		var yamlConfig []byte
 		if yamlConfig, err = ioutil.ReadFile(kubeyml); err != nil {
 			return clusterkey, err
@@ -625,7 +624,7 @@ func (r *clusters) StoreConfig(name, dir string, admin, createCalicoConfig bool,
 		return "", "", fmt.Errorf("Error creating directory to download the cluster config")
 	}
 	downloadPath := filepath.Join(resultDir, "config.zip")
-	trace.Logger.Println("Will download the kubeconfig at", downloadPath)
+	r.client.Logger().Debug("Will download the kubeconfig", "path", downloadPath)
 
 	var out *os.File
 	if out, err = os.Create(downloadPath); err != nil {
@@ -637,17 +636,17 @@ func (r *clusters) StoreConfig(name, dir string, admin, createCalicoConfig bool,
 	if err != nil {
 		return "", "", err
 	}
-	trace.Logger.Println("Downloaded the kubeconfig at", downloadPath)
-	if err = helpers.Unzip(downloadPath, resultDir); err != nil {
+	r.client.Logger().Debug("Downloaded the kubeconfig", "path", downloadPath)
+	if err = helpers.ExtractArchive(downloadPath, resultDir); err != nil {
 		return "", "", err
 	}
-	trace.Logger.Println("Downloaded the kubec", resultDir)
+	r.client.Logger().Debug("Downloaded the kubeconfig", "dir", resultDir)
 
 	unzipConfigPath, err := kubeConfigDir(resultDir)
 	if err != nil {
 		return "", "", err
 	}
-	trace.Logger.Println("Located unzipped directory: ", unzipConfigPath)
+	r.client.Logger().Debug("Located unzipped directory", "path", unzipConfigPath)
 	files, _ := ioutil.ReadDir(unzipConfigPath)
 	for _, f := range files {
 		old := filepath.Join(unzipConfigPath, f.Name())
@@ -694,7 +693,7 @@ func (r *clusters) StoreConfig(name, dir string, admin, createCalicoConfig bool,
 	}
 
 	if clusterInfo.Type == "openshift" {
-		trace.Logger.Println("Cluster Type is openshift trying login to get token")
+		r.client.Logger().Debug("Cluster type is openshift, trying login to get token")
 		var yamlConfig []byte
 		if yamlConfig, err = ioutil.ReadFile(kubeconfigFileName); err != nil {
 			return "", "", err
@@ -731,7 +730,7 @@ func (r *clusters) StoreConfigDetail(name, dir string, admin, createCalicoConfig
 		return "", clusterkey, fmt.Errorf("Error creating directory to download the cluster config")
 	}
 	downloadPath := filepath.Join(resultDir, "config.zip")
-	trace.Logger.Println("Will download the kubeconfig at", downloadPath)
+	r.client.Logger().Debug("Will download the kubeconfig", "path", downloadPath)
 
 	var out *os.File
 	if out, err = os.Create(downloadPath); err != nil {
@@ -743,17 +742,17 @@ func (r *clusters) StoreConfigDetail(name, dir string, admin, createCalicoConfig
 	if err != nil {
 		return "", clusterkey, err
 	}
-	trace.Logger.Println("Downloaded the kubeconfig at", downloadPath)
-	if err = helpers.Unzip(downloadPath, resultDir); err != nil {
+	r.client.Logger().Debug("Downloaded the kubeconfig", "path", downloadPath)
+	if err = helpers.ExtractArchive(downloadPath, resultDir); err != nil {
 		return "", clusterkey, err
 	}
-	trace.Logger.Println("Downloaded the kubec", resultDir)
+	r.client.Logger().Debug("Downloaded the kubeconfig", "dir", resultDir)
 
 	unzipConfigPath, err := kubeConfigDir(resultDir)
 	if err != nil {
 		return "", clusterkey, err
 	}
-	trace.Logger.Println("Located unzipped directory: ", unzipConfigPath)
+	r.client.Logger().Debug("Located unzipped directory", "path", unzipConfigPath)
 	files, _ := ioutil.ReadDir(unzipConfigPath)
 	for _, f := range files {
 		fileContent, _ := ioutil.ReadFile(unzipConfigPath + "/" + f.Name())
@@ -823,7 +822,7 @@ func (r *clusters) StoreConfigDetail(name, dir string, admin, createCalicoConfig
 	}
 
 	if clusterInfo.Type == "openshift" {
-		trace.Logger.Println("Cluster Type is openshift trying login to get token")
+		r.client.Logger().Debug("Cluster type is openshift, trying login to get token")
 		var yamlConfig []byte
 		if yamlConfig, err = ioutil.ReadFile(kubeconfigFileName); err != nil {
 			return "", clusterkey, err
@@ -1,6 +1,7 @@
 package containerv1
 
 import (
+	"context"
 	"log"
 	"net/http"
 
@@ -892,6 +893,23 @@ var _ = Describe("Clusters", func() {
 		})
 	})
 	//
+	Describe("openShiftClient", func() {
+		It("should keep the context of the service client", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			c := newCluster("https://containers.cloud.ibm.com").(*clusters)
+			c.client.DefaultHeader = http.Header{"Authorization": {"Bearer token"}}
+			c.client = c.client.WithContext(ctx)
+
+			oc := c.openShiftClient(true)
+			Expect(oc.Context()).Should(Equal(ctx))
+			Expect(oc.Config.SSLDisable).Should(BeTrue())
+			Expect(c.client.Config.SSLDisable).Should(BeFalse())
+
+			oc.DefaultHeader.Set("Authorization", "Bearer other")
+			Expect(c.client.DefaultHeader.Get("Authorization")).Should(Equal("Bearer token"))
+		})
+	})
 })
 
 func newCluster(url string) Clusters {
@@ -29,9 +29,9 @@ import (
 
 	yaml "github.com/ghodss/yaml"
 
+	"github.com/IBM-Cloud/bluemix-go/client"
 	bxhttp "github.com/IBM-Cloud/bluemix-go/http"
 	"github.com/IBM-Cloud/bluemix-go/rest"
-	"github.com/IBM-Cloud/bluemix-go/trace"
 )
 
 const (
@@ -119,26 +119,16 @@ func (r *clusters) FetchOCTokenForKubeConfig(kubecfg []byte, cMeta *ClusterInfo,
 	}
 
 	var token string
-	trace.Logger.Println("Creating user passcode to login for getting oc token")
+	r.client.Logger().Debug("Creating user passcode to login for getting oc token")
 	passcode, err := r.client.TokenRefresher.GetPasscode()
 
 	authEP, err := func(meta *ClusterInfo) (*authEndpoints, error) {
 		request := rest.GetRequest(meta.ServerURL + "/.well-known/oauth-authorization-server")
 		var auth authEndpoints
-		tempVar := r.client.ServiceName
-		r.client.ServiceName = ""
-
-		tempSSL := r.client.Config.SSLDisable
-		tempClient := r.client.Config.HTTPClient
-		r.client.Config.SSLDisable = skipSSLVerification
-		r.client.Config.HTTPClient = bxhttp.NewHTTPClient(r.client.Config)
-
-		defer func() {
-			r.client.ServiceName = tempVar
-			r.client.Config.SSLDisable = tempSSL
-			r.client.Config.HTTPClient = tempClient
-		}()
-		resp, err := r.client.SendRequest(request, &auth)
+		c := r.openShiftClient(skipSSLVerification)
+		c.ServiceName = ""
+		c.TokenRefresher = nil
+		resp, err := c.SendRequest(request, &auth)
 		if err != nil {
 			return &auth, err
 		}
@@ -150,10 +140,13 @@ func (r *clusters) FetchOCTokenForKubeConfig(kubecfg []byte, cMeta *ClusterInfo,
 		auth.ServerURL = meta.ServerURL
 		return &auth, nil
 	}(cMeta)
+	if err != nil {
+		return kubecfg, err
+	}
 
-	trace.Logger.Println("Got authentication end points for getting oc token")
+	r.client.Logger().Debug("Got authentication end points for getting oc token")
 	token, uname, err := r.openShiftAuthorizePasscode(authEP, passcode, cMeta.IsStagingSatelliteCluster())
-	trace.Logger.Println("Got the token and user ", uname)
+	r.client.Logger().Debug("Got the token", "user", uname)
 	clusterName, _ := NormalizeName(authEP.ServerURL[len("https://"):len(authEP.ServerURL)]) //TODO deal with http
 	ccontext := "default/" + clusterName + "/" + uname
 	uname = uname + "/" + clusterName
@@ -185,6 +178,22 @@ func (r *clusters) FetchOCTokenForKubeConfig(kubecfg []byte, cMeta *ClusterInfo,
 	return kubecfg, nil
 }
 
+// openShiftClient returns a copy of the service client for the calls made to
+// the OpenShift cluster, so the shared client is never modified
+func (r *clusters) openShiftClient(skipSSLVerification bool) *client.Client {
+	config := r.client.Config.Copy()
+	config.SSLDisable = skipSSLVerification
+	config.HTTPClient = bxhttp.NewHTTPClient(config)
+	c := &client.Client{
+		Config:         config,
+		DefaultHeader:  r.client.DefaultHeader,
+		ServiceName:    r.client.ServiceName,
+		TokenRefresher: r.client.TokenRefresher,
+		ErrorHandler:   r.client.ErrorHandler,
+	}
+	return c.WithContext(r.client.Context())
+}
+
 // Never redirect. Let caller handle. This is an http.Client callback method (CheckRedirect)
 func neverRedirect(req *http.Request, via []*http.Request) error {
 	return http.ErrUseLastResponse
@@ -194,26 +203,16 @@ func (r *clusters) openShiftAuthorizePasscode(authEP *authEndpoints, passcode st
 	request := rest.GetRequest(authEP.AuthorizationEndpoint+"?response_type=token&client_id=openshift-challenging-client").
 		Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("passcode:%s", passcode))))
 
-	tempSSL := r.client.Config.SSLDisable
-	tempClient := r.client.Config.HTTPClient
-	r.client.Config.SSLDisable = skipSSLVerification
-	r.client.Config.HTTPClient = bxhttp.NewHTTPClient(r.client.Config)
-
 	// To never redirect for this call
-	tempVar := r.client.Config.HTTPClient.CheckRedirect
-	r.client.Config.HTTPClient.CheckRedirect = neverRedirect
-	defer func() {
-		r.client.Config.HTTPClient.CheckRedirect = tempVar
-		r.client.Config.SSLDisable = tempSSL
-		r.client.Config.HTTPClient = tempClient
-	}()
+	c := r.openShiftClient(skipSSLVerification)
+	c.Config.HTTPClient.CheckRedirect = neverRedirect
 
 	var respInterface interface{}
 	var resp *http.Response
 	var err error
 	for try := 1; try <= 3; try++ {
 		// bmxerror.NewRequestFailure("ServerErrorResponse", string(raw), resp.StatusCode)
-		resp, err = r.client.SendRequest(request, respInterface)
+		resp, err = c.SendRequest(request, respInterface)
 		if err != nil {
 			if resp.StatusCode != 302 {
 				return "", "", err
@@ -240,7 +239,7 @@ func (r *clusters) openShiftAuthorizePasscode(authEP *authEndpoints, passcode st
 		return "", "", err
 	}
 	token := val.Get("access_token")
-	trace.Logger.Println("Getting username after getting the token")
+	r.client.Logger().Debug("Getting username after getting the token")
 	name, err := r.getOpenShiftUser(authEP, token)
 	if err != nil {
 		return "", "", err
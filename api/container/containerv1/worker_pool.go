@@ -44,6 +44,14 @@ type WorkerPoolResponse struct {
 	ReasonForDelete string                  `json:"reasonForDelete"`
 	IsBalanced      bool                    `json:"isBalanced"`
 	Zones           WorkerPoolZoneResponses `json:"zones"`
+	Taints          map[string]string       `json:"taints,omitempty"`
+}
+
+// WorkerPoolTaintRequest provides the taints to set on a worker pool
+type WorkerPoolTaintRequest struct {
+	Cluster    string            `json:"cluster" description:"cluster name"`
+	WorkerPool string            `json:"workerpool" description:"worker Pool name"`
+	Taints     map[string]string `json:"taints" description:"map of taints that has to be applied on workerpool"`
 }
 
 // WorkerPoolResponses sorts WorkerPoolResponse by ID.
@@ -92,6 +100,11 @@ type WorkerPool interface {
 	AddZone(clusterNameOrID string, poolID string, workerPoolZone WorkerPoolZone, target ClusterTargetHeader) error
 	RemoveZone(clusterNameOrID, zone, poolID string, target ClusterTargetHeader) error
 	UpdateZoneNetwork(clusterNameOrID, zone, poolID, privateVlan, publicVlan string, target ClusterTargetHeader) error
+	UpdateWorkerPoolTaints(taintRequest WorkerPoolTaintRequest, target ClusterTargetHeader) error
+	SetWorkerPoolTaints(clusterNameOrID, workerPoolNameOrID string, taints []Taint, target ClusterTargetHeader) error
+	DeleteWorkerPoolTaints(clusterNameOrID, workerPoolNameOrID string, target ClusterTargetHeader) error
+	SetWorkerPoolLabels(labelRequest WorkerPoolLabelRequest, target ClusterTargetHeader) (map[string]string, error)
+	PatchWorkerPoolLabels(clusterNameOrID, workerPoolNameOrID string, set map[string]string, remove []string, target ClusterTargetHeader) (map[string]string, error)
 }
 
 type workerpool struct {
@@ -183,3 +196,10 @@ func (w *workerpool) UpdateZoneNetwork(clusterNameOrID, zone, poolID, privateVla
 	_, err := w.client.Patch(fmt.Sprintf("/v1/clusters/%s/workerpools/%s/zones/%s", clusterNameOrID, poolID, zone), body, nil, target.ToMap())
 	return err
 }
+
+// UpdateWorkerPoolTaints calls the API to update taints to a worker pool
+func (w *workerpool) UpdateWorkerPoolTaints(taintRequest WorkerPoolTaintRequest, target ClusterTargetHeader) error {
+	// Make the request, don't care about return value
+	_, err := w.client.Post("/v2/setWorkerPoolTaints", taintRequest, nil, target.ToMap())
+	return err
+}
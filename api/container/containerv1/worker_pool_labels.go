@@ -0,0 +1,47 @@
+package containerv1
+
+//WorkerPoolLabelRequest replaces the labels of a worker pool
+type WorkerPoolLabelRequest struct {
+	Cluster    string            `json:"cluster" description:"cluster name"`
+	WorkerPool string            `json:"workerpool" description:"worker Pool name"`
+	Labels     map[string]string `json:"labels" description:"map of labels that has to be applied on workerpool"`
+}
+
+// SetWorkerPoolLabels replaces the labels of a worker pool and returns the
+// labels the pool has once they are applied
+func (w *workerpool) SetWorkerPoolLabels(labelRequest WorkerPoolLabelRequest, target ClusterTargetHeader) (map[string]string, error) {
+	labels := labelRequest.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	if err := w.UpdateLabelsWorkerPool(labelRequest.Cluster, labelRequest.WorkerPool, labels, target); err != nil {
+		return nil, err
+	}
+	wp, err := w.GetWorkerPool(labelRequest.Cluster, labelRequest.WorkerPool, target)
+	return wp.Labels, err
+}
+
+// PatchWorkerPoolLabels adds or updates the labels in set and removes the
+// labels in remove, keeping the other labels of the worker pool. It returns
+// the labels the pool has once they are applied.
+func (w *workerpool) PatchWorkerPoolLabels(clusterNameOrID, workerPoolNameOrID string, set map[string]string, remove []string, target ClusterTargetHeader) (map[string]string, error) {
+	wp, err := w.GetWorkerPool(clusterNameOrID, workerPoolNameOrID, target)
+	if err != nil {
+		return nil, err
+	}
+	labels := map[string]string{}
+	for k, v := range wp.Labels {
+		labels[k] = v
+	}
+	for k, v := range set {
+		labels[k] = v
+	}
+	for _, k := range remove {
+		delete(labels, k)
+	}
+	return w.SetWorkerPoolLabels(WorkerPoolLabelRequest{
+		Cluster:    clusterNameOrID,
+		WorkerPool: workerPoolNameOrID,
+		Labels:     labels,
+	}, target)
+}
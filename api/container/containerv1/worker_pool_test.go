@@ -496,6 +496,55 @@ var _ = Describe("WorkerPool", func() {
 			})
 		})
 	})
+	Describe("UpdateWorkerPoolTaints", func() {
+		Context("When update of worker pool taints is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v2/setWorkerPoolTaints"),
+						ghttp.VerifyJSON(`{"cluster":"test","workerpool":"abc-123-def-ghi","taints":{"key":"value:NoSchedule"}}`),
+						ghttp.RespondWith(http.StatusOK, `{}`),
+					),
+				)
+			})
+
+			It("should update worker pool taints", func() {
+				target := ClusterTargetHeader{
+					AccountID: "ghi",
+					Region:    "eu-de",
+				}
+				params := WorkerPoolTaintRequest{
+					Cluster:    "test",
+					WorkerPool: "abc-123-def-ghi",
+					Taints:     map[string]string{"key": "value:NoSchedule"},
+				}
+				err := newWorkerPool(server.URL()).UpdateWorkerPoolTaints(params, target)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+		Context("When update of worker pool taints is failed", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.SetAllowUnhandledRequests(true)
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v2/setWorkerPoolTaints"),
+						ghttp.RespondWith(http.StatusInternalServerError, `Failed to update worker pool taints`),
+					),
+				)
+			})
+
+			It("should return error updating worker pool taints", func() {
+				params := WorkerPoolTaintRequest{
+					Cluster:    "test",
+					WorkerPool: "abc-123-def-ghi",
+				}
+				err := newWorkerPool(server.URL()).UpdateWorkerPoolTaints(params, ClusterTargetHeader{})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
 })
 
 func newWorkerPool(url string) WorkerPool {
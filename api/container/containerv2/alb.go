@@ -50,12 +50,16 @@ type alb struct {
 }
 
 //Clusters interface
+//go:generate counterfeiter . Alb
 type Alb interface {
 	CreateAlb(albCreateReq AlbCreateReq, target ClusterTargetHeader) (AlbCreateResp, error)
 	DisableAlb(disableAlbReq AlbConfig, target ClusterTargetHeader) error
 	EnableAlb(enableAlbReq AlbConfig, target ClusterTargetHeader) error
 	GetAlb(albid string, target ClusterTargetHeader) (AlbConfig, error)
 	ListClusterAlbs(clusterNameOrID string, target ClusterTargetHeader) ([]AlbConfig, error)
+	GetAlbHealth(clusterNameOrID string, target ClusterTargetHeader) ([]AlbHealth, error)
+	GetAlbAutoscale(clusterNameOrID, albID string, target ClusterTargetHeader) (AlbAutoscaleConfig, error)
+	SetAlbAutoscale(albAutoscaleReq AlbAutoscaleReq, target ClusterTargetHeader) error
 }
 
 func newAlbAPI(c *client.Client) Alb {
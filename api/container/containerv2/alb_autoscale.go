@@ -0,0 +1,37 @@
+package containerv2
+
+import "fmt"
+
+//AlbAutoscaleConfig is the horizontal pod autoscaling of an ALB
+type AlbAutoscaleConfig struct {
+	MinReplicas int `json:"minReplicas"`
+	MaxReplicas int `json:"maxReplicas"`
+	//CPUAverageUtilization is the CPU usage percentage the replicas are scaled to keep
+	CPUAverageUtilization int `json:"cpuAverageUtilization"`
+}
+
+//AlbAutoscaleReq sets the autoscaling of an ALB
+type AlbAutoscaleReq struct {
+	Cluster string             `json:"cluster"`
+	AlbID   string             `json:"albID"`
+	Config  AlbAutoscaleConfig `json:"config"`
+}
+
+type albAutoscaleResp struct {
+	Config AlbAutoscaleConfig `json:"config"`
+}
+
+//GetAlbAutoscale returns the autoscaling of an ALB
+func (r *alb) GetAlbAutoscale(clusterNameOrID, albID string, target ClusterTargetHeader) (AlbAutoscaleConfig, error) {
+	var successV albAutoscaleResp
+	rawURL := fmt.Sprintf("/v2/alb/getAlbAutoscaleConfiguration?cluster=%s&albID=%s", clusterNameOrID, albID)
+	_, err := r.client.Get(rawURL, &successV, target.ToMap())
+	return successV.Config, err
+}
+
+//SetAlbAutoscale sets the autoscaling of an ALB
+func (r *alb) SetAlbAutoscale(albAutoscaleReq AlbAutoscaleReq, target ClusterTargetHeader) error {
+	// Make the request, don't care about return value
+	_, err := r.client.Post("/v2/alb/setAlbAutoscaleConfiguration", albAutoscaleReq, nil, target.ToMap())
+	return err
+}
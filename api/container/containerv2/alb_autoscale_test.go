@@ -0,0 +1,95 @@
+package containerv2
+
+import (
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Alb autoscale", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("GetAlbAutoscale", func() {
+		Context("When reading the autoscaling is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/alb/getAlbAutoscaleConfiguration", "cluster=mycluster&albID=public-cr1-alb1"),
+						ghttp.RespondWith(http.StatusOK, `{"config":{"minReplicas":2,"maxReplicas":6,"cpuAverageUtilization":70}}`),
+					),
+				)
+			})
+
+			It("should return the autoscaling", func() {
+				config, err := newAlbs(server.URL()).GetAlbAutoscale("mycluster", "public-cr1-alb1", ClusterTargetHeader{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(config).To(Equal(AlbAutoscaleConfig{MinReplicas: 2, MaxReplicas: 6, CPUAverageUtilization: 70}))
+			})
+		})
+		Context("When reading the autoscaling is unsuccessful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.SetAllowUnhandledRequests(true)
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/alb/getAlbAutoscaleConfiguration"),
+						ghttp.RespondWith(http.StatusInternalServerError, `Failed to get autoscaling`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				_, err := newAlbs(server.URL()).GetAlbAutoscale("mycluster", "public-cr1-alb1", ClusterTargetHeader{})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("SetAlbAutoscale", func() {
+		Context("When setting the autoscaling is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v2/alb/setAlbAutoscaleConfiguration"),
+						ghttp.VerifyJSON(`{"cluster":"mycluster","albID":"public-cr1-alb1","config":{"minReplicas":2,"maxReplicas":6,"cpuAverageUtilization":70}}`),
+						ghttp.RespondWith(http.StatusNoContent, ``),
+					),
+				)
+			})
+
+			It("should set the autoscaling", func() {
+				err := newAlbs(server.URL()).SetAlbAutoscale(AlbAutoscaleReq{
+					Cluster: "mycluster",
+					AlbID:   "public-cr1-alb1",
+					Config:  AlbAutoscaleConfig{MinReplicas: 2, MaxReplicas: 6, CPUAverageUtilization: 70},
+				}, ClusterTargetHeader{})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+		Context("When setting the autoscaling is unsuccessful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.SetAllowUnhandledRequests(true)
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v2/alb/setAlbAutoscaleConfiguration"),
+						ghttp.RespondWith(http.StatusInternalServerError, `Failed to set autoscaling`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				err := newAlbs(server.URL()).SetAlbAutoscale(AlbAutoscaleReq{Cluster: "mycluster", AlbID: "public-cr1-alb1"}, ClusterTargetHeader{})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,55 @@
+package containerv2
+
+import "strings"
+
+//AlbHealth is the health of an ALB of a cluster
+type AlbHealth struct {
+	AlbID                string
+	AlbType              string
+	ZoneAlb              string
+	Enable               bool
+	State                string
+	Status               string
+	LoadBalancerHostname string
+	//Healthy is true when the ALB is enabled, healthy and the ingress status
+	//report lists no problem for it
+	Healthy bool
+	//Reasons are the problems the ingress status report lists for the ALB
+	Reasons []string
+}
+
+//GetAlbHealth returns the health of the ALBs of a cluster
+func (r *alb) GetAlbHealth(clusterNameOrID string, target ClusterTargetHeader) ([]AlbHealth, error) {
+	albs, err := r.ListClusterAlbs(clusterNameOrID, target)
+	if err != nil {
+		return nil, err
+	}
+	status, err := getIngressStatus(r.client, clusterNameOrID, target.ToMap())
+	if err != nil {
+		return nil, err
+	}
+	reasons := make(map[string][]string, len(status.ALBStatus))
+	for _, s := range status.ALBStatus {
+		for _, reason := range s.Status {
+			if !strings.EqualFold(reason, statusHealthy) {
+				reasons[s.Component] = append(reasons[s.Component], reason)
+			}
+		}
+	}
+	health := make([]AlbHealth, 0, len(albs))
+	for _, a := range albs {
+		h := AlbHealth{
+			AlbID:                a.AlbID,
+			AlbType:              a.AlbType,
+			ZoneAlb:              a.ZoneAlb,
+			Enable:               a.Enable,
+			State:                a.State,
+			Status:               a.Status,
+			LoadBalancerHostname: a.LoadBalancerHostname,
+			Reasons:              reasons[a.AlbID],
+		}
+		h.Healthy = a.Enable && strings.EqualFold(a.Status, statusHealthy) && len(h.Reasons) == 0
+		health = append(health, h)
+	}
+	return health, nil
+}
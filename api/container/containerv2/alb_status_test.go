@@ -0,0 +1,78 @@
+package containerv2
+
+import (
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Alb health", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("GetAlbHealth", func() {
+		Context("When the ALBs and the ingress status are read", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/alb/getClusterAlbs", "cluster=mycluster"),
+						ghttp.RespondWith(http.StatusOK, `{"alb":[
+							{"albID":"public-cr1-alb1","albType":"public","zone":"us-south-1","enable":true,"state":"enabled","status":"healthy","loadBalancerHostname":"abc-us-south.lb.appdomain.cloud"},
+							{"albID":"public-cr1-alb2","albType":"public","zone":"us-south-2","enable":true,"state":"enabled","status":"critical","loadBalancerHostname":"abc-us-south.lb.appdomain.cloud"},
+							{"albID":"private-cr1-alb1","albType":"private","zone":"us-south-1","enable":false,"state":"disabled"}
+						]}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/alb/getStatus", "cluster=mycluster"),
+						ghttp.RespondWith(http.StatusOK, `{"cluster":"mycluster","enabled":true,"status":"critical","albStatus":[
+							{"component":"public-cr1-alb1","status":["healthy"]},
+							{"component":"public-cr1-alb2","status":["ALB pods are not running","healthy"]}
+						]}`),
+					),
+				)
+			})
+
+			It("should return the health of every ALB", func() {
+				health, err := newAlbs(server.URL()).GetAlbHealth("mycluster", ClusterTargetHeader{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(health).To(HaveLen(3))
+				Expect(health[0].AlbID).To(Equal("public-cr1-alb1"))
+				Expect(health[0].LoadBalancerHostname).To(Equal("abc-us-south.lb.appdomain.cloud"))
+				Expect(health[0].Healthy).To(BeTrue())
+				Expect(health[0].Reasons).To(BeEmpty())
+				Expect(health[1].Healthy).To(BeFalse())
+				Expect(health[1].Status).To(Equal("critical"))
+				Expect(health[1].Reasons).To(Equal([]string{"ALB pods are not running"}))
+				Expect(health[2].State).To(Equal("disabled"))
+				Expect(health[2].Healthy).To(BeFalse())
+			})
+		})
+		Context("When the ingress status cannot be read", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.SetAllowUnhandledRequests(true)
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/alb/getClusterAlbs", "cluster=mycluster"),
+						ghttp.RespondWith(http.StatusOK, `{"alb":[{"albID":"public-cr1-alb1","enable":true,"status":"healthy"}]}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/alb/getStatus", "cluster=mycluster"),
+						ghttp.RespondWith(http.StatusInternalServerError, `Failed to get the ingress status`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				_, err := newAlbs(server.URL()).GetAlbHealth("mycluster", ClusterTargetHeader{})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
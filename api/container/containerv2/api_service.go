@@ -1,6 +1,7 @@
 package containerv2
 
 import (
+	"context"
 	gohttp "net/http"
 
 	bluemix "github.com/IBM-Cloud/bluemix-go"
@@ -15,6 +16,7 @@ import (
 const ErrCodeAPICreation = "APICreationError"
 
 //ContainerServiceAPI is the Aramda K8s client ...
+//go:generate counterfeiter . ContainerServiceAPI
 type ContainerServiceAPI interface {
 	Monitoring() Monitoring
 	Logging() Logging
@@ -31,6 +33,15 @@ type ContainerServiceAPI interface {
 	DedicatedHost() DedicatedHost
 	DedicatedHostPool() DedicatedHostPool
 	DedicatedHostFlavor() DedicatedHostFlavor
+	Events() Events
+	Zones() Zones
+	Flavors() Flavors
+	Versions() Versions
+
+	//WithContext returns a client whose requests are canceled when ctx is done
+	WithContext(ctx context.Context) ContainerServiceAPI
+	//ResolveTarget resolves the resource group name of target to its ID
+	ResolveTarget(target ClusterTargetHeader) (ClusterTargetHeader, error)
 
 	//TODO Add other services
 }
@@ -38,6 +49,16 @@ type ContainerServiceAPI interface {
 //VpcContainerService holds the client
 type csService struct {
 	*client.Client
+
+	resolver *resourceGroupResolver
+}
+
+//WithContext ...
+func (c *csService) WithContext(ctx context.Context) ContainerServiceAPI {
+	return &csService{
+		Client:   c.Client.WithContext(ctx),
+		resolver: c.resolver,
+	}
 }
 
 //New ...
@@ -74,8 +95,11 @@ func New(sess *session.Session) (ContainerServiceAPI, error) {
 		config.Endpoint = &ep
 	}
 
+	c := client.New(config, bluemix.VpcContainerService, tokenRefreher)
+	c.ErrorHandler = toServiceError
 	return &csService{
-		Client: client.New(config, bluemix.VpcContainerService, tokenRefreher),
+		Client:   c,
+		resolver: newSessionResourceGroupResolver(sess),
 	}, nil
 }
 
@@ -147,3 +171,23 @@ func (c *csService) DedicatedHostPool() DedicatedHostPool {
 func (c *csService) DedicatedHostFlavor() DedicatedHostFlavor {
 	return newDedicatedHostFlavorAPI(c.Client)
 }
+
+//Events implements Cluster Events API
+func (c *csService) Events() Events {
+	return newEventsAPI(c.Client)
+}
+
+//Zones implements Zones API
+func (c *csService) Zones() Zones {
+	return newZonesAPI(c.Client)
+}
+
+//Flavors implements Flavors API
+func (c *csService) Flavors() Flavors {
+	return newFlavorsAPI(c.Client)
+}
+
+//Versions implements Versions API
+func (c *csService) Versions() Versions {
+	return newVersionsAPI(c.Client)
+}
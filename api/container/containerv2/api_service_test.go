@@ -0,0 +1,71 @@
+package containerv2
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/client"
+	bluemixHttp "github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/session"
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ContainerServiceAPI", func() {
+	var server *ghttp.Server
+	var release chan struct{}
+	BeforeEach(func() {
+		release = make(chan struct{})
+		server = ghttp.NewServer()
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/v2/getCluster"),
+				func(w http.ResponseWriter, r *http.Request) {
+					select {
+					case <-release:
+					case <-time.After(5 * time.Second):
+					}
+				},
+				ghttp.RespondWith(http.StatusOK, `{"id": "c1"}`),
+			),
+		)
+	})
+	AfterEach(func() {
+		close(release)
+		server.Close()
+	})
+
+	Describe("WithContext", func() {
+		It("should cancel the requests when the context is done", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			start := time.Now()
+			_, err := newContainerService(server.URL()).WithContext(ctx).Clusters().GetCluster("c1", ClusterTargetHeader{})
+			Expect(err).To(HaveOccurred())
+			Expect(ctx.Err()).Should(Equal(context.DeadlineExceeded))
+			Expect(time.Since(start)).Should(BeNumerically("<", 2*time.Second))
+		})
+	})
+})
+
+func newContainerService(url string) ContainerServiceAPI {
+	sess, err := session.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+	conf := sess.Config.Copy()
+	conf.HTTPClient = bluemixHttp.NewHTTPClient(conf)
+	conf.Endpoint = &url
+
+	return &csService{
+		Client: &client.Client{
+			Config:      conf,
+			ServiceName: bluemix.VpcContainerService,
+		},
+	}
+}
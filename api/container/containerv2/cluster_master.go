@@ -0,0 +1,36 @@
+package containerv2
+
+import (
+	"fmt"
+	"net/url"
+)
+
+type masterUpdateRequest struct {
+	Action  string `json:"action"`
+	Force   bool   `json:"force"`
+	Version string `json:"version"`
+}
+
+type masterActionRequest struct {
+	Action string `json:"action"`
+}
+
+//UpdateMaster starts the update of the cluster master to the version, such as
+//1.30 or 4.15_openshift. force skips the checks of the service, such as the
+//update of more than one minor version. The update is asynchronous, the
+//TargetVersion of the cluster is the version the master is updating to.
+//IKS exposes the master update only through the v1 cluster API, which serves
+//classic and VPC clusters alike.
+func (r *clusters) UpdateMaster(name, version string, force bool, target ClusterTargetHeader) error {
+	rawURL := fmt.Sprintf("/v1/clusters/%s", url.PathEscape(name))
+	_, err := r.client.Put(rawURL, masterUpdateRequest{Action: "update", Force: force, Version: version}, nil, target.ToMap())
+	return err
+}
+
+//RefreshMaster restarts the API servers of the cluster master, for instance
+//to apply a configuration change
+func (r *clusters) RefreshMaster(name string, target ClusterTargetHeader) error {
+	rawURL := fmt.Sprintf("/v1/clusters/%s/masters", url.PathEscape(name))
+	_, err := r.client.Put(rawURL, masterActionRequest{Action: "refresh"}, nil, target.ToMap())
+	return err
+}
@@ -0,0 +1,73 @@
+package containerv2
+
+import (
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cluster master", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("UpdateMaster", func() {
+		Context("When the update is started", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, "/v1/clusters/c1"),
+						ghttp.VerifyJSON(`{"action": "update", "force": false, "version": "1.30"}`),
+						ghttp.RespondWith(http.StatusNoContent, ``),
+					),
+				)
+			})
+
+			It("should not return error", func() {
+				err := newCluster(server.URL()).UpdateMaster("c1", "1.30", false, ClusterTargetHeader{})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+		Context("When the version is not supported", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, "/v1/clusters/c1"),
+						ghttp.RespondWith(http.StatusBadRequest, `{"code": "E0052", "description": "The version is not supported"}`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				err := newCluster(server.URL()).UpdateMaster("c1", "1.20", true, ClusterTargetHeader{})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("RefreshMaster", func() {
+		Context("When the API servers are refreshed", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, "/v1/clusters/c1/masters"),
+						ghttp.VerifyJSON(`{"action": "refresh"}`),
+						ghttp.RespondWith(http.StatusNoContent, ``),
+					),
+				)
+			})
+
+			It("should not return error", func() {
+				err := newCluster(server.URL()).RefreshMaster("c1", ClusterTargetHeader{})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,41 @@
+package containerv2
+
+import (
+	"fmt"
+	"net/url"
+)
+
+//ClusterUpdatePolicy describes how the master of the cluster is patched
+type ClusterUpdatePolicy struct {
+	//MasterAutoUpdate is true when master patch updates are applied automatically
+	MasterAutoUpdate bool
+	MasterVersion    string
+	//TargetVersion is the version the master is updating to, if any
+	TargetVersion string
+}
+
+type updatePolicyRequest struct {
+	AutoUpdateEnabled bool `json:"autoUpdateEnabled"`
+}
+
+//GetUpdatePolicy returns the automatic update settings of the cluster master.
+//Worker nodes are never updated automatically and the service has no
+//configurable maintenance window, the master patches are applied by IBM.
+func (r *clusters) GetUpdatePolicy(name string, target ClusterTargetHeader) (ClusterUpdatePolicy, error) {
+	cluster, err := r.GetCluster(name, target)
+	if err != nil {
+		return ClusterUpdatePolicy{}, err
+	}
+	return ClusterUpdatePolicy{
+		MasterAutoUpdate: !cluster.DisableAutoUpdate,
+		MasterVersion:    cluster.MasterKubeVersion,
+		TargetVersion:    cluster.TargetVersion,
+	}, nil
+}
+
+//SetMasterAutoUpdate enables or disables the automatic master patch updates
+func (r *clusters) SetMasterAutoUpdate(name string, enabled bool, target ClusterTargetHeader) error {
+	rawURL := fmt.Sprintf("/v1/clusters/%s/updatepolicy", url.PathEscape(name))
+	_, err := r.client.Put(rawURL, updatePolicyRequest{AutoUpdateEnabled: enabled}, nil, target.ToMap())
+	return err
+}
@@ -0,0 +1,74 @@
+package containerv2
+
+import (
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cluster update policy", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("GetUpdatePolicy", func() {
+		Context("When master auto update is disabled", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/getCluster", "cluster=c1&v1-compatible"),
+						ghttp.RespondWith(http.StatusOK, `{"id": "c1", "disableAutoUpdate": true, "masterKubeVersion": "1.27.8", "targetVersion": "1.27.9"}`),
+					),
+				)
+			})
+
+			It("should return the policy", func() {
+				policy, err := newCluster(server.URL()).GetUpdatePolicy("c1", ClusterTargetHeader{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(policy.MasterAutoUpdate).Should(BeFalse())
+				Expect(policy.MasterVersion).Should(Equal("1.27.8"))
+				Expect(policy.TargetVersion).Should(Equal("1.27.9"))
+			})
+		})
+	})
+	Describe("SetMasterAutoUpdate", func() {
+		Context("When the policy is updated", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, "/v1/clusters/c1/updatepolicy"),
+						ghttp.VerifyJSON(`{"autoUpdateEnabled": true}`),
+						ghttp.RespondWith(http.StatusNoContent, ``),
+					),
+				)
+			})
+
+			It("should not return error", func() {
+				err := newCluster(server.URL()).SetMasterAutoUpdate("c1", true, ClusterTargetHeader{})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+		Context("When the update fails", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, "/v1/clusters/c1/updatepolicy"),
+						ghttp.RespondWith(http.StatusForbidden, `{"code": "E0035", "description": "Not authorized"}`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				err := newCluster(server.URL()).SetMasterAutoUpdate("c1", false, ClusterTargetHeader{})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
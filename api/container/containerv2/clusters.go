@@ -15,7 +15,6 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
 	"github.com/IBM-Cloud/bluemix-go/client"
 	"github.com/IBM-Cloud/bluemix-go/helpers"
-	"github.com/IBM-Cloud/bluemix-go/trace"
 )
 
 //ClusterCreateRequest ...
@@ -112,7 +111,11 @@ type LifeCycleInfo struct {
 type ClusterTargetHeader struct {
 	AccountID     string
 	ResourceGroup string
-	Provider      string // supported providers e.g vpc-classic , vpc-gen2, satellite
+	// ResourceGroupName is resolved to the resource group ID by ContainerServiceAPI.ResolveTarget.
+	// When it was not resolved the name is sent as is, so that the service rejects the
+	// request instead of silently targeting the default resource group.
+	ResourceGroupName string
+	Provider          string // supported providers e.g vpc-classic , vpc-gen2, satellite
 }
 type Endpoints struct {
 	PrivateServiceEndpointEnabled bool   `json:"privateServiceEndpointEnabled"`
@@ -132,6 +135,7 @@ type ClusterCreateResponse struct {
 }
 
 //Clusters interface
+//go:generate counterfeiter . Clusters
 type Clusters interface {
 	Create(params ClusterCreateRequest, target ClusterTargetHeader) (ClusterCreateResponse, error)
 	List(target ClusterTargetHeader) ([]ClusterInfo, error)
@@ -139,8 +143,15 @@ type Clusters interface {
 	GetCluster(name string, target ClusterTargetHeader) (*ClusterInfo, error)
 	GetClusterConfigDetail(name, homeDir string, admin bool, target ClusterTargetHeader) (containerv1.ClusterKeyInfo, error)
 	StoreConfigDetail(name, baseDir string, admin bool, createCalicoConfig bool, target ClusterTargetHeader) (string, containerv1.ClusterKeyInfo, error)
+	StoreEncryptedConfigDetail(name, baseDir string, admin bool, key []byte, target ClusterTargetHeader) (containerv1.ClusterKeyInfo, error)
+	RefreshKubeConfigToken(name string, kubeconfig []byte, target ClusterTargetHeader) ([]byte, containerv1.ClusterKeyInfo, error)
 	EnableImageSecurityEnforcement(name string, target ClusterTargetHeader) error
 	DisableImageSecurityEnforcement(name string, target ClusterTargetHeader) error
+	ValidateClusterCreate(params ClusterCreateRequest, target ClusterTargetHeader) ([]ClusterValidationProblem, error)
+	GetUpdatePolicy(name string, target ClusterTargetHeader) (ClusterUpdatePolicy, error)
+	SetMasterAutoUpdate(name string, enabled bool, target ClusterTargetHeader) error
+	UpdateMaster(name, version string, force bool, target ClusterTargetHeader) error
+	RefreshMaster(name string, target ClusterTargetHeader) error
 	//TODO Add other opertaions
 }
 type clusters struct {
@@ -158,6 +169,9 @@ func (c ClusterTargetHeader) ToMap() map[string]string {
 	m := make(map[string]string, 3)
 	m[accountIDHeader] = c.AccountID
 	m[resourceGroupHeader] = c.ResourceGroup
+	if c.ResourceGroup == "" {
+		m[resourceGroupHeader] = c.ResourceGroupName
+	}
 	return m
 }
 
@@ -188,7 +202,7 @@ func (r *clusters) List(target ClusterTargetHeader) ([]ClusterInfo, error) {
 		_, err = r.client.Get("/v2/satellite/getClusters", &satelliteClusters, target.ToMap())
 		if err != nil && target.Provider == "satellite" {
 			// return error only when provider is satellite. Else ignore error and return VPC clusters
-			trace.Logger.Println("Unable to get the satellite clusters ", err)
+			r.client.Logger().Warn("Unable to get the satellite clusters", "error", err)
 			return nil, err
 		}
 		clusters = append(clusters, satelliteClusters...)
@@ -278,7 +292,7 @@ func (r *clusters) GetClusterConfigDetail(name, dir string, admin bool, target C
 		return clusterkey, fmt.Errorf("Error creating directory to download the cluster config")
 	}
 	downloadPath := filepath.Join(resultDir, "config.zip")
-	trace.Logger.Println("Will download the kubeconfig at", downloadPath)
+	r.client.Logger().Debug("Will download the kubeconfig", "path", downloadPath)
 
 	var out *os.File
 	if out, err = os.Create(downloadPath); err != nil {
@@ -290,8 +304,8 @@ func (r *clusters) GetClusterConfigDetail(name, dir string, admin bool, target C
 	if err != nil {
 		return clusterkey, err
 	}
-	trace.Logger.Println("Downloaded the kubeconfig at", downloadPath)
-	if err = helpers.Unzip(downloadPath, resultDir); err != nil {
+	r.client.Logger().Debug("Downloaded the kubeconfig", "path", downloadPath)
+	if err = helpers.ExtractArchive(downloadPath, resultDir); err != nil {
 		return clusterkey, err
 	}
 	defer helpers.RemoveFilesWithPattern(resultDir, "[^(.yml)|(.pem)]$")
@@ -348,7 +362,7 @@ func (r *clusters) GetClusterConfigDetail(name, dir string, admin bool, target C
 		return clusterkey, err
 	}
 	if clusterInfo.Type == "openshift" && clusterInfo.Provider != "satellite" {
-		trace.Logger.Println("Debug: type is openshift trying login to get token")
+		r.client.Logger().Debug("Cluster type is openshift, trying login to get token")
 		var yamlConfig []byte
 		if yamlConfig, err = ioutil.ReadFile(kubeyml); err != nil {
 			return clusterkey, err
@@ -416,7 +430,7 @@ func (r *clusters) StoreConfigDetail(name, dir string, admin, createCalicoConfig
 		return "", clusterkey, fmt.Errorf("Error creating directory to download the cluster config")
 	}
 	downloadPath := filepath.Join(resultDir, "config.zip")
-	trace.Logger.Println("Will download the kubeconfig at", downloadPath)
+	r.client.Logger().Debug("Will download the kubeconfig", "path", downloadPath)
 
 	var out *os.File
 	if out, err = os.Create(downloadPath); err != nil {
@@ -428,14 +442,14 @@ func (r *clusters) StoreConfigDetail(name, dir string, admin, createCalicoConfig
 	if err != nil {
 		return "", clusterkey, err
 	}
-	trace.Logger.Println("Downloaded the kubeconfig at", downloadPath)
-	if err = helpers.Unzip(downloadPath, resultDir); err != nil {
+	r.client.Logger().Debug("Downloaded the kubeconfig", "path", downloadPath)
+	if err = helpers.ExtractArchive(downloadPath, resultDir); err != nil {
 		return "", clusterkey, err
 	}
-	trace.Logger.Println("Downloaded the kubec", resultDir)
+	r.client.Logger().Debug("Downloaded the kubeconfig", "dir", resultDir)
 
 	unzipConfigPath := resultDir
-	trace.Logger.Println("Located unzipped directory: ", unzipConfigPath)
+	r.client.Logger().Debug("Located unzipped directory", "path", unzipConfigPath)
 	files, _ := ioutil.ReadDir(unzipConfigPath)
 	for _, f := range files {
 		if !strings.HasSuffix(f.Name(), ".zip") {
@@ -502,7 +516,7 @@ func (r *clusters) StoreConfigDetail(name, dir string, admin, createCalicoConfig
 	}
 
 	if clusterInfo.Type == "openshift" && clusterInfo.Provider != "satellite" {
-		trace.Logger.Println("Cluster Type is openshift trying login to get token")
+		r.client.Logger().Debug("Cluster type is openshift, trying login to get token")
 		var yamlConfig []byte
 		if yamlConfig, err = ioutil.ReadFile(kubeconfigFileName); err != nil {
 			return "", clusterkey, err
@@ -0,0 +1,32 @@
+package containerv2
+
+import (
+	"fmt"
+	"strings"
+)
+
+const clusterTypeOpenShift = "openshift"
+
+//IsOpenShift reports whether the cluster is a Red Hat OpenShift cluster
+func (r *ClusterInfo) IsOpenShift() bool {
+	return r.Type == clusterTypeOpenShift
+}
+
+//OpenShiftVersion returns the OpenShift version of the master, such as
+//4.15.45 for 4.15.45_openshift, empty for the Kubernetes clusters. The version
+//is changed with UpdateMaster, passing the version with the _openshift suffix.
+func (r *ClusterInfo) OpenShiftVersion() string {
+	if !r.IsOpenShift() {
+		return ""
+	}
+	return strings.TrimSuffix(r.MasterKubeVersion, openshiftSuffix)
+}
+
+//OpenShiftConsoleURL returns the URL of the OpenShift web console, which is
+//exposed on the ingress subdomain of the cluster
+func (r *ClusterInfo) OpenShiftConsoleURL() string {
+	if !r.IsOpenShift() || r.Ingress.HostName == "" {
+		return ""
+	}
+	return fmt.Sprintf("https://console-openshift-console.%s", r.Ingress.HostName)
+}
@@ -0,0 +1,68 @@
+package containerv2
+
+import (
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OpenShift clusters", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("GetCluster", func() {
+		Context("When the cluster is an OpenShift cluster", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/getCluster"),
+						ghttp.RespondWith(http.StatusOK, `{
+							"id": "roks1",
+							"type": "openshift",
+							"masterKubeVersion": "4.15.45_openshift",
+							"ingress": {"hostname": "roks1.us-south.containers.appdomain.cloud"}
+						}`),
+					),
+				)
+			})
+
+			It("should return the OpenShift details", func() {
+				cluster, err := newCluster(server.URL()).GetCluster("roks1", ClusterTargetHeader{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cluster.IsOpenShift()).To(BeTrue())
+				Expect(cluster.OpenShiftVersion()).To(Equal("4.15.45"))
+				Expect(cluster.OpenShiftConsoleURL()).To(Equal("https://console-openshift-console.roks1.us-south.containers.appdomain.cloud"))
+			})
+		})
+		Context("When the cluster is a Kubernetes cluster", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/getCluster"),
+						ghttp.RespondWith(http.StatusOK, `{
+							"id": "iks1",
+							"type": "kubernetes",
+							"masterKubeVersion": "1.30.4_1529",
+							"ingress": {"hostname": "iks1.us-south.containers.appdomain.cloud"}
+						}`),
+					),
+				)
+			})
+
+			It("should return no OpenShift details", func() {
+				cluster, err := newCluster(server.URL()).GetCluster("iks1", ClusterTargetHeader{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cluster.IsOpenShift()).To(BeFalse())
+				Expect(cluster.OpenShiftVersion()).To(BeEmpty())
+				Expect(cluster.OpenShiftConsoleURL()).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,66 @@
+package containerv2
+
+import (
+	"fmt"
+)
+
+//ClusterValidationProblem describes a single reason a cluster create request would fail
+type ClusterValidationProblem struct {
+	Field   string
+	Message string
+}
+
+func (p ClusterValidationProblem) String() string {
+	if p.Field == "" {
+		return p.Message
+	}
+	return fmt.Sprintf("%s: %s", p.Field, p.Message)
+}
+
+//ValidateClusterCreate checks params without creating the cluster. IKS has no
+//dry-run or validation endpoint for cluster creation, so only client side
+//checks are performed: quota, flavor availability and similar problems are
+//reported by CreateCluster itself. The request is never sent to the service,
+//target and the error are kept for when a validation endpoint is available.
+func (r *clusters) ValidateClusterCreate(params ClusterCreateRequest, target ClusterTargetHeader) ([]ClusterValidationProblem, error) {
+	return validateClusterCreateRequest(params), nil
+}
+
+func validateClusterCreateRequest(params ClusterCreateRequest) []ClusterValidationProblem {
+	problems := []ClusterValidationProblem{}
+	add := func(field, message string) {
+		problems = append(problems, ClusterValidationProblem{Field: field, Message: message})
+	}
+	if params.Name == "" {
+		add("name", "a cluster name is required")
+	}
+	if params.Provider == "" {
+		add("provider", "a provider is required")
+	}
+	wp := params.WorkerPools
+	if wp.Flavor == "" {
+		add("workerPool.flavor", "a worker flavor is required")
+	}
+	if wp.VpcID == "" {
+		add("workerPool.vpcID", "a VPC ID is required")
+	}
+	if wp.WorkerCount < 1 {
+		add("workerPool.workerCount", "at least one worker per zone is required")
+	}
+	if len(wp.Zones) == 0 {
+		add("workerPool.zones", "at least one zone is required")
+	}
+	seen := map[string]bool{}
+	for i, z := range wp.Zones {
+		if z.ID == "" {
+			add(fmt.Sprintf("workerPool.zones[%d].id", i), "a zone ID is required")
+		} else if seen[z.ID] {
+			add(fmt.Sprintf("workerPool.zones[%d].id", i), fmt.Sprintf("zone %s is specified more than once", z.ID))
+		}
+		seen[z.ID] = true
+		if z.SubnetID == "" {
+			add(fmt.Sprintf("workerPool.zones[%d].subnetID", i), "a subnet ID is required")
+		}
+	}
+	return problems
+}
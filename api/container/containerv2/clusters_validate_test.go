@@ -0,0 +1,61 @@
+package containerv2
+
+import (
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cluster create validation", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+
+	validRequest := func() ClusterCreateRequest {
+		return ClusterCreateRequest{
+			Name:     "mycluster",
+			Provider: "vpc-gen2",
+			WorkerPools: WorkerPoolConfig{
+				CommonWorkerPoolConfig: CommonWorkerPoolConfig{
+					Flavor:      "bx2.4x16",
+					VpcID:       "6015365a-9d93-4bb4-8248-79ae0db2dc26",
+					WorkerCount: 1,
+					Zones:       []Zone{{ID: "us-south-1", SubnetID: "subnet1"}},
+				},
+			},
+		}
+	}
+
+	Describe("ValidateClusterCreate", func() {
+		Context("When the request is incomplete", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+			})
+
+			It("should report every problem without calling the service", func() {
+				params := validRequest()
+				params.Name = ""
+				params.WorkerPools.Zones = append(params.WorkerPools.Zones, Zone{ID: "us-south-1"})
+				problems, err := newCluster(server.URL()).ValidateClusterCreate(params, ClusterTargetHeader{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(problems).To(HaveLen(3))
+				Expect(problems[0].Field).To(Equal("name"))
+				Expect(server.ReceivedRequests()).To(BeEmpty())
+			})
+		})
+		Context("When the request is complete", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+			})
+
+			It("should return no problems without calling the service", func() {
+				problems, err := newCluster(server.URL()).ValidateClusterCreate(validRequest(), ClusterTargetHeader{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(problems).To(BeEmpty())
+				Expect(server.ReceivedRequests()).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -77,6 +77,7 @@ type UpdateDedicatedHostPlacementRequest struct {
 }
 
 //DedicatedHost ...
+//go:generate counterfeiter . DedicatedHost
 type DedicatedHost interface {
 	CreateDedicatedHost(dedicatedHostReq CreateDedicatedHostRequest, target ClusterTargetHeader) (CreateDedicatedHostResponse, error)
 	GetDedicatedHost(dedicatedHostID, dedicatedHostPoolID string, target ClusterTargetHeader) (GetDedicatedHostResponse, error)
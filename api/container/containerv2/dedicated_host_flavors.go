@@ -32,6 +32,7 @@ type InstanceStorage struct {
 }
 
 //DedicatedHostFlavor ...
+//go:generate counterfeiter . DedicatedHostFlavor
 type DedicatedHostFlavor interface {
 	ListDedicatedHostFlavors(zone string, target ClusterTargetHeader) (GetDedicatedHostFlavors, error)
 }
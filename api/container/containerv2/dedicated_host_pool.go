@@ -53,6 +53,7 @@ type RemoveDedicatedHostPoolRequest struct {
 }
 
 //DedicatedHostPool ...
+//go:generate counterfeiter . DedicatedHostPool
 type DedicatedHostPool interface {
 	CreateDedicatedHostPool(dedicatedHostPoolReq CreateDedicatedHostPoolRequest, target ClusterTargetHeader) (CreateDedicatedHostPoolResponse, error)
 	GetDedicatedHostPool(dedicatedHostPoolID string, target ClusterTargetHeader) (GetDedicatedHostPoolResponse, error)
@@ -0,0 +1,109 @@
+package containerv2
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+)
+
+var docsURLPattern = regexp.MustCompile(`https?://[^\s'"<>)]+`)
+
+//ServiceError is the error payload returned by the IKS API, with the recovery
+//guidance the service provides for the failure
+type ServiceError struct {
+	IncidentID  string `json:"incidentID"`
+	ErrorCode   string `json:"code"`
+	Message     string `json:"description"`
+	Type        string `json:"type"`
+	RecoveryCLI string `json:"recoveryCLI"`
+	RecoveryUI  string `json:"recoveryUI"`
+	DocsLink    string `json:"docsURL"`
+
+	//Suggestion is the recovery action to print to the user
+	Suggestion string `json:"-"`
+	//DocsURL is the documentation link of the failure, if any
+	DocsURL string `json:"-"`
+	//TransactionID is the transaction ID of the response, if any
+	TransactionID string `json:"-"`
+
+	statusCode int
+}
+
+//toServiceError is the error handler of the client, it returns a *ServiceError
+//for the failures carrying an IKS error payload and err unchanged otherwise
+func toServiceError(err error) error {
+	if e, ok := ParseServiceError(err); ok {
+		return e
+	}
+	return err
+}
+
+//ParseServiceError returns the IKS error payload carried by err. It returns
+//false when err is not a request failure holding such a payload. The clients
+//created by New already return a *ServiceError for those failures.
+func ParseServiceError(err error) (*ServiceError, bool) {
+	if e, ok := err.(*ServiceError); ok {
+		return e, true
+	}
+	rf, ok := err.(bmxerror.RequestFailure)
+	if !ok {
+		return nil, false
+	}
+	var e ServiceError
+	if json.Unmarshal([]byte(rf.Description()), &e) != nil || e.ErrorCode == "" {
+		return nil, false
+	}
+	e.statusCode = rf.StatusCode()
+	if re, ok := err.(*bluemix.ResponseError); ok {
+		e.TransactionID = re.TransactionID()
+	}
+	e.Suggestion = e.RecoveryCLI
+	if e.Suggestion == "" {
+		e.Suggestion = e.RecoveryUI
+	}
+	e.DocsURL = e.DocsLink
+	if e.DocsURL == "" {
+		for _, text := range []string{e.RecoveryCLI, e.RecoveryUI, e.Message} {
+			if u := docsURLPattern.FindString(text); u != "" {
+				e.DocsURL = u
+				break
+			}
+		}
+	}
+	return &e, true
+}
+
+//Code ...
+func (e *ServiceError) Code() string {
+	return e.ErrorCode
+}
+
+//Description ...
+func (e *ServiceError) Description() string {
+	return e.Message
+}
+
+//StatusCode ...
+func (e *ServiceError) StatusCode() int {
+	return e.statusCode
+}
+
+//Is reports whether target is the bmxerror category of the status code of the
+//failure, such as bmxerror.ErrNotFound
+func (e *ServiceError) Is(target error) bool {
+	return target != nil && target == bmxerror.Category(e.statusCode)
+}
+
+func (e *ServiceError) Error() string {
+	msg := fmt.Sprintf("Request failed with status code: %d, %s: %s", e.statusCode, e.ErrorCode, e.Message)
+	if e.IncidentID != "" {
+		msg += fmt.Sprintf(" (incident ID: %s)", e.IncidentID)
+	}
+	if e.TransactionID != "" {
+		msg += fmt.Sprintf(" (transaction ID: %s)", e.TransactionID)
+	}
+	return msg
+}
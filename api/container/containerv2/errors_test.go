@@ -0,0 +1,88 @@
+package containerv2
+
+import (
+	"errors"
+	"net/http"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/bluemix-go/client"
+	bluemixHttp "github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/session"
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ServiceError", func() {
+	Describe("ParseServiceError", func() {
+		It("should expose the recovery guidance", func() {
+			err := bmxerror.NewRequestFailure("ServerErrorResponse", `{"incidentID":"i1","code":"E3917","description":"The cluster master is not ready.","type":"Provisioning","recoveryCLI":"Wait for the master to be ready, see https://ibm.biz/master-states and retry."}`, 409)
+			svcErr, ok := ParseServiceError(err)
+			Expect(ok).Should(BeTrue())
+			Expect(svcErr.Code()).Should(Equal("E3917"))
+			Expect(svcErr.StatusCode()).Should(Equal(409))
+			Expect(svcErr.Suggestion).Should(HavePrefix("Wait for the master"))
+			Expect(svcErr.DocsURL).Should(Equal("https://ibm.biz/master-states"))
+			Expect(svcErr.Error()).Should(ContainSubstring("incident ID: i1"))
+		})
+		It("should prefer the documentation link of the payload", func() {
+			err := bmxerror.NewRequestFailure("ServerErrorResponse", `{"code":"E0004","description":"Not found","recoveryUI":"Check the cluster name.","docsURL":"https://cloud.ibm.com/docs/containers"}`, 404)
+			svcErr, ok := ParseServiceError(err)
+			Expect(ok).Should(BeTrue())
+			Expect(svcErr.Suggestion).Should(Equal("Check the cluster name."))
+			Expect(svcErr.DocsURL).Should(Equal("https://cloud.ibm.com/docs/containers"))
+		})
+		It("should leave other errors unchanged", func() {
+			err := bmxerror.NewRequestFailure("ServerErrorResponse", "Bad Gateway", 502)
+			Expect(toServiceError(err)).Should(Equal(err))
+		})
+		It("should ignore other errors", func() {
+			_, ok := ParseServiceError(errors.New("network error"))
+			Expect(ok).Should(BeFalse())
+			_, ok = ParseServiceError(bmxerror.NewRequestFailure("ServerErrorResponse", "Bad Gateway", 502))
+			Expect(ok).Should(BeFalse())
+		})
+		Context("When the service returns an error", func() {
+			var server *ghttp.Server
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/getCluster"),
+						ghttp.RespondWith(http.StatusNotFound, `{"incidentID":"i2","code":"G0004","description":"The specified cluster could not be found.","type":"General","recoveryCLI":"To list the clusters you have access to, run 'ibmcloud ks cluster ls'."}`, http.Header{"X-Request-Id": {"tx-2"}}),
+					),
+				)
+			})
+			AfterEach(func() {
+				server.Close()
+			})
+
+			It("should be parsed from the returned error", func() {
+				_, err := newCluster(server.URL()).GetCluster("unknown", ClusterTargetHeader{})
+				svcErr, ok := ParseServiceError(err)
+				Expect(ok).Should(BeTrue())
+				Expect(svcErr.Suggestion).Should(ContainSubstring("ibmcloud ks cluster ls"))
+			})
+			It("should be returned by the client of the service", func() {
+				sess, err := session.New()
+				Expect(err).NotTo(HaveOccurred())
+				conf := sess.Config.Copy()
+				conf.HTTPClient = bluemixHttp.NewHTTPClient(conf)
+				endpoint := server.URL()
+				conf.Endpoint = &endpoint
+				c := client.New(conf, bluemix.VpcContainerService, nil)
+				c.ErrorHandler = toServiceError
+				_, err = newClusterAPI(c).GetCluster("unknown", ClusterTargetHeader{})
+				svcErr, ok := err.(*ServiceError)
+				Expect(ok).Should(BeTrue())
+				Expect(svcErr.Code()).Should(Equal("G0004"))
+				Expect(svcErr.TransactionID).Should(Equal("tx-2"))
+				Expect(errors.Is(err, bmxerror.ErrNotFound)).Should(BeTrue())
+				Expect(svcErr.StatusCode()).Should(Equal(http.StatusNotFound))
+				Expect(svcErr.Suggestion).Should(ContainSubstring("ibmcloud ks cluster ls"))
+			})
+		})
+	})
+})
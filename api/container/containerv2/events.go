@@ -0,0 +1,41 @@
+package containerv2
+
+import (
+	"github.com/IBM-Cloud/bluemix-go/client"
+)
+
+//Message is a notification published by the container service for all users,
+//such as maintenance notifications and master update announcements
+type Message struct {
+	ID        string `json:"id"`
+	Type      string `json:"type"`
+	Message   string `json:"message"`
+	Timestamp string `json:"timestamp"`
+}
+
+//Events interface
+//go:generate counterfeiter . Events
+type Events interface {
+	GetMessages(target ClusterTargetHeader) ([]Message, error)
+}
+
+type events struct {
+	client *client.Client
+}
+
+func newEventsAPI(c *client.Client) Events {
+	return &events{
+		client: c,
+	}
+}
+
+//GetMessages returns the messages currently published by the container service.
+//IKS keeps no per cluster or per worker event history in its API, cluster
+//events are available from Kubernetes and Activity Tracker.
+func (r *events) GetMessages(target ClusterTargetHeader) ([]Message, error) {
+	successV := struct {
+		Messages []Message `json:"messages"`
+	}{}
+	_, err := r.client.Get("/v1/messages", &successV, target.ToMap())
+	return successV.Messages, err
+}
@@ -0,0 +1,76 @@
+package containerv2
+
+import (
+	"log"
+	"net/http"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/client"
+	bluemixHttp "github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/session"
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Events", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("GetMessages", func() {
+		Context("When get messages is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v1/messages"),
+						ghttp.RespondWith(http.StatusOK, `{"messages": [{"id": "3", "type": "maintenance", "message": "Scheduled maintenance"}]}`),
+					),
+				)
+			})
+
+			It("should return messages", func() {
+				messages, err := newEvents(server.URL()).GetMessages(ClusterTargetHeader{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(messages).To(HaveLen(1))
+				Expect(messages[0].Type).To(Equal("maintenance"))
+			})
+		})
+		Context("When get messages is unsuccessful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v1/messages"),
+						ghttp.RespondWith(http.StatusInternalServerError, `Failed to get messages`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				_, err := newEvents(server.URL()).GetMessages(ClusterTargetHeader{})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
+
+func newEvents(url string) Events {
+
+	sess, err := session.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+	conf := sess.Config.Copy()
+	conf.HTTPClient = bluemixHttp.NewHTTPClient(conf)
+	conf.Endpoint = &url
+
+	client := client.Client{
+		Config:      conf,
+		ServiceName: bluemix.VpcContainerService,
+	}
+	return newEventsAPI(&client)
+}
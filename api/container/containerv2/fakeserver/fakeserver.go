@@ -0,0 +1,678 @@
+//Package fakeserver provides an in-memory implementation of a subset of the
+//IKS v2 API for the tests of code built on containerv2. Clusters, worker pools
+//and workers are kept in memory and go through their lifecycle states when
+//the server is advanced, so that callers polling for a state can be tested
+//end to end.
+package fakeserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/bluemix-go/helpers"
+	"github.com/IBM-Cloud/bluemix-go/session"
+)
+
+//Cluster states
+const (
+	ClusterDeploying = "deploying"
+	ClusterNormal    = "normal"
+	ClusterDeleting  = "deleting"
+)
+
+//Worker pool states
+const (
+	WorkerPoolCreating = "creating"
+	WorkerPoolActive   = "active"
+	WorkerPoolDeleting = "deleting"
+)
+
+//Worker states
+const (
+	WorkerProvisionPending = "provision_pending"
+	WorkerProvisioning     = "provisioning"
+	WorkerDeployed         = "deployed"
+	WorkerReloading        = "reloading"
+	WorkerDeleting         = "deleting"
+	WorkerDeleted          = "deleted"
+)
+
+//DefaultKubeVersion is the version of the clusters created without one
+const DefaultKubeVersion = "1.28.4"
+
+var workerTransitions = map[string]string{
+	WorkerProvisionPending: WorkerProvisioning,
+	WorkerProvisioning:     WorkerDeployed,
+	WorkerReloading:        WorkerDeployed,
+	WorkerDeleting:         WorkerDeleted,
+}
+
+type cluster struct {
+	info      containerv2.ClusterInfo
+	pools     []*containerv2.GetWorkerPoolResponse
+	workers   []*containerv2.Worker
+	workerSeq int
+}
+
+//Server is a fake IKS API server listening on a local address
+type Server struct {
+	*httptest.Server
+
+	lock        sync.Mutex
+	clusters    []*cluster
+	clusterSeq  int
+	poolSeq     int
+	autoAdvance bool
+}
+
+//New starts a fake server, Close it once done
+func New() *Server {
+	s := &Server{}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+//Session returns a session for containerv2.New targeting the server
+func (s *Server) Session() *session.Session {
+	endpoint := s.URL
+	sess, _ := session.New(&bluemix.Config{
+		Endpoint:        &endpoint,
+		IAMAccessToken:  "Bearer fake-access-token",
+		IAMRefreshToken: "fake-refresh-token",
+		MaxRetries:      helpers.Int(0),
+	})
+	return sess
+}
+
+//SetAutoAdvance makes the server advance every resource one step on each
+//request, so that clients polling for a state eventually reach it
+func (s *Server) SetAutoAdvance(enabled bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.autoAdvance = enabled
+}
+
+//Advance moves every cluster, worker pool and worker one step forward in its
+//lifecycle. It returns false when nothing changed.
+func (s *Server) Advance() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.advance()
+}
+
+//Settle advances the server until every resource reached a steady state
+func (s *Server) Settle() {
+	for s.Advance() {
+	}
+}
+
+func (s *Server) advance() bool {
+	changed := false
+	clusters := s.clusters[:0]
+	for _, c := range s.clusters {
+		for _, w := range c.workers {
+			if next, ok := workerTransitions[w.LifeCycle.ActualState]; ok {
+				setWorkerState(w, next)
+				changed = true
+			}
+		}
+		pools := c.pools[:0]
+		for _, p := range c.pools {
+			switch p.Lifecycle.ActualState {
+			case WorkerPoolCreating:
+				p.Lifecycle.ActualState = WorkerPoolActive
+				changed = true
+			case WorkerPoolDeleting:
+				changed = true
+				continue
+			}
+			pools = append(pools, p)
+		}
+		c.pools = pools
+		switch c.info.State {
+		case ClusterDeploying:
+			c.info.State = ClusterNormal
+			c.info.MasterStatus = "Ready"
+			c.info.Lifecycle.MasterStatus = "Ready"
+			c.info.Lifecycle.MasterState = "deployed"
+			c.info.Lifecycle.MasterHealth = "normal"
+			changed = true
+		case ClusterDeleting:
+			changed = true
+			continue
+		}
+		c.info.WorkerCount = activeWorkers(c)
+		clusters = append(clusters, c)
+	}
+	s.clusters = clusters
+	return changed
+}
+
+func setWorkerState(w *containerv2.Worker, state string) {
+	w.LifeCycle.ActualState = state
+	w.LifeCycle.Message = ""
+	w.LifeCycle.MessageDate = time.Now().UTC().Format(time.RFC3339)
+	switch state {
+	case WorkerDeployed:
+		w.LifeCycle.DesiredState = WorkerDeployed
+		w.Health = containerv2.HealthStatus{State: "normal", Message: "Ready"}
+	case WorkerDeleting, WorkerDeleted:
+		w.LifeCycle.DesiredState = WorkerDeleted
+		w.Health = containerv2.HealthStatus{State: "warning", Message: ""}
+	default:
+		w.LifeCycle.DesiredState = WorkerDeployed
+		w.Health = containerv2.HealthStatus{State: "pending", Message: ""}
+	}
+}
+
+func activeWorkers(c *cluster) int {
+	n := 0
+	for _, w := range c.workers {
+		if w.LifeCycle.DesiredState != WorkerDeleted {
+			n++
+		}
+	}
+	return n
+}
+
+//errorResponse is the error body returned by the IKS API
+type errorResponse struct {
+	IncidentID  string `json:"incidentID"`
+	Code        string `json:"code"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+}
+
+type apiError struct {
+	status int
+	body   errorResponse
+}
+
+func notFound(code, description string) *apiError {
+	return &apiError{status: http.StatusNotFound, body: errorResponse{Code: code, Description: description, Type: "General"}}
+}
+
+func badRequest(description string) *apiError {
+	return &apiError{status: http.StatusBadRequest, body: errorResponse{Code: "E0001", Description: description, Type: "BadRequest"}}
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.autoAdvance {
+		s.advance()
+	}
+
+	resp, apiErr := s.route(r)
+	w.Header().Set("Content-Type", "application/json")
+	if apiErr != nil {
+		apiErr.body.IncidentID = strconv.FormatInt(time.Now().UnixNano(), 36)
+		w.WriteHeader(apiErr.status)
+		json.NewEncoder(w).Encode(apiErr.body)
+		return
+	}
+	if resp == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (s *Server) route(r *http.Request) (interface{}, *apiError) {
+	q := r.URL.Query()
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/v2/vpc/getClusters":
+		return s.listClusters(), nil
+	case r.Method == http.MethodGet && r.URL.Path == "/v2/satellite/getClusters":
+		return []containerv2.ClusterInfo{}, nil
+	case r.Method == http.MethodGet && r.URL.Path == "/v2/getCluster":
+		c, err := s.findCluster(q.Get("cluster"))
+		if err != nil {
+			return nil, err
+		}
+		return c.info, nil
+	case r.Method == http.MethodPost && r.URL.Path == "/v2/vpc/createCluster":
+		var req containerv2.ClusterCreateRequest
+		if err := decode(r, &req); err != nil {
+			return nil, err
+		}
+		return s.createCluster(req)
+	case r.Method == http.MethodDelete && len(segments) == 3 && segments[0] == "v1" && segments[1] == "clusters":
+		return nil, s.deleteCluster(segments[2])
+
+	case r.Method == http.MethodGet && r.URL.Path == "/v2/vpc/getWorkerPools":
+		c, err := s.findCluster(q.Get("cluster"))
+		if err != nil {
+			return nil, err
+		}
+		pools := []containerv2.GetWorkerPoolResponse{}
+		for _, p := range c.pools {
+			pools = append(pools, *p)
+		}
+		return pools, nil
+	case r.Method == http.MethodGet && r.URL.Path == "/v2/vpc/getWorkerPool":
+		c, err := s.findCluster(q.Get("cluster"))
+		if err != nil {
+			return nil, err
+		}
+		p, err := findPool(c, q.Get("workerpool"))
+		if err != nil {
+			return nil, err
+		}
+		return *p, nil
+	case r.Method == http.MethodPost && r.URL.Path == "/v2/vpc/createWorkerPool":
+		var req containerv2.WorkerPoolRequest
+		if err := decode(r, &req); err != nil {
+			return nil, err
+		}
+		c, err := s.findCluster(req.Cluster)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := findPool(c, req.Name); err == nil {
+			return nil, &apiError{status: http.StatusConflict, body: errorResponse{Code: "E0009", Description: "A worker pool with the same name already exists.", Type: "Conflict"}}
+		}
+		p := s.addPool(c, req.CommonWorkerPoolConfig)
+		return containerv2.WorkerPoolResponse{ID: p.ID}, nil
+	case r.Method == http.MethodDelete && len(segments) == 5 && segments[0] == "v1" && segments[3] == "workerpools":
+		return nil, s.deletePool(segments[2], segments[4])
+	case r.Method == http.MethodPost && r.URL.Path == "/v2/vpc/createWorkerPoolZone":
+		var req containerv2.WorkerPoolZone
+		if err := decode(r, &req); err != nil {
+			return nil, err
+		}
+		return nil, s.addZone(req)
+	case r.Method == http.MethodPost && r.URL.Path == "/v2/setWorkerPoolTaints":
+		var req containerv2.WorkerPoolTaintRequest
+		if err := decode(r, &req); err != nil {
+			return nil, err
+		}
+		c, err := s.findCluster(req.Cluster)
+		if err != nil {
+			return nil, err
+		}
+		p, err := findPool(c, req.WorkerPool)
+		if err != nil {
+			return nil, err
+		}
+		p.Taints = req.Taints
+		return nil, nil
+	case r.Method == http.MethodPost && r.URL.Path == "/v2/setWorkerPoolLabels":
+		var req containerv2.WorkerPoolLabelRequest
+		if err := decode(r, &req); err != nil {
+			return nil, err
+		}
+		c, err := s.findCluster(req.Cluster)
+		if err != nil {
+			return nil, err
+		}
+		p, err := findPool(c, req.WorkerPool)
+		if err != nil {
+			return nil, err
+		}
+		p.Labels = req.Labels
+		return nil, nil
+	case r.Method == http.MethodPost && r.URL.Path == "/v2/resizeWorkerPool":
+		var req containerv2.ResizeWorkerPoolReq
+		if err := decode(r, &req); err != nil {
+			return nil, err
+		}
+		return nil, s.resizePool(req)
+
+	case r.Method == http.MethodGet && r.URL.Path == "/v2/vpc/getWorkers":
+		c, err := s.findCluster(q.Get("cluster"))
+		if err != nil {
+			return nil, err
+		}
+		var pool *containerv2.GetWorkerPoolResponse
+		if q.Get("pool") != "" {
+			if pool, err = findPool(c, q.Get("pool")); err != nil {
+				return nil, err
+			}
+		}
+		workers := []containerv2.Worker{}
+		for _, w := range c.workers {
+			if pool != nil && w.PoolID != pool.ID {
+				continue
+			}
+			if w.LifeCycle.ActualState == WorkerDeleted && q.Get("showDeleted") != "true" {
+				continue
+			}
+			workers = append(workers, *w)
+		}
+		return workers, nil
+	case r.Method == http.MethodGet && r.URL.Path == "/v2/vpc/getWorker":
+		c, err := s.findCluster(q.Get("cluster"))
+		if err != nil {
+			return nil, err
+		}
+		w, err := findWorker(c, q.Get("worker"))
+		if err != nil {
+			return nil, err
+		}
+		return *w, nil
+	case r.Method == http.MethodPost && r.URL.Path == "/v2/vpc/replaceWorker":
+		var req containerv2.ReplaceWorker
+		if err := decode(r, &req); err != nil {
+			return nil, err
+		}
+		return s.replaceWorker(req)
+	case r.Method == http.MethodPut && len(segments) == 5 && segments[0] == "v1" && segments[3] == "workers":
+		var req struct {
+			Action string `json:"action"`
+		}
+		if err := decode(r, &req); err != nil {
+			return nil, err
+		}
+		return nil, s.workerAction(segments[2], segments[4], req.Action)
+	case r.Method == http.MethodDelete && len(segments) == 5 && segments[0] == "v1" && segments[3] == "workers":
+		c, err := s.findCluster(segments[2])
+		if err != nil {
+			return nil, err
+		}
+		w, err := findWorker(c, segments[4])
+		if err != nil {
+			return nil, err
+		}
+		setWorkerState(w, WorkerDeleting)
+		return nil, nil
+	}
+	return nil, notFound("E0000", fmt.Sprintf("%s %s is not implemented by the fake server", r.Method, r.URL.Path))
+}
+
+func decode(r *http.Request, v interface{}) *apiError {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return badRequest("The request body could not be parsed: " + err.Error())
+	}
+	return nil
+}
+
+func (s *Server) listClusters() []containerv2.ClusterInfo {
+	clusters := []containerv2.ClusterInfo{}
+	for _, c := range s.clusters {
+		clusters = append(clusters, c.info)
+	}
+	return clusters
+}
+
+func (s *Server) findCluster(nameOrID string) (*cluster, *apiError) {
+	for _, c := range s.clusters {
+		if c.info.ID == nameOrID || c.info.Name == nameOrID {
+			return c, nil
+		}
+	}
+	return nil, notFound("G0004", fmt.Sprintf("The specified cluster %q could not be found.", nameOrID))
+}
+
+func findPool(c *cluster, nameOrID string) (*containerv2.GetWorkerPoolResponse, *apiError) {
+	for _, p := range c.pools {
+		if p.ID == nameOrID || p.PoolName == nameOrID {
+			return p, nil
+		}
+	}
+	return nil, notFound("G0007", fmt.Sprintf("The specified worker pool %q could not be found.", nameOrID))
+}
+
+func findWorker(c *cluster, id string) (*containerv2.Worker, *apiError) {
+	for _, w := range c.workers {
+		if w.ID == id {
+			return w, nil
+		}
+	}
+	return nil, notFound("G0005", fmt.Sprintf("The specified worker node %q could not be found.", id))
+}
+
+func (s *Server) createCluster(req containerv2.ClusterCreateRequest) (interface{}, *apiError) {
+	if req.Name == "" {
+		return nil, badRequest("The cluster name is required.")
+	}
+	if _, err := s.findCluster(req.Name); err == nil {
+		return nil, &apiError{status: http.StatusConflict, body: errorResponse{Code: "E0007", Description: "A cluster with the same name already exists.", Type: "Conflict"}}
+	}
+	s.clusterSeq++
+	version := req.KubeVersion
+	if version == "" {
+		version = DefaultKubeVersion
+	}
+	provider := req.Provider
+	if provider == "" {
+		provider = "vpc-gen2"
+	}
+	id := fmt.Sprintf("fake%016d", s.clusterSeq)
+	c := &cluster{
+		info: containerv2.ClusterInfo{
+			ID:                id,
+			Name:              req.Name,
+			CRN:               fmt.Sprintf("crn:v1:bluemix:public:containers-kubernetes:fake:a/fake:%s::", id),
+			CreatedDate:       time.Now().UTC().Format(time.RFC3339),
+			State:             ClusterDeploying,
+			MasterStatus:      "Deploying",
+			MasterKubeVersion: version,
+			Provider:          provider,
+			Type:              "kubernetes",
+			PodSubnet:         req.PodSubnet,
+			ServiceSubnet:     req.ServiceSubnet,
+			MasterURL:         fmt.Sprintf("https://%s.fake.containers.test:30000", id),
+			Lifecycle: containerv2.LifeCycleInfo{
+				MasterStatus: "Deploying",
+				MasterState:  "deploying",
+				MasterHealth: "pending",
+			},
+		},
+	}
+	for _, z := range req.WorkerPools.Zones {
+		c.info.WorkerZones = append(c.info.WorkerZones, z.ID)
+	}
+	if len(c.info.WorkerZones) > 0 {
+		c.info.Location = c.info.WorkerZones[0]
+	}
+	if req.WorkerPools.VpcID != "" {
+		c.info.Vpcs = []string{req.WorkerPools.VpcID}
+	}
+	pool := req.WorkerPools.CommonWorkerPoolConfig
+	if pool.Name == "" {
+		pool.Name = "default"
+	}
+	s.clusters = append(s.clusters, c)
+	s.addPool(c, pool)
+	c.info.WorkerCount = activeWorkers(c)
+	return containerv2.ClusterCreateResponse{ID: id}, nil
+}
+
+func (s *Server) deleteCluster(nameOrID string) *apiError {
+	c, err := s.findCluster(nameOrID)
+	if err != nil {
+		return err
+	}
+	c.info.State = ClusterDeleting
+	for _, p := range c.pools {
+		p.Lifecycle.ActualState = WorkerPoolDeleting
+		p.Lifecycle.DesiredState = WorkerPoolDeleting
+	}
+	for _, w := range c.workers {
+		if w.LifeCycle.ActualState != WorkerDeleted {
+			setWorkerState(w, WorkerDeleting)
+		}
+	}
+	return nil
+}
+
+func (s *Server) addPool(c *cluster, config containerv2.CommonWorkerPoolConfig) *containerv2.GetWorkerPoolResponse {
+	s.poolSeq++
+	p := &containerv2.GetWorkerPoolResponse{
+		ID:              fmt.Sprintf("%s-%08d", c.info.ID, s.poolSeq),
+		PoolName:        config.Name,
+		Flavor:          config.Flavor,
+		Isolation:       config.Isolation,
+		Labels:          config.Labels,
+		OperatingSystem: config.OperatingSystem,
+		VpcID:           config.VpcID,
+		WorkerCount:     config.WorkerCount,
+		Provider:        c.info.Provider,
+		Lifecycle: containerv2.Lifecycle{
+			ActualState:  WorkerPoolCreating,
+			DesiredState: WorkerPoolActive,
+		},
+	}
+	c.pools = append(c.pools, p)
+	for _, z := range config.Zones {
+		addPoolZone(c, p, z.ID, z.SubnetID)
+	}
+	return p
+}
+
+func addPoolZone(c *cluster, p *containerv2.GetWorkerPoolResponse, zone, subnetID string) {
+	z := containerv2.ZoneResp{ID: zone, WorkerCount: p.WorkerCount}
+	if subnetID != "" {
+		z.Subnets = []containerv2.Subnet{{ID: subnetID, Primary: true}}
+	}
+	p.Zones = append(p.Zones, z)
+	for i := 0; i < p.WorkerCount; i++ {
+		addWorker(c, p, zone)
+	}
+}
+
+func addWorker(c *cluster, p *containerv2.GetWorkerPoolResponse, zone string) *containerv2.Worker {
+	c.workerSeq++
+	w := &containerv2.Worker{
+		ID:       fmt.Sprintf("kube-%s-%s-%08d", c.info.ID, p.PoolName, c.workerSeq),
+		Flavor:   p.Flavor,
+		Location: zone,
+		PoolID:   p.ID,
+		PoolName: p.PoolName,
+		KubeVersion: containerv2.KubeDetails{
+			Actual:  c.info.MasterKubeVersion,
+			Desired: c.info.MasterKubeVersion,
+			Target:  c.info.MasterKubeVersion,
+		},
+		NetworkInterfaces: []containerv2.Network{{
+			IpAddress: fmt.Sprintf("10.%d.%d.%d", (c.workerSeq>>16)&0xff, (c.workerSeq>>8)&0xff, c.workerSeq&0xff),
+			Primary:   true,
+		}},
+	}
+	setWorkerState(w, WorkerProvisionPending)
+	c.workers = append(c.workers, w)
+	return w
+}
+
+func (s *Server) deletePool(clusterNameOrID, poolNameOrID string) *apiError {
+	c, err := s.findCluster(clusterNameOrID)
+	if err != nil {
+		return err
+	}
+	p, err := findPool(c, poolNameOrID)
+	if err != nil {
+		return err
+	}
+	p.Lifecycle.ActualState = WorkerPoolDeleting
+	p.Lifecycle.DesiredState = WorkerPoolDeleting
+	for _, w := range c.workers {
+		if w.PoolID == p.ID && w.LifeCycle.ActualState != WorkerDeleted {
+			setWorkerState(w, WorkerDeleting)
+		}
+	}
+	return nil
+}
+
+func (s *Server) addZone(req containerv2.WorkerPoolZone) *apiError {
+	c, err := s.findCluster(req.Cluster)
+	if err != nil {
+		return err
+	}
+	p, err := findPool(c, req.WorkerPoolID)
+	if err != nil {
+		return err
+	}
+	for _, z := range p.Zones {
+		if z.ID == req.Id {
+			return &apiError{status: http.StatusConflict, body: errorResponse{Code: "E0010", Description: "The zone is already attached to the worker pool.", Type: "Conflict"}}
+		}
+	}
+	addPoolZone(c, p, req.Id, req.SubnetID)
+	return nil
+}
+
+//resizePool sets the number of workers per zone, the newest workers are
+//removed first when scaling down
+func (s *Server) resizePool(req containerv2.ResizeWorkerPoolReq) *apiError {
+	c, err := s.findCluster(req.Cluster)
+	if err != nil {
+		return err
+	}
+	p, err := findPool(c, req.Workerpool)
+	if err != nil {
+		return err
+	}
+	size := int(req.Size)
+	if size < 0 {
+		return badRequest("The worker pool size can't be negative.")
+	}
+	for i := range p.Zones {
+		zone := p.Zones[i].ID
+		var active []*containerv2.Worker
+		for _, w := range c.workers {
+			if w.PoolID == p.ID && w.Location == zone && w.LifeCycle.DesiredState != WorkerDeleted {
+				active = append(active, w)
+			}
+		}
+		for n := len(active); n < size; n++ {
+			addWorker(c, p, zone)
+		}
+		sort.SliceStable(active, func(a, b int) bool { return active[a].ID > active[b].ID })
+		for n := 0; n < len(active)-size; n++ {
+			setWorkerState(active[n], WorkerDeleting)
+		}
+		p.Zones[i].WorkerCount = size
+	}
+	p.WorkerCount = size
+	return nil
+}
+
+func (s *Server) replaceWorker(req containerv2.ReplaceWorker) (interface{}, *apiError) {
+	c, err := s.findCluster(req.ClusterIDOrName)
+	if err != nil {
+		return nil, err
+	}
+	w, err := findWorker(c, req.WorkerID)
+	if err != nil {
+		return nil, err
+	}
+	p, err := findPool(c, w.PoolID)
+	if err != nil {
+		return nil, err
+	}
+	setWorkerState(w, WorkerDeleting)
+	replacement := addWorker(c, p, w.Location)
+	return replacement.ID, nil
+}
+
+func (s *Server) workerAction(clusterNameOrID, workerID, action string) *apiError {
+	c, err := s.findCluster(clusterNameOrID)
+	if err != nil {
+		return err
+	}
+	w, err := findWorker(c, workerID)
+	if err != nil {
+		return err
+	}
+	switch action {
+	case "reboot", "reload", "os_reload":
+		if w.LifeCycle.ActualState != WorkerDeployed {
+			return &apiError{status: http.StatusConflict, body: errorResponse{Code: "E0011", Description: fmt.Sprintf("The worker node is %s and can't be reloaded.", w.LifeCycle.ActualState), Type: "Conflict"}}
+		}
+		setWorkerState(w, WorkerReloading)
+		return nil
+	}
+	return badRequest(fmt.Sprintf("The worker action %q is not supported.", action))
+}
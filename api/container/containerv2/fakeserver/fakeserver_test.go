@@ -0,0 +1,140 @@
+package fakeserver
+
+import (
+	"github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Server", func() {
+	var server *Server
+	var api containerv2.ContainerServiceAPI
+	target := containerv2.ClusterTargetHeader{AccountID: "acc"}
+
+	BeforeEach(func() {
+		server = New()
+		var err error
+		api, err = containerv2.New(server.Session())
+		Expect(err).NotTo(HaveOccurred())
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	createCluster := func() string {
+		resp, err := api.Clusters().Create(containerv2.ClusterCreateRequest{
+			Name: "c1",
+			WorkerPools: containerv2.WorkerPoolConfig{CommonWorkerPoolConfig: containerv2.CommonWorkerPoolConfig{
+				Flavor:      "bx2.4x16",
+				VpcID:       "vpc1",
+				WorkerCount: 2,
+				Zones:       []containerv2.Zone{{ID: "us-south-1", SubnetID: "s1"}},
+			}},
+		}, target)
+		Expect(err).NotTo(HaveOccurred())
+		return resp.ID
+	}
+
+	It("should deploy a cluster with its default worker pool", func() {
+		id := createCluster()
+		cluster, err := api.Clusters().GetCluster("c1", target)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cluster.ID).Should(Equal(id))
+		Expect(cluster.State).Should(Equal(ClusterDeploying))
+
+		workers, err := api.Workers().ListWorkers(id, false, target)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(workers).Should(HaveLen(2))
+		Expect(workers[0].LifeCycle.ActualState).Should(Equal(WorkerProvisionPending))
+
+		server.Settle()
+		cluster, err = api.Clusters().GetCluster(id, target)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cluster.State).Should(Equal(ClusterNormal))
+		worker, err := api.Workers().Get(id, workers[0].ID, target)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(worker.LifeCycle.ActualState).Should(Equal(WorkerDeployed))
+		Expect(worker.Health.State).Should(Equal("normal"))
+	})
+	It("should manage worker pools", func() {
+		id := createCluster()
+		_, err := api.WorkerPools().CreateWorkerPool(containerv2.WorkerPoolRequest{Cluster: id, CommonWorkerPoolConfig: containerv2.CommonWorkerPoolConfig{
+			Name:        "edge",
+			Flavor:      "bx2.2x8",
+			WorkerCount: 1,
+			Zones:       []containerv2.Zone{{ID: "us-south-1"}},
+		}}, target)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(api.WorkerPools().CreateWorkerPoolZone(containerv2.WorkerPoolZone{Cluster: id, Id: "us-south-2", WorkerPoolID: "edge"}, target)).To(Succeed())
+		Expect(api.WorkerPools().ResizeWorkerPool(containerv2.ResizeWorkerPoolReq{Cluster: id, Workerpool: "edge", Size: 3}, target)).To(Succeed())
+		Expect(api.WorkerPools().UpdateWorkerPoolTaints(containerv2.WorkerPoolTaintRequest{Cluster: id, WorkerPool: "edge", Taints: map[string]string{"dedicated": "edge:NoSchedule"}}, target)).To(Succeed())
+		server.Settle()
+
+		pool, err := api.WorkerPools().GetWorkerPool(id, "edge", target)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pool.ActualState).Should(Equal(WorkerPoolActive))
+		Expect(pool.Zones).Should(HaveLen(2))
+		Expect(pool.Taints).Should(HaveKeyWithValue("dedicated", "edge:NoSchedule"))
+		labels, err := api.WorkerPools().PatchWorkerPoolLabels(id, "edge", map[string]string{"tier": "edge"}, nil, target)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(labels).Should(HaveKeyWithValue("tier", "edge"))
+		workers, err := api.Workers().ListByWorkerPool(id, "edge", false, target)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(workers).Should(HaveLen(6))
+
+		Expect(api.WorkerPools().ResizeWorkerPool(containerv2.ResizeWorkerPoolReq{Cluster: id, Workerpool: "edge", Size: 1}, target)).To(Succeed())
+		server.Settle()
+		workers, err = api.Workers().ListByWorkerPool(id, "edge", false, target)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(workers).Should(HaveLen(2))
+
+		Expect(api.WorkerPools().DeleteWorkerPool(id, "edge", target)).To(Succeed())
+		server.Settle()
+		_, err = api.WorkerPools().GetWorkerPool(id, "edge", target)
+		Expect(err).To(HaveOccurred())
+	})
+	It("should replace and reboot workers", func() {
+		id := createCluster()
+		server.Settle()
+		workers, err := api.Workers().ListWorkers(id, false, target)
+		Expect(err).NotTo(HaveOccurred())
+
+		replacement, err := api.Workers().ReplaceWokerNode(id, workers[0].ID, target)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(replacement).NotTo(Equal(workers[0].ID))
+		results, err := api.Workers().RebootWorkers(containerv2.BulkWorkerRequest{Cluster: id, Selector: containerv2.WorkerSelector{WorkerIDs: []string{workers[1].ID}}}, target)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results.Failed()).Should(BeEmpty())
+		worker, err := api.Workers().Get(id, workers[1].ID, target)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(worker.LifeCycle.ActualState).Should(Equal(WorkerReloading))
+
+		server.Settle()
+		current, err := api.Workers().ListWorkers(id, false, target)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(current).Should(HaveLen(2))
+		all, err := api.Workers().ListWorkers(id, true, target)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(all).Should(HaveLen(3))
+	})
+	It("should advance on requests when auto advance is enabled", func() {
+		id := createCluster()
+		server.SetAutoAdvance(true)
+		Eventually(func() string {
+			cluster, err := api.Clusters().GetCluster(id, target)
+			Expect(err).NotTo(HaveOccurred())
+			return cluster.State
+		}).Should(Equal(ClusterNormal))
+	})
+	It("should delete clusters", func() {
+		id := createCluster()
+		Expect(api.Clusters().Delete(id, target)).To(Succeed())
+		server.Settle()
+		_, err := api.Clusters().GetCluster(id, target)
+		Expect(err).To(HaveOccurred())
+		clusters, err := api.Clusters().List(target)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusters).Should(BeEmpty())
+	})
+})
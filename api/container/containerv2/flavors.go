@@ -0,0 +1,113 @@
+package containerv2
+
+import (
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/client"
+)
+
+//FlavorInfo is a machine type workers can be created with
+type FlavorInfo struct {
+	Name         string `json:"name"`
+	Provider     string `json:"provider"`
+	Cores        string `json:"cores"`
+	Memory       string `json:"memory"`
+	NetworkSpeed string `json:"networkSpeed"`
+	ServerType   string `json:"serverType"`
+	Deprecated   bool   `json:"deprecated"`
+	//Storage is the size of the boot disk, e.g. 100GB
+	Storage string `json:"storage,omitempty"`
+	//SecondaryStorage are the secondary disks the workers can have
+	SecondaryStorage []FlavorStorage `json:"secondaryStorage,omitempty"`
+}
+
+//FlavorStorage is a secondary disk of a flavor
+type FlavorStorage struct {
+	Count             int    `json:"count"`
+	Size              string `json:"size"`
+	DeviceType        string `json:"deviceType"`
+	RAIDConfiguration string `json:"raidConfiguration"`
+	Profile           string `json:"profile,omitempty"`
+}
+
+//gpuFlavorName matches the VPC GPU flavors, e.g. gx2-16x128x2v100 has 2 V100 GPUs
+var gpuFlavorName = regexp.MustCompile(`^g[a-z]*\d+-\d+x\d+x(\d+)([a-z][a-z0-9]*)?`)
+
+//CoreCount returns the number of vCPUs of the flavor, 0 when unknown
+func (f FlavorInfo) CoreCount() int {
+	n, _ := strconv.Atoi(strings.TrimSpace(f.Cores))
+	return n
+}
+
+//MemoryGB returns the memory of the flavor in GB, 0 when unknown
+func (f FlavorInfo) MemoryGB() int {
+	n, _ := strconv.Atoi(strings.TrimSuffix(strings.TrimSpace(f.Memory), "GB"))
+	return n
+}
+
+//GPUCount returns the number of GPUs of the flavor. The service doesn't
+//report it, it is read from the flavor name.
+func (f FlavorInfo) GPUCount() int {
+	m := gpuFlavorName.FindStringSubmatch(f.Name)
+	if m == nil {
+		return 0
+	}
+	n, _ := strconv.Atoi(m[1])
+	return n
+}
+
+//GPUModel returns the model of the GPUs of the flavor read from its name,
+//e.g. v100 or l4, empty when unknown
+func (f FlavorInfo) GPUModel() string {
+	m := gpuFlavorName.FindStringSubmatch(f.Name)
+	if m == nil {
+		return ""
+	}
+	return m[2]
+}
+
+//StorageGB returns the size of the boot disk of the flavor in GB, 0 when unknown
+func (f FlavorInfo) StorageGB() int {
+	n, _ := strconv.Atoi(strings.TrimSuffix(strings.TrimSpace(f.Storage), "GB"))
+	return n
+}
+
+//FindFlavor returns the flavor of the list with the name, to validate the
+//flavor of a WorkerPoolRequest against the flavors of its zones
+func FindFlavor(flavors []FlavorInfo, name string) (FlavorInfo, bool) {
+	for _, f := range flavors {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return FlavorInfo{}, false
+}
+
+//Flavors interface
+//go:generate counterfeiter . Flavors
+type Flavors interface {
+	ListFlavors(zone, provider string, target ClusterTargetHeader) ([]FlavorInfo, error)
+}
+
+type flavors struct {
+	client *client.Client
+}
+
+func newFlavorsAPI(c *client.Client) Flavors {
+	return &flavors{
+		client: c,
+	}
+}
+
+//ListFlavors returns the flavors available in the zone for the provider, e.g. vpc-gen2 or classic
+func (r *flavors) ListFlavors(zone, provider string, target ClusterTargetHeader) ([]FlavorInfo, error) {
+	query := url.Values{}
+	query.Set("zone", zone)
+	query.Set("provider", provider)
+	successV := []FlavorInfo{}
+	_, err := r.client.Get("/v2/getFlavors?"+query.Encode(), &successV, target.ToMap())
+	return successV, err
+}
@@ -0,0 +1,98 @@
+package containerv2
+
+import (
+	"log"
+	"net/http"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/client"
+	bluemixHttp "github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/session"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Flavors", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("ListFlavors", func() {
+		Context("When the flavors of a zone are read", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/getFlavors", "provider=vpc-gen2&zone=us-south-1"),
+						ghttp.RespondWith(http.StatusOK, `[
+							{"name": "bx2.4x16", "provider": "vpc-gen2", "cores": "4", "memory": "16GB", "networkSpeed": "8Gbps",
+							 "serverType": "virtual", "storage": "100GB", "deprecated": false},
+							{"name": "gx2-16x128x2v100", "provider": "vpc-gen2", "cores": "16", "memory": "128GB", "storage": "100GB",
+							 "secondaryStorage": [{"count": 1, "size": "600GB", "deviceType": "SSD", "raidConfiguration": "raid0"}]}
+						]`),
+					),
+				)
+			})
+
+			It("should return the details of the flavors", func() {
+				flavors, err := newFlavors(server.URL()).ListFlavors("us-south-1", "vpc-gen2", ClusterTargetHeader{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(flavors).To(HaveLen(2))
+				Expect(flavors[0].CoreCount()).To(Equal(4))
+				Expect(flavors[0].MemoryGB()).To(Equal(16))
+				Expect(flavors[0].StorageGB()).To(Equal(100))
+				Expect(flavors[0].GPUCount()).To(Equal(0))
+				Expect(flavors[0].GPUModel()).To(BeEmpty())
+				Expect(flavors[1].GPUCount()).To(Equal(2))
+				Expect(flavors[1].GPUModel()).To(Equal("v100"))
+				Expect(flavors[1].SecondaryStorage).To(Equal([]FlavorStorage{{Count: 1, Size: "600GB", DeviceType: "SSD", RAIDConfiguration: "raid0"}}))
+			})
+			It("should find the flavor of a worker pool", func() {
+				flavors, err := newFlavors(server.URL()).ListFlavors("us-south-1", "vpc-gen2", ClusterTargetHeader{})
+				Expect(err).NotTo(HaveOccurred())
+				f, ok := FindFlavor(flavors, "bx2.4x16")
+				Expect(ok).To(BeTrue())
+				Expect(f.NetworkSpeed).To(Equal("8Gbps"))
+				_, ok = FindFlavor(flavors, "bx2.2x8")
+				Expect(ok).To(BeFalse())
+			})
+		})
+		Context("When read of flavors is unsuccessful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/getFlavors"),
+						ghttp.RespondWith(http.StatusInternalServerError, `Failed to read flavors`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				_, err := newFlavors(server.URL()).ListFlavors("us-south-1", "vpc-gen2", ClusterTargetHeader{})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
+
+func newFlavors(url string) Flavors {
+
+	sess, err := session.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+	conf := sess.Config.Copy()
+	conf.HTTPClient = bluemixHttp.NewHTTPClient(conf)
+	conf.Endpoint = &url
+
+	client := client.Client{
+		Config:      conf,
+		ServiceName: bluemix.VpcContainerService,
+	}
+	return newFlavorsAPI(&client)
+}
@@ -123,6 +123,7 @@ type ingress struct {
 }
 
 // Ingress interface
+//go:generate counterfeiter . Ingress
 type Ingress interface {
 	CreateIngressSecret(req SecretCreateConfig) (response Secret, err error)
 	UpdateIngressSecret(req SecretUpdateConfig) (response Secret, err error)
@@ -134,6 +135,13 @@ type Ingress interface {
 	DeleteIngressInstance(req InstanceDeleteConfig) (err error)
 	GetIngressInstance(clusterNameOrID, instanceName string) (response Instance, err error)
 	GetIngressInstanceList(clusterNameOrID string, showDeleted bool) (response Instances, err error)
+	GetIngressStatus(clusterNameOrID string) (response IngressStatus, err error)
+	AddIngressSecretFields(req SecretUpdateConfig) (response Secret, err error)
+	RemoveIngressSecretFields(req SecretUpdateConfig) (response Secret, err error)
+	ResyncIngressSecret(clusterNameOrID, secretName, secretNamespace string) (response Secret, err error)
+	CreateIngressDomain(req DomainCreateConfig) (response Domain, err error)
+	ListIngressDomains(clusterNameOrID string) (response Domains, err error)
+	SetDefaultIngressDomain(clusterNameOrID, domain string) (err error)
 }
 
 func newIngressAPI(c *client.Client) Ingress {
@@ -161,12 +169,23 @@ func (r *ingress) CreateIngressSecret(req SecretCreateConfig) (response Secret,
 	return
 }
 
-// UpdateIngressSecret updates an existing secret with new cert values
+// UpdateIngressSecret updates an existing secret with new cert values. Without a
+// CRN the secret is synced again with its certificate in secrets manager.
 func (r *ingress) UpdateIngressSecret(req SecretUpdateConfig) (response Secret, err error) {
 	_, err = r.client.Post("/ingress/v2/secret/updateSecret", req, &response)
 	return
 }
 
+// ResyncIngressSecret syncs a secret again with its certificate in secrets
+// manager, e.g. once the certificate is renewed, without deleting the secret
+func (r *ingress) ResyncIngressSecret(clusterNameOrID, secretName, secretNamespace string) (response Secret, err error) {
+	return r.UpdateIngressSecret(SecretUpdateConfig{
+		Cluster:   clusterNameOrID,
+		Name:      secretName,
+		Namespace: secretNamespace,
+	})
+}
+
 // DeleteIngressSecret deletes the ingress secret from the cluster
 func (r *ingress) DeleteIngressSecret(req SecretDeleteConfig) (err error) {
 	_, err = r.client.Post("/ingress/v2/secret/deleteSecret", req, nil)
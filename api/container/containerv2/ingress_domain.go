@@ -0,0 +1,60 @@
+package containerv2
+
+import "fmt"
+
+// Domain struct holding details for a single ingress domain
+type Domain struct {
+	Cluster     string   `json:"cluster" description:"id of cluster"`
+	Domain      string   `json:"domain" description:"the domain"`
+	Provider    string   `json:"provider" description:"DNS provider of the domain, such as akamai or a custom provider"`
+	IsDefault   bool     `json:"isDefault" description:"true or false. Used to show the default domain of the cluster"`
+	Status      string   `json:"status" description:"status of the domain"`
+	IPs         []string `json:"ips" description:"IP addresses the domain resolves to"`
+	LBHostname  string   `json:"lbHostname" description:"load balancer hostname the domain resolves to"`
+	SecretName  string   `json:"secretName" description:"name of the TLS secret of the domain"`
+	UserManaged bool     `json:"userManaged" description:"true or false. Used to show which domains are system generated and which are not"`
+}
+
+// Domains struct for a domain array
+type Domains []Domain
+
+// DomainCreateConfig the domain create request
+type DomainCreateConfig struct {
+	Cluster         string   `json:"cluster" description:"id of cluster" binding:"required"`
+	Domain          string   `json:"domain" description:"the domain. Optional, a subdomain is generated if none specified"`
+	DomainProvider  string   `json:"domainProvider" description:"DNS provider of the domain. Defaults to akamai if none specified"`
+	IPAddresses     []string `json:"ipAddresses" description:"IP addresses to register for the domain"`
+	LBHostname      string   `json:"lbHostname" description:"load balancer hostname to register for the domain"`
+	IsDefault       bool     `json:"isDefault" description:"true or false. Make the domain the default domain of the cluster"`
+	SecretNamespace string   `json:"secretNamespace" description:"namespace of the TLS secret of the domain"`
+}
+
+// DomainUpdateConfig the domain update request
+type DomainUpdateConfig struct {
+	Cluster   string `json:"cluster" description:"id of cluster" binding:"required"`
+	Domain    string `json:"domain" description:"the domain" binding:"required"`
+	IsDefault bool   `json:"isDefault" description:"true or false. Make the domain the default domain of the cluster"`
+}
+
+// CreateIngressDomain registers a domain for the ingress of a cluster
+func (r *ingress) CreateIngressDomain(req DomainCreateConfig) (response Domain, err error) {
+	_, err = r.client.Post("/ingress/v2/domain/createDomain", req, &response)
+	return
+}
+
+// ListIngressDomains returns the ingress domains of a cluster
+func (r *ingress) ListIngressDomains(clusterNameOrID string) (response Domains, err error) {
+	_, err = r.client.Get(fmt.Sprintf("/ingress/v2/domain/getDomains?cluster=%s", clusterNameOrID), &response)
+	return
+}
+
+// SetDefaultIngressDomain makes a domain the default ingress domain of a cluster
+func (r *ingress) SetDefaultIngressDomain(clusterNameOrID, domain string) (err error) {
+	req := DomainUpdateConfig{
+		Cluster:   clusterNameOrID,
+		Domain:    domain,
+		IsDefault: true,
+	}
+	_, err = r.client.Post("/ingress/v2/domain/updateDomain", req, nil)
+	return
+}
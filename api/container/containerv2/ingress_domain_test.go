@@ -0,0 +1,137 @@
+package containerv2
+
+import (
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ingress Domains", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("Create", func() {
+		Context("When creating ingress domain is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/ingress/v2/domain/createDomain"),
+						ghttp.VerifyJSON(`{"cluster":"mycluster","domain":"example.com","domainProvider":"custom","ipAddresses":null,"lbHostname":"abc-us-south.lb.appdomain.cloud","isDefault":false,"secretNamespace":""}`),
+						ghttp.RespondWith(http.StatusCreated, `{"cluster":"mycluster","domain":"example.com","provider":"custom","status":"pending","lbHostname":"abc-us-south.lb.appdomain.cloud"}`),
+					),
+				)
+			})
+
+			It("should return the domain", func() {
+				domain, err := newIngresses(server.URL()).CreateIngressDomain(DomainCreateConfig{
+					Cluster:        "mycluster",
+					Domain:         "example.com",
+					DomainProvider: "custom",
+					LBHostname:     "abc-us-south.lb.appdomain.cloud",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(domain.Status).To(Equal("pending"))
+				Expect(domain.Provider).To(Equal("custom"))
+			})
+		})
+		Context("When creating ingress domain is unsuccessful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.SetAllowUnhandledRequests(true)
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/ingress/v2/domain/createDomain"),
+						ghttp.RespondWith(http.StatusInternalServerError, `Failed to create the domain`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				_, err := newIngresses(server.URL()).CreateIngressDomain(DomainCreateConfig{Cluster: "mycluster"})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("List", func() {
+		Context("When listing ingress domains is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/ingress/v2/domain/getDomains", "cluster=mycluster"),
+						ghttp.RespondWith(http.StatusOK, `[{"domain":"mycluster-abc.us-south.containers.appdomain.cloud","provider":"akamai","isDefault":true},{"domain":"example.com","provider":"custom","userManaged":true}]`),
+					),
+				)
+			})
+
+			It("should return the domains", func() {
+				domains, err := newIngresses(server.URL()).ListIngressDomains("mycluster")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(domains).To(HaveLen(2))
+				Expect(domains[0].IsDefault).To(BeTrue())
+				Expect(domains[1].UserManaged).To(BeTrue())
+			})
+		})
+		Context("When listing ingress domains is unsuccessful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.SetAllowUnhandledRequests(true)
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/ingress/v2/domain/getDomains"),
+						ghttp.RespondWith(http.StatusInternalServerError, `Failed to get the domains`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				_, err := newIngresses(server.URL()).ListIngressDomains("mycluster")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("SetDefault", func() {
+		Context("When setting the default ingress domain is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/ingress/v2/domain/updateDomain"),
+						ghttp.VerifyJSON(`{"cluster":"mycluster","domain":"example.com","isDefault":true}`),
+						ghttp.RespondWith(http.StatusNoContent, ``),
+					),
+				)
+			})
+
+			It("should set the default domain", func() {
+				err := newIngresses(server.URL()).SetDefaultIngressDomain("mycluster", "example.com")
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+		Context("When setting the default ingress domain is unsuccessful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.SetAllowUnhandledRequests(true)
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/ingress/v2/domain/updateDomain"),
+						ghttp.RespondWith(http.StatusInternalServerError, `Failed to update the domain`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				err := newIngresses(server.URL()).SetDefaultIngressDomain("mycluster", "example.com")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,30 @@
+package containerv2
+
+//Ingress secret types
+const (
+	IngressSecretTypeTLS    = "TLS"
+	IngressSecretTypeOpaque = "Opaque"
+)
+
+// Field returns the field of an opaque secret with the given name
+func (s Secret) Field(name string) (Field, bool) {
+	for _, f := range s.Fields {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return Field{}, false
+}
+
+// AddIngressSecretFields adds the FieldsToAdd of req to an opaque secret, or
+// sets the CRN of the fields the secret already has
+func (r *ingress) AddIngressSecretFields(req SecretUpdateConfig) (response Secret, err error) {
+	_, err = r.client.Post("/ingress/v2/secret/addField", req, &response)
+	return
+}
+
+// RemoveIngressSecretFields removes the FieldsToRemove of req from an opaque secret
+func (r *ingress) RemoveIngressSecretFields(req SecretUpdateConfig) (response Secret, err error) {
+	_, err = r.client.Post("/ingress/v2/secret/removeField", req, &response)
+	return
+}
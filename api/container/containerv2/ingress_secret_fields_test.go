@@ -0,0 +1,137 @@
+package containerv2
+
+import (
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ingress Secret Fields", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("Create opaque secret", func() {
+		Context("When creating an opaque secret with fields is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/ingress/v2/secret/createSecret"),
+						ghttp.VerifyJSON(`{"cluster":"mycluster","name":"mysecret","namespace":"default","crn":"","persistence":false,"type":"Opaque","add":[{"name":"username","crn":"crn:v1:secret:1","append_prefix":false},{"name":"","crn":"crn:v1:secret:2","append_prefix":true}]}`),
+						ghttp.RespondWith(http.StatusCreated, `{"name":"mysecret","type":"Opaque","fields":[{"name":"username","crn":"crn:v1:secret:1","lastUpdatedTimestamp":"2026-10-01T10:00:00Z"},{"name":"db_password","crn":"crn:v1:secret:2"}]}`),
+					),
+				)
+			})
+
+			It("should return the fields of the secret", func() {
+				secret, err := newIngresses(server.URL()).CreateIngressSecret(SecretCreateConfig{
+					Cluster:   "mycluster",
+					Name:      "mysecret",
+					Namespace: "default",
+					Type:      IngressSecretTypeOpaque,
+					FieldsToAdd: []FieldAdd{
+						{Name: "username", CRN: "crn:v1:secret:1"},
+						{CRN: "crn:v1:secret:2", AppendPrefix: true},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				field, ok := secret.Field("username")
+				Expect(ok).To(BeTrue())
+				Expect(field.LastUpdatedTimestamp).To(Equal("2026-10-01T10:00:00Z"))
+				_, ok = secret.Field("password")
+				Expect(ok).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("AddIngressSecretFields", func() {
+		Context("When adding fields is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/ingress/v2/secret/addField"),
+						ghttp.VerifyJSON(`{"cluster":"mycluster","name":"mysecret","namespace":"default","crn":"","add":[{"name":"token","crn":"crn:v1:secret:3","append_prefix":false}],"remove":null}`),
+						ghttp.RespondWith(http.StatusOK, `{"name":"mysecret","type":"Opaque","fields":[{"name":"token","crn":"crn:v1:secret:3"}]}`),
+					),
+				)
+			})
+
+			It("should return the updated secret", func() {
+				secret, err := newIngresses(server.URL()).AddIngressSecretFields(SecretUpdateConfig{
+					Cluster:     "mycluster",
+					Name:        "mysecret",
+					Namespace:   "default",
+					FieldsToAdd: []FieldAdd{{Name: "token", CRN: "crn:v1:secret:3"}},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(secret.Fields).To(HaveLen(1))
+			})
+		})
+		Context("When adding fields is unsuccessful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.SetAllowUnhandledRequests(true)
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/ingress/v2/secret/addField"),
+						ghttp.RespondWith(http.StatusInternalServerError, `Failed to add the fields`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				_, err := newIngresses(server.URL()).AddIngressSecretFields(SecretUpdateConfig{Cluster: "mycluster", Name: "mysecret", Namespace: "default"})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("RemoveIngressSecretFields", func() {
+		Context("When removing fields is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/ingress/v2/secret/removeField"),
+						ghttp.VerifyJSON(`{"cluster":"mycluster","name":"mysecret","namespace":"default","crn":"","add":null,"remove":[{"name":"token"}]}`),
+						ghttp.RespondWith(http.StatusOK, `{"name":"mysecret","type":"Opaque","fields":[]}`),
+					),
+				)
+			})
+
+			It("should return the updated secret", func() {
+				secret, err := newIngresses(server.URL()).RemoveIngressSecretFields(SecretUpdateConfig{
+					Cluster:        "mycluster",
+					Name:           "mysecret",
+					Namespace:      "default",
+					FieldsToRemove: []FieldRemove{{Name: "token"}},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(secret.Fields).To(BeEmpty())
+			})
+		})
+		Context("When removing fields is unsuccessful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.SetAllowUnhandledRequests(true)
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/ingress/v2/secret/removeField"),
+						ghttp.RespondWith(http.StatusInternalServerError, `Failed to remove the fields`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				_, err := newIngresses(server.URL()).RemoveIngressSecretFields(SecretUpdateConfig{Cluster: "mycluster", Name: "mysecret", Namespace: "default"})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,56 @@
+package containerv2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/client"
+)
+
+//statusHealthy is the status of the healthy ALBs and ingress components
+const statusHealthy = "healthy"
+
+//IngressComponentStatus is the status of a component in the ingress status report
+type IngressComponentStatus struct {
+	Component string   `json:"component"`
+	Status    []string `json:"status"`
+	Type      string   `json:"type,omitempty"`
+}
+
+//IngressStatus is the ingress status report of a cluster
+type IngressStatus struct {
+	Cluster                string                   `json:"cluster"`
+	Enabled                bool                     `json:"enabled"`
+	Status                 string                   `json:"status"`
+	NonTranslatedStatus    string                   `json:"nonTranslatedStatus"`
+	Message                string                   `json:"message"`
+	GeneralComponentStatus []IngressComponentStatus `json:"generalComponentStatus"`
+	ALBStatus              []IngressComponentStatus `json:"albStatus"`
+}
+
+func getIngressStatus(c *client.Client, clusterNameOrID string, header map[string]string) (IngressStatus, error) {
+	var successV IngressStatus
+	_, err := c.Get(fmt.Sprintf("/v2/alb/getStatus?cluster=%s", clusterNameOrID), &successV, header)
+	return successV, err
+}
+
+//Unhealthy returns the components of the report with a status other than healthy
+func (s IngressStatus) Unhealthy() []IngressComponentStatus {
+	var unhealthy []IngressComponentStatus
+	for _, components := range [][]IngressComponentStatus{s.GeneralComponentStatus, s.ALBStatus} {
+		for _, c := range components {
+			for _, status := range c.Status {
+				if !strings.EqualFold(status, statusHealthy) {
+					unhealthy = append(unhealthy, c)
+					break
+				}
+			}
+		}
+	}
+	return unhealthy
+}
+
+// GetIngressStatus returns the ingress status report of a cluster
+func (r *ingress) GetIngressStatus(clusterNameOrID string) (response IngressStatus, err error) {
+	return getIngressStatus(r.client, clusterNameOrID, nil)
+}
@@ -0,0 +1,62 @@
+package containerv2
+
+import (
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ingress Status", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("GetIngressStatus", func() {
+		Context("When reading the ingress status is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/alb/getStatus", "cluster=mycluster"),
+						ghttp.RespondWith(http.StatusOK, `{"cluster":"mycluster","enabled":true,"status":"warning","nonTranslatedStatus":"warning","message":"Some Ingress components are in warning state",
+							"generalComponentStatus":[{"component":"ibm-cloud-provider-ip-10-1-1-1","status":["healthy"]},{"component":"mycluster-secret","status":["certificate expires soon"]}],
+							"albStatus":[{"component":"public-cr1-alb1","status":["healthy"],"type":"public"}]}`),
+					),
+				)
+			})
+
+			It("should return the ingress status report", func() {
+				status, err := newIngresses(server.URL()).GetIngressStatus("mycluster")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status.Status).To(Equal("warning"))
+				Expect(status.Message).To(Equal("Some Ingress components are in warning state"))
+				Expect(status.GeneralComponentStatus).To(HaveLen(2))
+				Expect(status.ALBStatus[0].Type).To(Equal("public"))
+				Expect(status.Unhealthy()).To(Equal([]IngressComponentStatus{
+					{Component: "mycluster-secret", Status: []string{"certificate expires soon"}},
+				}))
+			})
+		})
+		Context("When reading the ingress status is unsuccessful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.SetAllowUnhandledRequests(true)
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/alb/getStatus"),
+						ghttp.RespondWith(http.StatusInternalServerError, `Failed to get the ingress status`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				_, err := newIngresses(server.URL()).GetIngressStatus("mycluster")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
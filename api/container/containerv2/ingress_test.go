@@ -73,6 +73,44 @@ var _ = Describe("Ingress Secrets", func() {
 		})
 	})
 
+	Describe("Resync", func() {
+		Context("When syncing the ingress secret again is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/ingress/v2/secret/updateSecret"),
+						ghttp.VerifyJSON(`{"cluster":"bugi52rf0rtfgadjfso0","name":"testabc2","namespace":"default","crn":"","add":null,"remove":null}`),
+						ghttp.RespondWith(http.StatusOK, `{"name":"testabc2","namespace":"default","expiresOn":"2027-10-15T00:00:00Z"}`),
+					),
+				)
+			})
+
+			It("should return the synced secret", func() {
+				secret, err := newIngresses(server.URL()).ResyncIngressSecret("bugi52rf0rtfgadjfso0", "testabc2", "default")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(secret.ExpiresOn).To(Equal("2027-10-15T00:00:00Z"))
+			})
+		})
+		Context("When syncing the ingress secret again is unsuccessful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.SetAllowUnhandledRequests(true)
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/ingress/v2/secret/updateSecret"),
+						ghttp.RespondWith(http.StatusInternalServerError, `Failed to update the secret`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				_, err := newIngresses(server.URL()).ResyncIngressSecret("bugi52rf0rtfgadjfso0", "testabc2", "default")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
 	//Disable
 	Describe("Destroy", func() {
 		Context("When deleting ingress secret successful", func() {
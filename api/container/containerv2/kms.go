@@ -36,6 +36,7 @@ type kms struct {
 }
 
 //Kms interface
+//go:generate counterfeiter . Kms
 type Kms interface {
 	EnableKms(enableKmsReq KmsEnableReq, target ClusterHeader) error
 }
@@ -0,0 +1,198 @@
+package containerv2
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	yaml "github.com/ghodss/yaml"
+
+	"github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	"github.com/IBM-Cloud/bluemix-go/helpers"
+)
+
+//EncryptedKubeConfigSuffix is appended to the name of the encrypted kubeconfig file
+const EncryptedKubeConfigSuffix = ".enc"
+
+//KubeConfigKeySize is the size of the keys used to encrypt the kubeconfig
+const KubeConfigKeySize = 32
+
+//encryptedKubeConfigMagic prefixes the sealed kubeconfig so that the loader can
+//tell it apart from a plaintext file
+var encryptedKubeConfigMagic = []byte("BMXKCFG1")
+
+//ErrInvalidKubeConfigKey is returned when the encrypted kubeconfig can't be opened with the key
+var ErrInvalidKubeConfigKey = errors.New("The kubeconfig could not be decrypted, check the encryption key")
+
+//ErrKubeConfigKeySize is returned when the encryption key is not KubeConfigKeySize bytes long
+var ErrKubeConfigKeySize = fmt.Errorf("The kubeconfig encryption key must be %d random bytes, use NewKubeConfigKey to generate one", KubeConfigKeySize)
+
+//NewKubeConfigKey generates a random key to encrypt the kubeconfig with
+func NewKubeConfigKey() ([]byte, error) {
+	key := make([]byte, KubeConfigKeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+//StoreEncryptedConfigDetail downloads the kubeconfig like GetClusterConfigDetail
+//and stores it as a single kubeconfig encrypted with the key. The files are
+//downloaded in a temporary directory that is removed whether or not the
+//encryption succeeds, and the certificates referenced by the kubeconfig are
+//embedded before encryption, so no plaintext file is left in dir. The
+//returned FilePath points to the encrypted file, use LoadEncryptedKubeConfig
+//to read it back.
+func (r *clusters) StoreEncryptedConfigDetail(name, dir string, admin bool, key []byte, target ClusterTargetHeader) (containerv1.ClusterKeyInfo, error) {
+	if len(key) != KubeConfigKeySize {
+		return containerv1.ClusterKeyInfo{}, ErrKubeConfigKeySize
+	}
+	if !helpers.FileExists(dir) {
+		return containerv1.ClusterKeyInfo{}, fmt.Errorf("Path: %q, to download the config doesn't exist", dir)
+	}
+	tmpDir, err := ioutil.TempDir(dir, ".kubeconfig")
+	if err != nil {
+		return containerv1.ClusterKeyInfo{}, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	clusterkey, err := r.GetClusterConfigDetail(name, tmpDir, admin, target)
+	if err != nil {
+		return clusterkey, err
+	}
+	kubeconfig, err := ioutil.ReadFile(clusterkey.FilePath)
+	if err != nil {
+		return clusterkey, err
+	}
+	if kubeconfig, err = inlineKubeConfigFiles(kubeconfig, filepath.Dir(clusterkey.FilePath)); err != nil {
+		return clusterkey, err
+	}
+	sealed, err := EncryptKubeConfig(kubeconfig, key)
+	if err != nil {
+		return clusterkey, err
+	}
+	absTmpDir, err := filepath.Abs(tmpDir)
+	if err != nil {
+		return clusterkey, err
+	}
+	rel, err := filepath.Rel(absTmpDir, clusterkey.FilePath)
+	if err != nil {
+		return clusterkey, err
+	}
+	encryptedPath, err := filepath.Abs(filepath.Join(dir, rel+EncryptedKubeConfigSuffix))
+	if err != nil {
+		return clusterkey, err
+	}
+	if err = os.MkdirAll(filepath.Dir(encryptedPath), 0700); err != nil {
+		return clusterkey, err
+	}
+	if err = ioutil.WriteFile(encryptedPath, sealed, 0600); err != nil {
+		return clusterkey, err
+	}
+	r.client.Logger().Debug("Stored the encrypted kubeconfig", "path", encryptedPath)
+	clusterkey.FilePath = encryptedPath
+	return clusterkey, nil
+}
+
+//LoadEncryptedKubeConfig reads and decrypts a kubeconfig written by StoreEncryptedConfigDetail
+func LoadEncryptedKubeConfig(path string, key []byte) ([]byte, error) {
+	sealed, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return DecryptKubeConfig(sealed, key)
+}
+
+//EncryptKubeConfig seals the kubeconfig with AES-256-GCM. The key must be
+//KubeConfigKeySize random bytes, such as the ones returned by NewKubeConfigKey.
+func EncryptKubeConfig(kubeconfig, key []byte) ([]byte, error) {
+	gcm, err := kubeConfigCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	out := append([]byte{}, encryptedKubeConfigMagic...)
+	out = append(out, nonce...)
+	return gcm.Seal(out, nonce, kubeconfig, encryptedKubeConfigMagic), nil
+}
+
+//DecryptKubeConfig opens a kubeconfig sealed by EncryptKubeConfig
+func DecryptKubeConfig(sealed, key []byte) ([]byte, error) {
+	if !bytes.HasPrefix(sealed, encryptedKubeConfigMagic) {
+		return nil, errors.New("The file is not an encrypted kubeconfig")
+	}
+	gcm, err := kubeConfigCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	sealed = sealed[len(encryptedKubeConfigMagic):]
+	if len(sealed) < gcm.NonceSize() {
+		return nil, ErrInvalidKubeConfigKey
+	}
+	kubeconfig, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], encryptedKubeConfigMagic)
+	if err != nil {
+		return nil, ErrInvalidKubeConfigKey
+	}
+	return kubeconfig, nil
+}
+
+func kubeConfigCipher(key []byte) (cipher.AEAD, error) {
+	if len(key) != KubeConfigKeySize {
+		return nil, ErrKubeConfigKeySize
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+//inlineKubeConfigFiles replaces the certificate and key file references of the
+//kubeconfig with their base64 encoded content
+func inlineKubeConfigFiles(kubeconfig []byte, dir string) ([]byte, error) {
+	var cfg map[string]interface{}
+	if err := yaml.Unmarshal(kubeconfig, &cfg); err != nil {
+		return nil, err
+	}
+	inline := func(entries interface{}, field string, keys ...string) error {
+		list, _ := entries.([]interface{})
+		for _, e := range list {
+			entry, _ := e.(map[string]interface{})
+			values, _ := entry[field].(map[string]interface{})
+			for _, k := range keys {
+				file, ok := values[k].(string)
+				if !ok || file == "" {
+					continue
+				}
+				if !filepath.IsAbs(file) {
+					file = filepath.Join(dir, file)
+				}
+				content, err := ioutil.ReadFile(file)
+				if err != nil {
+					return fmt.Errorf("Couldn't embed %q in the kubeconfig: %v", k, err)
+				}
+				values[k+"-data"] = base64.StdEncoding.EncodeToString(content)
+				delete(values, k)
+			}
+		}
+		return nil
+	}
+	if err := inline(cfg["clusters"], "cluster", "certificate-authority"); err != nil {
+		return nil, err
+	}
+	if err := inline(cfg["users"], "user", "client-certificate", "client-key"); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(cfg)
+}
@@ -0,0 +1,187 @@
+package containerv2
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	yaml "github.com/ghodss/yaml"
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Kubeconfig encryption", func() {
+	kubeconfig := []byte("apiVersion: v1\nkind: Config\n")
+	key := []byte("0123456789abcdef0123456789abcdef")
+
+	Describe("EncryptKubeConfig", func() {
+		It("should be opened with the same key only", func() {
+			sealed, err := EncryptKubeConfig(kubeconfig, key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(sealed)).NotTo(ContainSubstring("kind: Config"))
+
+			opened, err := DecryptKubeConfig(sealed, key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(opened).Should(Equal(kubeconfig))
+
+			other, err := NewKubeConfigKey()
+			Expect(err).NotTo(HaveOccurred())
+			_, err = DecryptKubeConfig(sealed, other)
+			Expect(err).Should(Equal(ErrInvalidKubeConfigKey))
+		})
+		It("should reject a key that is not 32 bytes long", func() {
+			_, err := EncryptKubeConfig(kubeconfig, nil)
+			Expect(err).Should(Equal(ErrKubeConfigKeySize))
+			_, err = EncryptKubeConfig(kubeconfig, []byte("passphrase"))
+			Expect(err).Should(Equal(ErrKubeConfigKeySize))
+		})
+		It("should reject a plaintext kubeconfig", func() {
+			_, err := DecryptKubeConfig(kubeconfig, key)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+	Describe("LoadEncryptedKubeConfig", func() {
+		var dir string
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "kubeconfig")
+			Expect(err).NotTo(HaveOccurred())
+		})
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		It("should decrypt the file", func() {
+			sealed, err := EncryptKubeConfig(kubeconfig, key)
+			Expect(err).NotTo(HaveOccurred())
+			path := filepath.Join(dir, "config.yml"+EncryptedKubeConfigSuffix)
+			Expect(ioutil.WriteFile(path, sealed, 0600)).To(Succeed())
+
+			opened, err := LoadEncryptedKubeConfig(path, key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(opened).Should(Equal(kubeconfig))
+		})
+		It("should embed the referenced certificates", func() {
+			Expect(ioutil.WriteFile(filepath.Join(dir, "ca.pem"), []byte("CA"), 0600)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(dir, "admin.pem"), []byte("CERT"), 0600)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(dir, "admin-key.pem"), []byte("KEY"), 0600)).To(Succeed())
+			admin := []byte(`clusters:
+- name: c1
+  cluster:
+    server: https://c1.example.com
+    certificate-authority: ca.pem
+users:
+- name: admin
+  user:
+    client-certificate: admin.pem
+    client-key: admin-key.pem
+`)
+			inlined, err := inlineKubeConfigFiles(admin, dir)
+			Expect(err).NotTo(HaveOccurred())
+
+			var cfg struct {
+				Clusters []struct {
+					Cluster map[string]string `json:"cluster"`
+				} `json:"clusters"`
+				Users []struct {
+					User map[string]string `json:"user"`
+				} `json:"users"`
+			}
+			Expect(yaml.Unmarshal(inlined, &cfg)).To(Succeed())
+			Expect(cfg.Clusters[0].Cluster).ShouldNot(HaveKey("certificate-authority"))
+			Expect(cfg.Clusters[0].Cluster["certificate-authority-data"]).Should(Equal(base64.StdEncoding.EncodeToString([]byte("CA"))))
+			Expect(cfg.Users[0].User["client-certificate-data"]).Should(Equal(base64.StdEncoding.EncodeToString([]byte("CERT"))))
+			Expect(cfg.Users[0].User["client-key-data"]).Should(Equal(base64.StdEncoding.EncodeToString([]byte("KEY"))))
+		})
+	})
+	Describe("StoreEncryptedConfigDetail", func() {
+		var dir string
+		var server *ghttp.Server
+		kubeconfigZip := func(files map[string]string) []byte {
+			var buf bytes.Buffer
+			w := zip.NewWriter(&buf)
+			for name, content := range files {
+				f, err := w.Create(name)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = f.Write([]byte(content))
+				Expect(err).NotTo(HaveOccurred())
+			}
+			Expect(w.Close()).To(Succeed())
+			return buf.Bytes()
+		}
+		listFiles := func() []string {
+			var files []string
+			filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+				if err == nil && !info.IsDir() {
+					files = append(files, path)
+				}
+				return err
+			})
+			return files
+		}
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "kubeconfig")
+			Expect(err).NotTo(HaveOccurred())
+			server = ghttp.NewServer()
+		})
+		AfterEach(func() {
+			server.Close()
+			os.RemoveAll(dir)
+		})
+		serve := func(archive []byte) {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/v2/getCluster"),
+					ghttp.RespondWith(http.StatusOK, `{"id": "c1", "name": "mycluster", "provider": "vpc-gen2"}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, "/v2/applyRBACAndGetKubeconfig"),
+					ghttp.RespondWith(http.StatusOK, archive),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/v2/getCluster"),
+					ghttp.RespondWith(http.StatusOK, `{"id": "c1", "name": "mycluster", "provider": "vpc-gen2"}`),
+				),
+			)
+		}
+
+		It("should leave only the encrypted kubeconfig", func() {
+			serve(kubeconfigZip(map[string]string{
+				"kube-config.yaml": "clusters:\n- name: c1\n  cluster:\n    server: https://c1.example.com\n    certificate-authority: ca-c1.pem\n",
+				"ca-c1.pem":        "CA",
+			}))
+			clusterkey, err := newCluster(server.URL()).StoreEncryptedConfigDetail("mycluster", dir, false, key, ClusterTargetHeader{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(listFiles()).Should(Equal([]string{clusterkey.FilePath}))
+			Expect(clusterkey.FilePath).Should(HaveSuffix("config.yml" + EncryptedKubeConfigSuffix))
+
+			opened, err := LoadEncryptedKubeConfig(clusterkey.FilePath, key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(opened)).Should(ContainSubstring("certificate-authority-data: " + base64.StdEncoding.EncodeToString([]byte("CA"))))
+		})
+		It("should remove the downloaded files when the encryption fails", func() {
+			serve(kubeconfigZip(map[string]string{
+				"kube-config.yaml": "clusters:\n- name: c1\n  cluster:\n    server: https://c1.example.com\n    certificate-authority: missing.pem\n",
+				"ca-c1.pem":        "CA",
+			}))
+			_, err := newCluster(server.URL()).StoreEncryptedConfigDetail("mycluster", dir, false, key, ClusterTargetHeader{})
+			Expect(err).To(HaveOccurred())
+			Expect(listFiles()).Should(BeEmpty())
+			entries, err := ioutil.ReadDir(dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).Should(BeEmpty())
+		})
+		It("should reject a short key before downloading", func() {
+			_, err := newCluster(server.URL()).StoreEncryptedConfigDetail("mycluster", dir, false, []byte("passphrase"), ClusterTargetHeader{})
+			Expect(err).Should(Equal(ErrKubeConfigKeySize))
+			Expect(server.ReceivedRequests()).Should(BeEmpty())
+		})
+	})
+})
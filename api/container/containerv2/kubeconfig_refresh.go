@@ -0,0 +1,139 @@
+package containerv2
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+
+	yaml "github.com/ghodss/yaml"
+
+	"github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	"github.com/IBM-Cloud/bluemix-go/rest"
+)
+
+//oidcTokenResponse ...
+type oidcTokenResponse struct {
+	IDToken      string `json:"id_token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+//RefreshKubeConfigToken renews the user token of the current context in an
+//existing kubeconfig without downloading the config bundle again. Kubernetes
+//clusters using the IAM OIDC auth provider are refreshed with the refresh
+//token stored in the kubeconfig, OpenShift clusters get a new OAuth token.
+//The updated kubeconfig and the connection details of the cluster are returned.
+func (r *clusters) RefreshKubeConfigToken(name string, kubeconfig []byte, target ClusterTargetHeader) ([]byte, containerv1.ClusterKeyInfo, error) {
+	clusterkey := containerv1.ClusterKeyInfo{}
+	var cfg map[string]interface{}
+	if err := yaml.Unmarshal(kubeconfig, &cfg); err != nil {
+		return kubeconfig, clusterkey, err
+	}
+	context := namedEntry(cfg, "contexts", "context", stringValue(cfg["current-context"]))
+	if context == nil {
+		return kubeconfig, clusterkey, fmt.Errorf("The current context of the kubeconfig was not found")
+	}
+	cluster := namedEntry(cfg, "clusters", "cluster", stringValue(context["cluster"]))
+	user := namedEntry(cfg, "users", "user", stringValue(context["user"]))
+	if cluster == nil || user == nil {
+		return kubeconfig, clusterkey, fmt.Errorf("The cluster or user of the current kubeconfig context was not found")
+	}
+
+	var token string
+	if provider, ok := user["auth-provider"].(map[string]interface{}); ok {
+		providerConfig, _ := provider["config"].(map[string]interface{})
+		if providerConfig == nil {
+			return kubeconfig, clusterkey, fmt.Errorf("The kubeconfig auth provider has no configuration")
+		}
+		tokens, err := r.refreshOIDCToken(providerConfig)
+		if err != nil {
+			return kubeconfig, clusterkey, err
+		}
+		providerConfig["id-token"] = tokens.IDToken
+		if tokens.RefreshToken != "" {
+			providerConfig["refresh-token"] = tokens.RefreshToken
+		}
+		token = tokens.IDToken
+	} else {
+		r.client.Logger().Debug("Refreshing the OpenShift token", "cluster", name)
+		clusterInfo, err := r.FindWithOutShowResourcesCompatible(name, target)
+		if err != nil {
+			return kubeconfig, clusterkey, err
+		}
+		passcode, err := r.getOpenShiftPasscode()
+		if err != nil {
+			return kubeconfig, clusterkey, err
+		}
+		authEP, err := getOpenShiftAuthEndpoints(&clusterInfo)
+		if err != nil {
+			return kubeconfig, clusterkey, err
+		}
+		token, _, err = r.openShiftAuthorizePasscode(authEP, passcode, clusterInfo.IsStagingSatelliteCluster())
+		if err != nil {
+			return kubeconfig, clusterkey, err
+		}
+		user["token"] = token
+	}
+
+	bytes, err := yaml.Marshal(cfg)
+	if err != nil {
+		return kubeconfig, clusterkey, err
+	}
+	clusterkey.Host = stringValue(cluster["server"])
+	clusterkey.Token = token
+	if data := stringValue(cluster["certificate-authority-data"]); data != "" {
+		ca, err := base64.StdEncoding.DecodeString(data)
+		if err == nil {
+			clusterkey.ClusterCACertificate = string(ca)
+		}
+	} else if file := stringValue(cluster["certificate-authority"]); file != "" {
+		ca, err := ioutil.ReadFile(file)
+		if err == nil {
+			clusterkey.ClusterCACertificate = string(ca)
+		}
+	}
+	return bytes, clusterkey, nil
+}
+
+func (r *clusters) refreshOIDCToken(providerConfig map[string]interface{}) (oidcTokenResponse, error) {
+	var tokens oidcTokenResponse
+	issuer := stringValue(providerConfig["idp-issuer-url"])
+	refreshToken := stringValue(providerConfig["refresh-token"])
+	if issuer == "" || refreshToken == "" {
+		return tokens, fmt.Errorf("The kubeconfig auth provider has no issuer or refresh token")
+	}
+	clientID := stringValue(providerConfig["client-id"])
+	clientSecret := stringValue(providerConfig["client-secret"])
+	request := rest.PostRequest(issuer+"/token").
+		Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(clientID+":"+clientSecret))).
+		Field("grant_type", "refresh_token").
+		Field("refresh_token", refreshToken)
+
+	restClient := &rest.Client{HTTPClient: r.client.Config.HTTPClient}
+	_, err := restClient.Do(request, &tokens, nil)
+	if err != nil {
+		return tokens, err
+	}
+	if tokens.IDToken == "" {
+		return tokens, fmt.Errorf("No id token was returned by %s", issuer)
+	}
+	return tokens, nil
+}
+
+//namedEntry returns the value of field in the entry called name of the list
+//key in a kubeconfig, e.g. the "cluster" of the cluster entry "mycluster"
+func namedEntry(cfg map[string]interface{}, key, field, name string) map[string]interface{} {
+	entries, _ := cfg[key].([]interface{})
+	for _, e := range entries {
+		entry, _ := e.(map[string]interface{})
+		if entry != nil && stringValue(entry["name"]) == name {
+			value, _ := entry[field].(map[string]interface{})
+			return value
+		}
+	}
+	return nil
+}
+
+func stringValue(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
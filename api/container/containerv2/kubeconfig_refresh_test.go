@@ -0,0 +1,86 @@
+package containerv2
+
+import (
+	"fmt"
+	"net/http"
+
+	yaml "github.com/ghodss/yaml"
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Kubeconfig refresh", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("RefreshKubeConfigToken", func() {
+		Context("When the kubeconfig uses the IAM OIDC auth provider", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/identity/token"),
+						ghttp.VerifyBasicAuth("kube", "kube"),
+						ghttp.VerifyFormKV("grant_type", "refresh_token"),
+						ghttp.VerifyFormKV("refresh_token", "old-refresh"),
+						ghttp.RespondWith(http.StatusOK, `{"id_token": "new-id", "refresh_token": "new-refresh"}`),
+					),
+				)
+			})
+
+			It("should replace the tokens", func() {
+				kubeconfig := fmt.Sprintf(`
+apiVersion: v1
+current-context: mycluster
+clusters:
+- name: mycluster
+  cluster:
+    server: https://c1.us-south.containers.cloud.ibm.com:30000
+    certificate-authority-data: Y2VydA==
+contexts:
+- name: mycluster
+  context:
+    cluster: mycluster
+    user: me
+users:
+- name: me
+  user:
+    auth-provider:
+      name: oidc
+      config:
+        client-id: kube
+        client-secret: kube
+        id-token: old-id
+        refresh-token: old-refresh
+        idp-issuer-url: %s/identity
+`, server.URL())
+				updated, key, err := newCluster(server.URL()).RefreshKubeConfigToken("mycluster", []byte(kubeconfig), ClusterTargetHeader{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(key.Token).To(Equal("new-id"))
+				Expect(key.Host).To(Equal("https://c1.us-south.containers.cloud.ibm.com:30000"))
+				Expect(key.ClusterCACertificate).To(Equal("cert"))
+
+				var cfg map[string]interface{}
+				Expect(yaml.Unmarshal(updated, &cfg)).To(Succeed())
+				user := namedEntry(cfg, "users", "user", "me")
+				config := user["auth-provider"].(map[string]interface{})["config"].(map[string]interface{})
+				Expect(config["id-token"]).To(Equal("new-id"))
+				Expect(config["refresh-token"]).To(Equal("new-refresh"))
+			})
+		})
+		Context("When the current context is missing", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+			})
+
+			It("should return error", func() {
+				_, _, err := newCluster(server.URL()).RefreshKubeConfigToken("mycluster", []byte(`current-context: none`), ClusterTargetHeader{})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
@@ -12,9 +12,15 @@ type nlbdns struct {
 }
 
 //Clusters interface
+//go:generate counterfeiter . Nlbdns
 type Nlbdns interface {
 	GetNLBDNSList(clusterNameOrID string) ([]NlbVPCListConfig, error)
 	GetLocationNLBDNSList(location string) ([]NlbVPCListConfig, error)
+	CreateNlbDNS(nlbDNSCreateReq NlbDNSCreateReq) (string, error)
+	ReplaceNlbDNS(nlbDNSReplaceReq NlbDNSReplaceReq) error
+	RemoveNlbDNS(clusterNameOrID, nlbSubdomain string) error
+	EnableNlbDNSMonitor(clusterNameOrID, nlbSubdomain string) error
+	DisableNlbDNSMonitor(clusterNameOrID, nlbSubdomain string) error
 }
 type NlbVPCListConfig struct {
 	// ExtendedNlbVPCConfig is the response body for the get v2 vpc apis.
@@ -0,0 +1,63 @@
+package containerv2
+
+//NlbDNSCreateReq registers the hostname of a VPC load balancer with an NLB DNS subdomain
+type NlbDNSCreateReq struct {
+	Cluster         string `json:"cluster"`
+	LbHostname      string `json:"lbHostname"`
+	Type            string `json:"type"`
+	SecretNamespace string `json:"secretNamespace,omitempty"`
+}
+
+//NlbDNSReplaceReq replaces the VPC load balancer hostname of an NLB DNS subdomain
+type NlbDNSReplaceReq struct {
+	Cluster      string `json:"cluster"`
+	LbHostname   string `json:"lbHostname"`
+	NlbSubdomain string `json:"nlbSubdomain"`
+}
+
+type nlbDNSSubdomainReq struct {
+	Cluster      string `json:"cluster"`
+	NlbSubdomain string `json:"nlbSubdomain"`
+}
+
+type nlbDNSCreateResp struct {
+	NlbSubdomain string `json:"nlbSubdomain"`
+}
+
+//CreateNlbDNS creates an NLB DNS subdomain for a VPC load balancer and returns the subdomain
+func (r *nlbdns) CreateNlbDNS(nlbDNSCreateReq NlbDNSCreateReq) (string, error) {
+	var successV nlbDNSCreateResp
+	_, err := r.client.Post("/v2/nlb-dns/vpc/createNlbDNS", nlbDNSCreateReq, &successV)
+	return successV.NlbSubdomain, err
+}
+
+//ReplaceNlbDNS points an NLB DNS subdomain to another VPC load balancer
+func (r *nlbdns) ReplaceNlbDNS(nlbDNSReplaceReq NlbDNSReplaceReq) error {
+	// Make the request, don't care about return value
+	_, err := r.client.Patch("/v2/nlb-dns/vpc/replaceLBHostname", nlbDNSReplaceReq, nil)
+	return err
+}
+
+//RemoveNlbDNS deletes an NLB DNS subdomain of a cluster
+func (r *nlbdns) RemoveNlbDNS(clusterNameOrID, nlbSubdomain string) error {
+	return r.subdomainAction("/v2/nlb-dns/deleteSubdomain", clusterNameOrID, nlbSubdomain)
+}
+
+//EnableNlbDNSMonitor enables the health monitor of an NLB DNS subdomain
+func (r *nlbdns) EnableNlbDNSMonitor(clusterNameOrID, nlbSubdomain string) error {
+	return r.subdomainAction("/v2/nlb-dns/enableMonitor", clusterNameOrID, nlbSubdomain)
+}
+
+//DisableNlbDNSMonitor disables the health monitor of an NLB DNS subdomain
+func (r *nlbdns) DisableNlbDNSMonitor(clusterNameOrID, nlbSubdomain string) error {
+	return r.subdomainAction("/v2/nlb-dns/disableMonitor", clusterNameOrID, nlbSubdomain)
+}
+
+func (r *nlbdns) subdomainAction(path, clusterNameOrID, nlbSubdomain string) error {
+	req := nlbDNSSubdomainReq{
+		Cluster:      clusterNameOrID,
+		NlbSubdomain: nlbSubdomain,
+	}
+	_, err := r.client.Post(path, req, nil)
+	return err
+}
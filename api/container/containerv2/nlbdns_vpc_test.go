@@ -0,0 +1,220 @@
+package containerv2
+
+import (
+	"log"
+	"net/http"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/client"
+	bluemixHttp "github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/session"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Nlbdns", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("GetNLBDNSList", func() {
+		Context("When listing the NLB DNS of a VPC cluster is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/nlb-dns/getNlbDNSList", "cluster=mycluster"),
+						ghttp.RespondWith(http.StatusOK, `[{"Nlb":{"cluster":"mycluster","dnsType":"public","lbHostname":"abc-us-south.lb.appdomain.cloud","nlbSubdomain":"mycluster-abc-0001.us-south.containers.appdomain.cloud","nlbMonitorState":"enabled","type":"public"},"secretName":"mycluster-abc-0001","secretStatus":"created"}]`),
+					),
+				)
+			})
+
+			It("should return the NLB DNS", func() {
+				nlbs, err := newNlbdns(server.URL()).GetNLBDNSList("mycluster")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(nlbs).To(HaveLen(1))
+				Expect(nlbs[0].Nlb.LbHostname).To(Equal("abc-us-south.lb.appdomain.cloud"))
+				Expect(nlbs[0].Nlb.NlbMonitorState).To(Equal("enabled"))
+				Expect(nlbs[0].SecretName).To(Equal("mycluster-abc-0001"))
+			})
+		})
+	})
+
+	Describe("CreateNlbDNS", func() {
+		Context("When creating the NLB DNS is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v2/nlb-dns/vpc/createNlbDNS"),
+						ghttp.VerifyJSON(`{"cluster":"mycluster","lbHostname":"abc-us-south.lb.appdomain.cloud","type":"public"}`),
+						ghttp.RespondWith(http.StatusCreated, `{"nlbSubdomain":"mycluster-abc-0001.us-south.containers.appdomain.cloud"}`),
+					),
+				)
+			})
+
+			It("should return the subdomain", func() {
+				subdomain, err := newNlbdns(server.URL()).CreateNlbDNS(NlbDNSCreateReq{
+					Cluster:    "mycluster",
+					LbHostname: "abc-us-south.lb.appdomain.cloud",
+					Type:       "public",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(subdomain).To(Equal("mycluster-abc-0001.us-south.containers.appdomain.cloud"))
+			})
+		})
+		Context("When creating the NLB DNS is unsuccessful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.SetAllowUnhandledRequests(true)
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v2/nlb-dns/vpc/createNlbDNS"),
+						ghttp.RespondWith(http.StatusInternalServerError, `Failed to create the NLB DNS`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				_, err := newNlbdns(server.URL()).CreateNlbDNS(NlbDNSCreateReq{Cluster: "mycluster"})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("ReplaceNlbDNS", func() {
+		Context("When replacing the load balancer hostname is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPatch, "/v2/nlb-dns/vpc/replaceLBHostname"),
+						ghttp.VerifyJSON(`{"cluster":"mycluster","lbHostname":"def-us-south.lb.appdomain.cloud","nlbSubdomain":"mycluster-abc-0001.us-south.containers.appdomain.cloud"}`),
+						ghttp.RespondWith(http.StatusNoContent, ``),
+					),
+				)
+			})
+
+			It("should replace the load balancer hostname", func() {
+				err := newNlbdns(server.URL()).ReplaceNlbDNS(NlbDNSReplaceReq{
+					Cluster:      "mycluster",
+					LbHostname:   "def-us-south.lb.appdomain.cloud",
+					NlbSubdomain: "mycluster-abc-0001.us-south.containers.appdomain.cloud",
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("RemoveNlbDNS", func() {
+		Context("When removing the NLB DNS is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v2/nlb-dns/deleteSubdomain"),
+						ghttp.VerifyJSON(`{"cluster":"mycluster","nlbSubdomain":"mycluster-abc-0001.us-south.containers.appdomain.cloud"}`),
+						ghttp.RespondWith(http.StatusNoContent, ``),
+					),
+				)
+			})
+
+			It("should remove the NLB DNS", func() {
+				err := newNlbdns(server.URL()).RemoveNlbDNS("mycluster", "mycluster-abc-0001.us-south.containers.appdomain.cloud")
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+		Context("When removing the NLB DNS is unsuccessful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.SetAllowUnhandledRequests(true)
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v2/nlb-dns/deleteSubdomain"),
+						ghttp.RespondWith(http.StatusInternalServerError, `Failed to remove the NLB DNS`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				err := newNlbdns(server.URL()).RemoveNlbDNS("mycluster", "mycluster-abc-0001.us-south.containers.appdomain.cloud")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("NLB DNS monitor", func() {
+		Context("When enabling the monitor is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v2/nlb-dns/enableMonitor"),
+						ghttp.VerifyJSON(`{"cluster":"mycluster","nlbSubdomain":"mycluster-abc-0001.us-south.containers.appdomain.cloud"}`),
+						ghttp.RespondWith(http.StatusNoContent, ``),
+					),
+				)
+			})
+
+			It("should enable the monitor", func() {
+				err := newNlbdns(server.URL()).EnableNlbDNSMonitor("mycluster", "mycluster-abc-0001.us-south.containers.appdomain.cloud")
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+		Context("When disabling the monitor is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v2/nlb-dns/disableMonitor"),
+						ghttp.VerifyJSON(`{"cluster":"mycluster","nlbSubdomain":"mycluster-abc-0001.us-south.containers.appdomain.cloud"}`),
+						ghttp.RespondWith(http.StatusNoContent, ``),
+					),
+				)
+			})
+
+			It("should disable the monitor", func() {
+				err := newNlbdns(server.URL()).DisableNlbDNSMonitor("mycluster", "mycluster-abc-0001.us-south.containers.appdomain.cloud")
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+		Context("When disabling the monitor is unsuccessful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.SetAllowUnhandledRequests(true)
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v2/nlb-dns/disableMonitor"),
+						ghttp.RespondWith(http.StatusInternalServerError, `Failed to disable the monitor`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				err := newNlbdns(server.URL()).DisableNlbDNSMonitor("mycluster", "mycluster-abc-0001.us-south.containers.appdomain.cloud")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
+
+func newNlbdns(url string) Nlbdns {
+
+	sess, err := session.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+	conf := sess.Config.Copy()
+	conf.HTTPClient = bluemixHttp.NewHTTPClient(conf)
+	conf.Endpoint = &url
+
+	client := client.Client{
+		Config:      conf,
+		ServiceName: bluemix.VpcContainerService,
+	}
+	return newNlbdnsAPI(&client)
+}
@@ -81,6 +81,7 @@ type LoggingDeleteRequest struct {
 }
 
 //Logging interface
+//go:generate counterfeiter . Logging
 type Logging interface {
 	CreateLoggingConfig(params LoggingCreateRequest, target LoggingTargetHeader) (LoggingCreateResponse, error)
 	GetLoggingConfig(clusterName string, LoggingInstance string, target LoggingTargetHeader) (*LoggingInfo, error)
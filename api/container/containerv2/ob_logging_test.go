@@ -128,6 +128,98 @@ var _ = Describe("Logging", func() {
 			})
 		})
 	})
+	//Get
+	Describe("GetLoggingConfig", func() {
+		Context("When read of logging config is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/observe/logging/getConfig", "cluster=DragonBoat-cluster&instance=ec4f0886-edc4-409e-8720-574035538f91"),
+						ghttp.RespondWith(http.StatusOK, `{
+							 "instanceId": "ec4f0886-edc4-409e-8720-574035538f91",
+							 "instanceName": "ns",
+							 "daemonsetName": "logdna-agent"
+						}`),
+					),
+				)
+			})
+
+			It("should return the logging config", func() {
+				target := LoggingTargetHeader{}
+				myLogging, err := newLogging(server.URL()).GetLoggingConfig("DragonBoat-cluster", "ec4f0886-edc4-409e-8720-574035538f91", target)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(myLogging.InstanceName).Should(Equal("ns"))
+				Expect(myLogging.DaemonsetName).Should(Equal("logdna-agent"))
+			})
+		})
+		Context("When read of logging config is unsuccessful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.SetAllowUnhandledRequests(true)
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/observe/logging/getConfig"),
+						ghttp.RespondWith(http.StatusInternalServerError, `Failed to retrieve logging config`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				target := LoggingTargetHeader{}
+				myLogging, err := newLogging(server.URL()).GetLoggingConfig("DragonBoat-cluster", "ec4f0886-edc4-409e-8720-574035538f91", target)
+				Expect(err).To(HaveOccurred())
+				Expect(myLogging).Should(BeNil())
+			})
+		})
+	})
+
+	//Delete
+	Describe("DeleteLoggingConfig", func() {
+		Context("When delete is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v2/observe/logging/removeConfig"),
+						ghttp.VerifyJSON(`{"cluster": "DragonBoat-cluster", "instance": "ec4f0886-edc4-409e-8720-574035538f91"}`),
+						ghttp.VerifyHeaderKV("X-Auth-Resource-Account", "abc"),
+						ghttp.RespondWith(http.StatusOK, `"OK"`),
+					),
+				)
+			})
+
+			It("should delete the logging config", func() {
+				params := LoggingDeleteRequest{
+					Cluster: "DragonBoat-cluster", Instance: "ec4f0886-edc4-409e-8720-574035538f91",
+				}
+				target := LoggingTargetHeader{AccountID: "abc"}
+				_, err := newLogging(server.URL()).DeleteLoggingConfig(params, target)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+		Context("When delete is unsuccessful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.SetAllowUnhandledRequests(true)
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v2/observe/logging/removeConfig"),
+						ghttp.RespondWith(http.StatusInternalServerError, `Failed to delete logging config`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				params := LoggingDeleteRequest{
+					Cluster: "DragonBoat-cluster", Instance: "ec4f0886-edc4-409e-8720-574035538f91",
+				}
+				target := LoggingTargetHeader{}
+				_, err := newLogging(server.URL()).DeleteLoggingConfig(params, target)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
 })
 
 func newLogging(url string) Logging {
@@ -81,6 +81,7 @@ type MonitoringDeleteRequest struct {
 }
 
 //Monitoring interface
+//go:generate counterfeiter . Monitoring
 type Monitoring interface {
 	CreateMonitoringConfig(params MonitoringCreateRequest, target MonitoringTargetHeader) (MonitoringCreateResponse, error)
 	GetMonitoringConfig(clusterName string, monitoringInstance string, target MonitoringTargetHeader) (*MonitoringInfo, error)
@@ -129,6 +129,98 @@ var _ = Describe("Monitoring", func() {
 			})
 		})
 	})
+	//Get
+	Describe("GetMonitoringConfig", func() {
+		Context("When read of monitoring config is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/observe/monitoring/getConfig", "cluster=DragonBoat-cluster&instance=ec4f0886-edc4-409e-8720-574035538f91"),
+						ghttp.RespondWith(http.StatusOK, `{
+							 "instanceId": "ec4f0886-edc4-409e-8720-574035538f91",
+							 "instanceName": "ns",
+							 "daemonsetName": "sysdig-agent"
+						}`),
+					),
+				)
+			})
+
+			It("should return the monitoring config", func() {
+				target := MonitoringTargetHeader{}
+				myMonitor, err := newMonitoring(server.URL()).GetMonitoringConfig("DragonBoat-cluster", "ec4f0886-edc4-409e-8720-574035538f91", target)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(myMonitor.InstanceName).Should(Equal("ns"))
+				Expect(myMonitor.DaemonsetName).Should(Equal("sysdig-agent"))
+			})
+		})
+		Context("When read of monitoring config is unsuccessful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.SetAllowUnhandledRequests(true)
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/observe/monitoring/getConfig"),
+						ghttp.RespondWith(http.StatusInternalServerError, `Failed to retrieve monitoring config`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				target := MonitoringTargetHeader{}
+				myMonitor, err := newMonitoring(server.URL()).GetMonitoringConfig("DragonBoat-cluster", "ec4f0886-edc4-409e-8720-574035538f91", target)
+				Expect(err).To(HaveOccurred())
+				Expect(myMonitor).Should(BeNil())
+			})
+		})
+	})
+
+	//Delete
+	Describe("DeleteMonitoringConfig", func() {
+		Context("When delete is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v2/observe/monitoring/removeConfig"),
+						ghttp.VerifyJSON(`{"cluster": "DragonBoat-cluster", "instance": "ec4f0886-edc4-409e-8720-574035538f91"}`),
+						ghttp.VerifyHeaderKV("X-Auth-Resource-Account", "abc"),
+						ghttp.RespondWith(http.StatusOK, `"OK"`),
+					),
+				)
+			})
+
+			It("should delete the monitoring config", func() {
+				params := MonitoringDeleteRequest{
+					Cluster: "DragonBoat-cluster", Instance: "ec4f0886-edc4-409e-8720-574035538f91",
+				}
+				target := MonitoringTargetHeader{AccountID: "abc"}
+				_, err := newMonitoring(server.URL()).DeleteMonitoringConfig(params, target)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+		Context("When delete is unsuccessful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.SetAllowUnhandledRequests(true)
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v2/observe/monitoring/removeConfig"),
+						ghttp.RespondWith(http.StatusInternalServerError, `Failed to delete monitoring config`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				params := MonitoringDeleteRequest{
+					Cluster: "DragonBoat-cluster", Instance: "ec4f0886-edc4-409e-8720-574035538f91",
+				}
+				target := MonitoringTargetHeader{}
+				_, err := newMonitoring(server.URL()).DeleteMonitoringConfig(params, target)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
 })
 
 func newMonitoring(url string) Monitoring {
@@ -32,7 +32,6 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/client"
 	bxhttp "github.com/IBM-Cloud/bluemix-go/http"
 	"github.com/IBM-Cloud/bluemix-go/rest"
-	"github.com/IBM-Cloud/bluemix-go/trace"
 )
 
 const (
@@ -119,58 +118,24 @@ func (r *clusters) FetchOCTokenForKubeConfig(kubecfg []byte, cMeta *ClusterInfo,
 		return kubecfg, err
 	}
 
-	var token, passcode string
-	if r.client.Config.BluemixAPIKey == "" {
-		trace.Logger.Println("Creating user passcode to login for getting oc token")
-
-		// Retry to cover rate limiting on passcode endpoint in particular
-		for try := 1; try <= 3; try++ {
-			passcode, err = r.client.TokenRefresher.GetPasscode()
-
-			if err == nil {
-				break
-			}
-
-			if err != nil && try == 3 {
-				return kubecfg, err
-			}
-
-			time.Sleep(1 * time.Second)
-		}
+	passcode, err := r.getOpenShiftPasscode()
+	if err != nil {
+		return kubecfg, err
 	}
 
-	authEP, err := func(meta *ClusterInfo) (*authEndpoints, error) {
-		request := rest.GetRequest(meta.ServerURL + "/.well-known/oauth-authorization-server")
-		var auth authEndpoints
-
-		// Create new REST client - reusing modified existing client instances could lead to race conditions
-		restClient := &rest.Client{}
-		resp, err := restClient.Do(request, &auth, nil)
-
-		if err != nil {
-			return &auth, err
-		}
-		defer resp.Body.Close()
-		if resp.StatusCode > 299 {
-			msg, _ := ioutil.ReadAll(resp.Body)
-			return nil, fmt.Errorf("Bad status code [%d] returned when fetching Cluster authentication endpoints: %s", resp.StatusCode, msg)
-		}
-		auth.ServerURL = meta.ServerURL
-		return &auth, nil
-	}(cMeta)
-
+	authEP, err := getOpenShiftAuthEndpoints(cMeta)
 	if err != nil {
 		return kubecfg, err
 	}
 
-	trace.Logger.Println("Got authentication end points for getting oc token")
+	r.client.Logger().Debug("Got authentication end points for getting oc token")
 	token, uname, err := r.openShiftAuthorizePasscode(authEP, passcode, cMeta.IsStagingSatelliteCluster())
 
 	if err != nil {
 		return kubecfg, err
 	}
 
-	trace.Logger.Println("Got the token and user ", uname)
+	r.client.Logger().Debug("Got the token", "user", uname)
 	clusterName, _ := NormalizeName(authEP.ServerURL[len("https://"):len(authEP.ServerURL)]) //TODO deal with http
 	ccontext := "default/" + clusterName + "/" + uname
 	uname = uname + "/" + clusterName
@@ -202,6 +167,47 @@ func (r *clusters) FetchOCTokenForKubeConfig(kubecfg []byte, cMeta *ClusterInfo,
 	return kubecfg, nil
 }
 
+// getOpenShiftPasscode creates a user passcode for the OpenShift login. No
+// passcode is needed when an API key is configured.
+func (r *clusters) getOpenShiftPasscode() (passcode string, err error) {
+	if r.client.Config.BluemixAPIKey != "" {
+		return "", nil
+	}
+	r.client.Logger().Debug("Creating user passcode to login for getting oc token")
+
+	// Retry to cover rate limiting on passcode endpoint in particular
+	for try := 1; try <= 3; try++ {
+		passcode, err = r.client.TokenRefresher.GetPasscode()
+		if err == nil {
+			break
+		}
+		if try < 3 {
+			time.Sleep(1 * time.Second)
+		}
+	}
+	return passcode, err
+}
+
+func getOpenShiftAuthEndpoints(meta *ClusterInfo) (*authEndpoints, error) {
+	request := rest.GetRequest(meta.ServerURL + "/.well-known/oauth-authorization-server")
+	var auth authEndpoints
+
+	// Create new REST client - reusing modified existing client instances could lead to race conditions
+	restClient := &rest.Client{}
+	resp, err := restClient.Do(request, &auth, nil)
+
+	if err != nil {
+		return &auth, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode > 299 {
+		msg, _ := ioutil.ReadAll(resp.Body)
+		return nil, fmt.Errorf("Bad status code [%d] returned when fetching Cluster authentication endpoints: %s", resp.StatusCode, msg)
+	}
+	auth.ServerURL = meta.ServerURL
+	return &auth, nil
+}
+
 // Never redirect. Let caller handle. This is an http.Client callback method (CheckRedirect)
 func neverRedirect(req *http.Request, via []*http.Request) error {
 	return http.ErrUseLastResponse
@@ -222,7 +228,7 @@ func (r *clusters) openShiftAuthorizePasscode(authEP *authEndpoints, passcode st
 	copyConfig.HTTPClient = bxhttp.NewHTTPClient(copyConfig)
 	copyConfig.HTTPClient.CheckRedirect = neverRedirect
 
-	client := client.New(copyConfig, r.client.ServiceName, r.client.TokenRefresher)
+	client := client.New(copyConfig, r.client.ServiceName, r.client.TokenRefresher).WithContext(r.client.Context())
 
 	var respInterface interface{}
 	var resp *http.Response
@@ -256,7 +262,7 @@ func (r *clusters) openShiftAuthorizePasscode(authEP *authEndpoints, passcode st
 		return "", "", err
 	}
 	token := val.Get("access_token")
-	trace.Logger.Println("Getting username after getting the token")
+	r.client.Logger().Debug("Getting username after getting the token")
 	name, err := r.getOpenShiftUser(authEP, token)
 	if err != nil {
 		return "", "", err
@@ -0,0 +1,80 @@
+package containerv2
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/bluemix-go/helpers"
+)
+
+//OperationGuard serializes the mutating operations sent for a cluster from
+//this process, and retries the operations the service rejects because another
+//operation is already in progress on the cluster.
+type OperationGuard struct {
+	//Retry defaults to 5 retries starting 10 seconds apart
+	Retry *bluemix.RetryPolicy
+	//ConflictCodes are the IKS error codes retried. When empty any 409 Conflict
+	//response is retried.
+	ConflictCodes []string
+
+	mu    sync.Mutex
+	locks map[string]chan struct{}
+}
+
+//NewOperationGuard ...
+func NewOperationGuard() *OperationGuard {
+	return &OperationGuard{}
+}
+
+//Do runs op once no other operation of the guard runs for the cluster, and
+//runs it again while it fails with a conflict. It returns the context error
+//if ctx is done while waiting.
+func (g *OperationGuard) Do(ctx context.Context, clusterID string, op func() error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	lock := g.lock(clusterID)
+	select {
+	case lock <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-lock }()
+
+	policy := g.Retry
+	if policy == nil {
+		policy = &bluemix.RetryPolicy{MaxRetries: 5, InitialDelay: 10 * time.Second}
+	}
+	return helpers.Retry(ctx, policy.Backoff(), policy.MaxRetries, g.conflict, op)
+}
+
+func (g *OperationGuard) lock(clusterID string) chan struct{} {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.locks == nil {
+		g.locks = map[string]chan struct{}{}
+	}
+	lock, ok := g.locks[clusterID]
+	if !ok {
+		lock = make(chan struct{}, 1)
+		g.locks[clusterID] = lock
+	}
+	return lock
+}
+
+func (g *OperationGuard) conflict(err error) bool {
+	if svcErr, ok := ParseServiceError(err); ok && len(g.ConflictCodes) > 0 {
+		for _, code := range g.ConflictCodes {
+			if svcErr.ErrorCode == code {
+				return true
+			}
+		}
+		return false
+	}
+	rf, ok := err.(bmxerror.RequestFailure)
+	return ok && len(g.ConflictCodes) == 0 && rf.StatusCode() == http.StatusConflict
+}
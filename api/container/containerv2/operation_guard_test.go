@@ -0,0 +1,106 @@
+package containerv2
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OperationGuard", func() {
+	var guard *OperationGuard
+	conflict := bmxerror.NewRequestFailure("ServerErrorResponse", `{"code":"E0075","description":"Another operation is in progress on the cluster."}`, 409)
+	BeforeEach(func() {
+		guard = NewOperationGuard()
+		guard.Retry = &bluemix.RetryPolicy{MaxRetries: 3, InitialDelay: time.Millisecond}
+	})
+
+	It("should serialize the operations of a cluster", func() {
+		var running, max int32
+		var wg sync.WaitGroup
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				Expect(guard.Do(context.Background(), "c1", func() error {
+					n := atomic.AddInt32(&running, 1)
+					if n > atomic.LoadInt32(&max) {
+						atomic.StoreInt32(&max, n)
+					}
+					time.Sleep(5 * time.Millisecond)
+					atomic.AddInt32(&running, -1)
+					return nil
+				})).To(Succeed())
+			}()
+		}
+		wg.Wait()
+		Expect(max).To(Equal(int32(1)))
+	})
+	It("should retry while another operation is in progress", func() {
+		calls := 0
+		err := guard.Do(context.Background(), "c1", func() error {
+			calls++
+			if calls < 3 {
+				return conflict
+			}
+			return nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(calls).To(Equal(3))
+	})
+	It("should stop after the retries of the policy", func() {
+		calls := 0
+		err := guard.Do(context.Background(), "c1", func() error {
+			calls++
+			return conflict
+		})
+		Expect(err).To(Equal(conflict))
+		Expect(calls).To(Equal(4))
+	})
+	It("should not retry other failures", func() {
+		calls := 0
+		failure := bmxerror.NewRequestFailure("ServerErrorResponse", `{"code":"E0004","description":"Not found"}`, 404)
+		err := guard.Do(context.Background(), "c1", func() error {
+			calls++
+			return failure
+		})
+		Expect(err).To(Equal(failure))
+		Expect(calls).To(Equal(1))
+	})
+	It("should only retry the configured codes", func() {
+		guard.ConflictCodes = []string{"E0076"}
+		calls := 0
+		err := guard.Do(context.Background(), "c1", func() error {
+			calls++
+			return conflict
+		})
+		Expect(err).To(Equal(conflict))
+		Expect(calls).To(Equal(1))
+	})
+	It("should stop waiting for the cluster when the context is done", func() {
+		release := make(chan struct{})
+		started := make(chan struct{})
+		go func() {
+			guard.Do(context.Background(), "c1", func() error {
+				close(started)
+				<-release
+				return nil
+			})
+		}()
+		<-started
+		defer close(release)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		err := guard.Do(ctx, "c1", func() error { return nil })
+		Expect(err).To(Equal(context.DeadlineExceeded))
+		Expect(guard.Do(ctx, "c2", func() error { return nil })).To(Equal(context.DeadlineExceeded))
+	})
+})
@@ -0,0 +1,81 @@
+package containerv2
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/IBM-Cloud/bluemix-go/api/resource/resourcev2/managementv2"
+	"github.com/IBM-Cloud/bluemix-go/session"
+)
+
+//resourceGroupResolver resolves resource group names to IDs. Results are
+//cached per account since resource group IDs never change.
+type resourceGroupResolver struct {
+	mu      sync.Mutex
+	newRepo func() (managementv2.ResourceGroupRepository, error)
+	repo    managementv2.ResourceGroupRepository
+	cache   map[string]string
+}
+
+//newSessionResourceGroupResolver returns a resolver looking names up with the
+//credentials of sess. The resource manager client is created on first use.
+func newSessionResourceGroupResolver(sess *session.Session) *resourceGroupResolver {
+	return newResourceGroupResolver(func() (managementv2.ResourceGroupRepository, error) {
+		api, err := managementv2.New(sess)
+		if err != nil {
+			return nil, err
+		}
+		return api.ResourceGroup(), nil
+	})
+}
+
+func newResourceGroupResolver(newRepo func() (managementv2.ResourceGroupRepository, error)) *resourceGroupResolver {
+	return &resourceGroupResolver{
+		newRepo: newRepo,
+		cache:   map[string]string{},
+	}
+}
+
+func (r *resourceGroupResolver) resolve(accountID, name string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := accountID + "/" + name
+	if id, ok := r.cache[key]; ok {
+		return id, nil
+	}
+	if r.repo == nil {
+		repo, err := r.newRepo()
+		if err != nil {
+			return "", err
+		}
+		r.repo = repo
+	}
+	groups, err := r.repo.FindByName(&managementv2.ResourceGroupQuery{AccountID: accountID}, name)
+	if err != nil {
+		return "", err
+	}
+	if len(groups) == 0 {
+		return "", fmt.Errorf("Resource group %q was not found", name)
+	}
+	r.cache[key] = groups[0].ID
+	return groups[0].ID, nil
+}
+
+//ResolveTarget returns target with ResourceGroup set to the ID of the resource
+//group named ResourceGroupName, looked up with the credentials of the session
+//the service was created with. Targets which already have a resource group ID
+//are returned unchanged.
+func (c *csService) ResolveTarget(target ClusterTargetHeader) (ClusterTargetHeader, error) {
+	if target.ResourceGroup != "" || target.ResourceGroupName == "" {
+		return target, nil
+	}
+	if c.resolver == nil {
+		return target, fmt.Errorf("Unable to resolve resource group %q, no resolver available", target.ResourceGroupName)
+	}
+	id, err := c.resolver.resolve(target.AccountID, target.ResourceGroupName)
+	if err != nil {
+		return target, fmt.Errorf("Unable to resolve resource group %q: %v", target.ResourceGroupName, err)
+	}
+	target.ResourceGroup = id
+	return target, nil
+}
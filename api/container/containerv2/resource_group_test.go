@@ -0,0 +1,87 @@
+package containerv2
+
+import (
+	"errors"
+
+	"github.com/IBM-Cloud/bluemix-go/api/resource/resourcev2/managementv2"
+	"github.com/IBM-Cloud/bluemix-go/models"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type fakeResourceGroups struct {
+	managementv2.ResourceGroupRepository
+	calls int
+	err   error
+}
+
+func (f *fakeResourceGroups) FindByName(query *managementv2.ResourceGroupQuery, name string) ([]models.ResourceGroupv2, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return []models.ResourceGroupv2{{ResourceGroup: models.ResourceGroup{ID: "rg-" + query.AccountID + "-" + name}}}, nil
+}
+
+var _ = Describe("Resource group resolution", func() {
+	var fake *fakeResourceGroups
+	var service *csService
+	BeforeEach(func() {
+		fake = &fakeResourceGroups{}
+		service = &csService{
+			resolver: newResourceGroupResolver(func() (managementv2.ResourceGroupRepository, error) {
+				return fake, nil
+			}),
+		}
+	})
+
+	Context("When only a resource group name is set", func() {
+		It("should resolve the name to an ID once", func() {
+			target := ClusterTargetHeader{AccountID: "acc", ResourceGroupName: "default"}
+			resolved, err := service.ResolveTarget(target)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resolved.ResourceGroup).To(Equal("rg-acc-default"))
+			Expect(resolved.ToMap()[resourceGroupHeader]).To(Equal("rg-acc-default"))
+			_, err = service.ResolveTarget(target)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fake.calls).To(Equal(1))
+		})
+	})
+	Context("When a resource group ID is set", func() {
+		It("should not look up the name", func() {
+			target := ClusterTargetHeader{ResourceGroup: "rgid", ResourceGroupName: "default"}
+			resolved, err := service.ResolveTarget(target)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resolved.ToMap()[resourceGroupHeader]).To(Equal("rgid"))
+			Expect(fake.calls).To(Equal(0))
+		})
+	})
+	Context("When the name can not be resolved", func() {
+		It("should return an error", func() {
+			fake.err = errors.New("Given resource Group : \"missing\" doesn't exist")
+			_, err := service.ResolveTarget(ClusterTargetHeader{AccountID: "acc", ResourceGroupName: "missing"})
+			Expect(err).To(MatchError(ContainSubstring("missing")))
+		})
+	})
+	Context("When each service has its own session", func() {
+		It("should resolve names with the resolver of the service", func() {
+			other := &fakeResourceGroups{}
+			otherService := &csService{
+				resolver: newResourceGroupResolver(func() (managementv2.ResourceGroupRepository, error) {
+					return other, nil
+				}),
+			}
+			_, err := otherService.ResolveTarget(ClusterTargetHeader{AccountID: "acc2", ResourceGroupName: "default"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(other.calls).To(Equal(1))
+			Expect(fake.calls).To(Equal(0))
+		})
+	})
+	Context("When the name is not resolved", func() {
+		It("should not send an empty resource group", func() {
+			target := ClusterTargetHeader{ResourceGroupName: "default"}
+			Expect(target.ToMap()[resourceGroupHeader]).To(Equal("default"))
+		})
+	})
+})
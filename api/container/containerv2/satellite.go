@@ -88,6 +88,7 @@ type SatelliteLocationInfo struct {
 	OpenVpnServerPort int `json:"open_vpn_server_port"`
 }
 
+//go:generate counterfeiter . Satellite
 type Satellite interface {
 	GetLocationInfo(name string, target ClusterTargetHeader) (*SatelliteLocationInfo, error)
 }
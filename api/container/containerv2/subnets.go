@@ -23,6 +23,7 @@ type subnet struct {
 }
 
 //Subnets interface
+//go:generate counterfeiter . Subnets
 type Subnets interface {
 	ListSubnets(vpcID, zone string, target ClusterTargetHeader) ([]SubnetConfig, error)
 }
@@ -0,0 +1,72 @@
+package containerv2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/client"
+)
+
+//openshiftSuffix ends the OpenShift versions of the cluster create requests, e.g. 4.15_openshift
+const openshiftSuffix = "_openshift"
+
+//VersionInfo is a Kubernetes or OpenShift version clusters can run
+type VersionInfo struct {
+	Major   int  `json:"major"`
+	Minor   int  `json:"minor"`
+	Patch   int  `json:"patch"`
+	Default bool `json:"default"`
+	//EndOfService is the date the version stops being supported, empty when not announced
+	EndOfService string `json:"end_of_service,omitempty"`
+}
+
+//String returns the version as major.minor.patch
+func (v VersionInfo) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+//VersionsInfo are the supported versions by platform
+type VersionsInfo struct {
+	Kubernetes []VersionInfo `json:"kubernetes"`
+	OpenShift  []VersionInfo `json:"openshift"`
+}
+
+//Find returns the supported version matching the version of a cluster create
+//request, given as major.minor or major.minor.patch and ending with _openshift
+//for OpenShift
+func (vs VersionsInfo) Find(version string) (VersionInfo, bool) {
+	versions := vs.Kubernetes
+	if strings.HasSuffix(version, openshiftSuffix) {
+		versions = vs.OpenShift
+		version = strings.TrimSuffix(version, openshiftSuffix)
+	}
+	for _, v := range versions {
+		if version == fmt.Sprintf("%d.%d", v.Major, v.Minor) || version == v.String() {
+			return v, true
+		}
+	}
+	return VersionInfo{}, false
+}
+
+//Versions interface
+//go:generate counterfeiter . Versions
+type Versions interface {
+	ListVersions(target ClusterTargetHeader) (VersionsInfo, error)
+}
+
+type versions struct {
+	client *client.Client
+}
+
+func newVersionsAPI(c *client.Client) Versions {
+	return &versions{
+		client: c,
+	}
+}
+
+//ListVersions returns the supported Kubernetes and OpenShift versions
+func (r *versions) ListVersions(target ClusterTargetHeader) (VersionsInfo, error) {
+	var successV VersionsInfo
+	_, err := r.client.Get("/v2/getVersions", &successV, target.ToMap())
+	return successV, err
+}
@@ -0,0 +1,103 @@
+package containerv2
+
+import (
+	"log"
+	"net/http"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/client"
+	bluemixHttp "github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/session"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Versions", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("ListVersions", func() {
+		Context("When the versions are read", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/getVersions"),
+						ghttp.RespondWith(http.StatusOK, `{
+							"kubernetes": [
+								{"major": 1, "minor": 29, "patch": 14, "default": false, "end_of_service": "2025-04-30"},
+								{"major": 1, "minor": 30, "patch": 10, "default": true}
+							],
+							"openshift": [
+								{"major": 4, "minor": 15, "patch": 45, "default": true, "end_of_service": "2025-08-27"}
+							]
+						}`),
+					),
+				)
+			})
+
+			It("should return the versions of both platforms", func() {
+				versions, err := newVersions(server.URL()).ListVersions(ClusterTargetHeader{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(versions.Kubernetes).To(HaveLen(2))
+				Expect(versions.Kubernetes[0].EndOfService).To(Equal("2025-04-30"))
+				Expect(versions.Kubernetes[1].Default).To(BeTrue())
+				Expect(versions.OpenShift).To(HaveLen(1))
+				Expect(versions.OpenShift[0].String()).To(Equal("4.15.45"))
+			})
+			It("should find the versions of the cluster create requests", func() {
+				versions, err := newVersions(server.URL()).ListVersions(ClusterTargetHeader{})
+				Expect(err).NotTo(HaveOccurred())
+				v, ok := versions.Find("1.30")
+				Expect(ok).To(BeTrue())
+				Expect(v.Patch).To(Equal(10))
+				_, ok = versions.Find("1.29.14")
+				Expect(ok).To(BeTrue())
+				_, ok = versions.Find("4.15_openshift")
+				Expect(ok).To(BeTrue())
+				_, ok = versions.Find("4.15")
+				Expect(ok).To(BeFalse())
+				_, ok = versions.Find("1.28")
+				Expect(ok).To(BeFalse())
+			})
+		})
+		Context("When read of versions is unsuccessful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/getVersions"),
+						ghttp.RespondWith(http.StatusInternalServerError, `Failed to read versions`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				_, err := newVersions(server.URL()).ListVersions(ClusterTargetHeader{})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
+
+func newVersions(url string) Versions {
+
+	sess, err := session.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+	conf := sess.Config.Copy()
+	conf.HTTPClient = bluemixHttp.NewHTTPClient(conf)
+	conf.Endpoint = &url
+
+	client := client.Client{
+		Config:      conf,
+		ServiceName: bluemix.VpcContainerService,
+	}
+	return newVersionsAPI(&client)
+}
@@ -19,6 +19,7 @@ type vpc struct {
 }
 
 //VPCs interface
+//go:generate counterfeiter . VPCs
 type VPCs interface {
 	ListVPCs(target ClusterTargetHeader) ([]VPCConfig, error)
 }
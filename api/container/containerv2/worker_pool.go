@@ -101,6 +101,7 @@ type ResizeWorkerPoolReq struct {
 }
 
 //Workers ...
+//go:generate counterfeiter . WorkerPool
 type WorkerPool interface {
 	CreateWorkerPool(workerPoolReq WorkerPoolRequest, target ClusterTargetHeader) (WorkerPoolResponse, error)
 	GetWorkerPool(clusterNameOrID, workerPoolNameOrID string, target ClusterTargetHeader) (GetWorkerPoolResponse, error)
@@ -108,7 +109,15 @@ type WorkerPool interface {
 	CreateWorkerPoolZone(workerPoolZone WorkerPoolZone, target ClusterTargetHeader) error
 	DeleteWorkerPool(clusterNameOrID string, workerPoolNameOrID string, target ClusterTargetHeader) error
 	UpdateWorkerPoolTaints(taintRequest WorkerPoolTaintRequest, target ClusterTargetHeader) error
+	SetWorkerPoolTaints(clusterNameOrID, workerPoolNameOrID string, taints []Taint, target ClusterTargetHeader) error
+	DeleteWorkerPoolTaints(clusterNameOrID, workerPoolNameOrID string, target ClusterTargetHeader) error
+	SetWorkerPoolLabels(labelRequest WorkerPoolLabelRequest, target ClusterTargetHeader) (map[string]string, error)
+	PatchWorkerPoolLabels(clusterNameOrID, workerPoolNameOrID string, set map[string]string, remove []string, target ClusterTargetHeader) (map[string]string, error)
+	SetWorkerPoolOperatingSystem(osRequest WorkerPoolOperatingSystemRequest, target ClusterTargetHeader) error
+	UpdateWorkerPool(clusterNameOrID, workerPoolNameOrID string, update WorkerPoolUpdate, target ClusterTargetHeader) error
 	ResizeWorkerPool(resizeWorkerPoolReq ResizeWorkerPoolReq, target ClusterTargetHeader) error
+	ImportWorkerPool(clusterNameOrID, workerPoolNameOrID string, target ClusterTargetHeader) (CommonWorkerPoolConfig, error)
+	GetCapacity(clusterNameOrID string, target ClusterTargetHeader) (ClusterCapacity, error)
 }
 
 type workerpool struct {
@@ -0,0 +1,131 @@
+package containerv2
+
+//WorkerPoolCapacity joins the configuration of a worker pool, the resources of
+//its flavor and its current workers
+type WorkerPoolCapacity struct {
+	WorkerPoolID string
+	PoolName     string
+	Flavor       string
+	Zones        []string
+	//DesiredWorkers is the size of the pool, its worker count per zone times its zones
+	DesiredWorkers int
+	//Workers is the number of workers the pool has, whatever their state
+	Workers int
+
+	CoresPerWorker    int
+	MemoryGBPerWorker int
+	GPUsPerWorker     int
+	//FlavorFound is false when the flavor isn't listed for the zones of the
+	//pool anymore, the per worker resources are then 0
+	FlavorFound bool
+}
+
+//TotalCores ...
+func (p WorkerPoolCapacity) TotalCores() int {
+	return p.Workers * p.CoresPerWorker
+}
+
+//TotalMemoryGB ...
+func (p WorkerPoolCapacity) TotalMemoryGB() int {
+	return p.Workers * p.MemoryGBPerWorker
+}
+
+//TotalGPUs ...
+func (p WorkerPoolCapacity) TotalGPUs() int {
+	return p.Workers * p.GPUsPerWorker
+}
+
+//ClusterCapacity is the capacity of the worker pools of a cluster
+type ClusterCapacity struct {
+	Cluster     string
+	WorkerPools []WorkerPoolCapacity
+}
+
+//TotalCores ...
+func (c ClusterCapacity) TotalCores() int {
+	total := 0
+	for _, p := range c.WorkerPools {
+		total += p.TotalCores()
+	}
+	return total
+}
+
+//TotalMemoryGB ...
+func (c ClusterCapacity) TotalMemoryGB() int {
+	total := 0
+	for _, p := range c.WorkerPools {
+		total += p.TotalMemoryGB()
+	}
+	return total
+}
+
+//TotalGPUs ...
+func (c ClusterCapacity) TotalGPUs() int {
+	total := 0
+	for _, p := range c.WorkerPools {
+		total += p.TotalGPUs()
+	}
+	return total
+}
+
+//GetCapacity returns the capacity of every worker pool of the cluster. The
+//flavors are listed once per zone and provider, and only until the flavors of
+//all the pools are found.
+func (w *workerpool) GetCapacity(clusterNameOrID string, target ClusterTargetHeader) (ClusterCapacity, error) {
+	capacity := ClusterCapacity{Cluster: clusterNameOrID}
+	pools, err := w.ListWorkerPools(clusterNameOrID, target)
+	if err != nil {
+		return capacity, err
+	}
+	workers, err := newWorkerAPI(w.client).ListWorkers(clusterNameOrID, false, target)
+	if err != nil {
+		return capacity, err
+	}
+	workerCount := map[string]int{}
+	for _, worker := range workers {
+		workerCount[worker.PoolID]++
+	}
+
+	found := map[string]FlavorInfo{}
+	listed := map[string]bool{}
+	for _, pool := range pools {
+		p := WorkerPoolCapacity{
+			WorkerPoolID: pool.ID,
+			PoolName:     pool.PoolName,
+			Flavor:       pool.Flavor,
+			Workers:      workerCount[pool.ID],
+		}
+		for _, zone := range pool.Zones {
+			p.Zones = append(p.Zones, zone.ID)
+			p.DesiredWorkers += pool.WorkerCount
+		}
+
+		flavor, ok := found[pool.Flavor]
+		for _, zone := range p.Zones {
+			if ok {
+				break
+			}
+			key := pool.Provider + "/" + zone
+			if listed[key] {
+				continue
+			}
+			listed[key] = true
+			flavors, err := newFlavorsAPI(w.client).ListFlavors(zone, pool.Provider, target)
+			if err != nil {
+				return capacity, err
+			}
+			for _, f := range flavors {
+				found[f.Name] = f
+			}
+			flavor, ok = found[pool.Flavor]
+		}
+		if ok {
+			p.FlavorFound = true
+			p.CoresPerWorker = flavor.CoreCount()
+			p.MemoryGBPerWorker = flavor.MemoryGB()
+			p.GPUsPerWorker = flavor.GPUCount()
+		}
+		capacity.WorkerPools = append(capacity.WorkerPools, p)
+	}
+	return capacity, nil
+}
@@ -0,0 +1,79 @@
+package containerv2
+
+import (
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Worker pool capacity", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("GetCapacity", func() {
+		Context("When the cluster has several worker pools", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/vpc/getWorkerPools", "cluster=c1"),
+						ghttp.RespondWith(http.StatusOK, `[
+							{"id": "p1", "poolName": "default", "flavor": "bx2.4x16", "provider": "vpc-gen2", "workerCount": 2,
+							 "zones": [{"id": "us-south-1", "workerCount": 2}, {"id": "us-south-2", "workerCount": 2}]},
+							{"id": "p2", "poolName": "gpu", "flavor": "gx2-16x128x2v100", "provider": "vpc-gen2", "workerCount": 1,
+							 "zones": [{"id": "us-south-1", "workerCount": 1}]},
+							{"id": "p3", "poolName": "old", "flavor": "retired.2x4", "provider": "vpc-gen2", "workerCount": 1,
+							 "zones": [{"id": "us-south-3", "workerCount": 1}]}
+						]`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/vpc/getWorkers", "cluster=c1&showDeleted=false"),
+						ghttp.RespondWith(http.StatusOK, `[
+							{"id": "w1", "poolid": "p1"}, {"id": "w2", "poolid": "p1"}, {"id": "w3", "poolid": "p1"},
+							{"id": "w4", "poolid": "p2"}
+						]`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/getFlavors", "provider=vpc-gen2&zone=us-south-1"),
+						ghttp.RespondWith(http.StatusOK, `[
+							{"name": "bx2.4x16", "provider": "vpc-gen2", "cores": "4", "memory": "16GB"},
+							{"name": "gx2-16x128x2v100", "provider": "vpc-gen2", "cores": "16", "memory": "128GB"}
+						]`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/getFlavors", "provider=vpc-gen2&zone=us-south-3"),
+						ghttp.RespondWith(http.StatusOK, `[]`),
+					),
+				)
+			})
+
+			It("should join the pools, flavors and workers", func() {
+				capacity, err := newWorkerPool(server.URL()).GetCapacity("c1", ClusterTargetHeader{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(server.ReceivedRequests()).To(HaveLen(4))
+				Expect(capacity.WorkerPools).To(HaveLen(3))
+
+				p1 := capacity.WorkerPools[0]
+				Expect(p1.Zones).To(Equal([]string{"us-south-1", "us-south-2"}))
+				Expect(p1.DesiredWorkers).To(Equal(4))
+				Expect(p1.Workers).To(Equal(3))
+				Expect(p1.TotalCores()).To(Equal(12))
+				Expect(p1.TotalMemoryGB()).To(Equal(48))
+
+				p2 := capacity.WorkerPools[1]
+				Expect(p2.GPUsPerWorker).To(Equal(2))
+				Expect(p2.TotalGPUs()).To(Equal(2))
+
+				Expect(capacity.WorkerPools[2].FlavorFound).To(BeFalse())
+				Expect(capacity.TotalCores()).To(Equal(28))
+				Expect(capacity.TotalMemoryGB()).To(Equal(176))
+				Expect(capacity.TotalGPUs()).To(Equal(2))
+			})
+		})
+	})
+})
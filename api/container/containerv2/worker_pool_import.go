@@ -0,0 +1,60 @@
+package containerv2
+
+import (
+	"sort"
+)
+
+//ToCommonWorkerPoolConfig converts a live worker pool into the configuration
+//used to create it, so desired and actual state can be compared directly.
+//Optional fields the service leaves unset are normalized to their empty
+//values and zones are sorted by ID.
+func (r GetWorkerPoolResponse) ToCommonWorkerPoolConfig() CommonWorkerPoolConfig {
+	config := CommonWorkerPoolConfig{
+		Flavor:                 r.Flavor,
+		Isolation:              r.Isolation,
+		Labels:                 map[string]string{},
+		Name:                   r.PoolName,
+		OperatingSystem:        r.OperatingSystem,
+		VpcID:                  r.VpcID,
+		WorkerCount:            r.WorkerCount,
+		Zones:                  []Zone{},
+		WorkerVolumeEncryption: r.WorkerVolumeEncryption,
+	}
+	for k, v := range r.Labels {
+		config.Labels[k] = v
+	}
+	if r.SecondaryStorageOption != nil {
+		config.SecondaryStorageOption = r.SecondaryStorageOption.Name
+	}
+	for _, z := range r.Zones {
+		config.Zones = append(config.Zones, Zone{
+			ID:       z.ID,
+			SubnetID: primarySubnetID(z.Subnets),
+		})
+	}
+	sort.Slice(config.Zones, func(i, j int) bool {
+		return config.Zones[i].ID < config.Zones[j].ID
+	})
+	return config
+}
+
+func primarySubnetID(subnets []Subnet) string {
+	for _, s := range subnets {
+		if s.Primary {
+			return s.ID
+		}
+	}
+	if len(subnets) > 0 {
+		return subnets[0].ID
+	}
+	return ""
+}
+
+// ImportWorkerPool reads a worker pool and returns its normalized configuration
+func (w *workerpool) ImportWorkerPool(clusterNameOrID, workerPoolNameOrID string, target ClusterTargetHeader) (CommonWorkerPoolConfig, error) {
+	wp, err := w.GetWorkerPool(clusterNameOrID, workerPoolNameOrID, target)
+	if err != nil {
+		return CommonWorkerPoolConfig{}, err
+	}
+	return wp.ToCommonWorkerPoolConfig(), nil
+}
@@ -0,0 +1,76 @@
+package containerv2
+
+import (
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Worker pool import", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("ImportWorkerPool", func() {
+		Context("When the worker pool exists", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/vpc/getWorkerPool", "cluster=mycluster&workerpool=default"),
+						ghttp.RespondWith(http.StatusOK, `{
+							"id": "pool1",
+							"poolName": "default",
+							"flavor": "bx2.4x16",
+							"vpcID": "vpc1",
+							"workerCount": 2,
+							"secondaryStorageOption": {"name": "900gb.5x-raid0"},
+							"zones": [
+								{"id": "us-south-2", "subnets": [{"id": "subnet2", "primary": true}]},
+								{"id": "us-south-1", "subnets": [{"id": "other"}, {"id": "subnet1", "primary": true}]}
+							]
+						}`),
+					),
+				)
+			})
+
+			It("should return the normalized configuration", func() {
+				config, err := newWorkerPool(server.URL()).ImportWorkerPool("mycluster", "default", ClusterTargetHeader{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(config).To(Equal(CommonWorkerPoolConfig{
+					Flavor:                 "bx2.4x16",
+					Labels:                 map[string]string{},
+					Name:                   "default",
+					VpcID:                  "vpc1",
+					WorkerCount:            2,
+					SecondaryStorageOption: "900gb.5x-raid0",
+					Zones: []Zone{
+						{ID: "us-south-1", SubnetID: "subnet1"},
+						{ID: "us-south-2", SubnetID: "subnet2"},
+					},
+				}))
+			})
+		})
+		Context("When the worker pool cannot be read", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.SetAllowUnhandledRequests(true)
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/vpc/getWorkerPool"),
+						ghttp.RespondWith(http.StatusInternalServerError, `Failed to get worker pool`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				_, err := newWorkerPool(server.URL()).ImportWorkerPool("mycluster", "default", ClusterTargetHeader{})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,72 @@
+package containerv2
+
+import (
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Worker pool labels", func() {
+	var server *ghttp.Server
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("SetWorkerPoolLabels", func() {
+		It("should replace the labels and return the applied ones", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, "/v2/setWorkerPoolLabels"),
+					ghttp.VerifyJSON(`{"cluster":"c1","workerpool":"edge","labels":{"tier":"edge"}}`),
+					ghttp.RespondWith(http.StatusOK, ``),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/v2/vpc/getWorkerPool", "cluster=c1&workerpool=edge"),
+					ghttp.RespondWith(http.StatusOK, `{"id":"p1","poolName":"edge","labels":{"tier":"edge","ibm-cloud.kubernetes.io/worker-pool-id":"p1"}}`),
+				),
+			)
+			labels, err := newWorkerPool(server.URL()).SetWorkerPoolLabels(WorkerPoolLabelRequest{
+				Cluster:    "c1",
+				WorkerPool: "edge",
+				Labels:     map[string]string{"tier": "edge"},
+			}, ClusterTargetHeader{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(labels).To(Equal(map[string]string{"tier": "edge", "ibm-cloud.kubernetes.io/worker-pool-id": "p1"}))
+		})
+		It("should return the error of the service", func() {
+			server.AppendHandlers(ghttp.RespondWith(http.StatusNotFound, `{"code":"G0004","description":"The worker pool could not be found"}`))
+			_, err := newWorkerPool(server.URL()).SetWorkerPoolLabels(WorkerPoolLabelRequest{Cluster: "c1", WorkerPool: "edge"}, ClusterTargetHeader{})
+			Expect(err).To(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Describe("PatchWorkerPoolLabels", func() {
+		It("should keep the labels neither set nor removed", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/v2/vpc/getWorkerPool", "cluster=c1&workerpool=edge"),
+					ghttp.RespondWith(http.StatusOK, `{"id":"p1","labels":{"tier":"edge","team":"a","old":"x"}}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, "/v2/setWorkerPoolLabels"),
+					ghttp.VerifyJSON(`{"cluster":"c1","workerpool":"edge","labels":{"tier":"edge","team":"b"}}`),
+					ghttp.RespondWith(http.StatusOK, ``),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/v2/vpc/getWorkerPool", "cluster=c1&workerpool=edge"),
+					ghttp.RespondWith(http.StatusOK, `{"id":"p1","labels":{"tier":"edge","team":"b"}}`),
+				),
+			)
+			labels, err := newWorkerPool(server.URL()).PatchWorkerPoolLabels("c1", "edge", map[string]string{"team": "b"}, []string{"old"}, ClusterTargetHeader{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(labels).To(Equal(map[string]string{"tier": "edge", "team": "b"}))
+		})
+	})
+})
@@ -0,0 +1,60 @@
+package containerv2
+
+import (
+	"sort"
+	"strings"
+)
+
+//Taint effects
+const (
+	TaintEffectNoSchedule       = "NoSchedule"
+	TaintEffectPreferNoSchedule = "PreferNoSchedule"
+	TaintEffectNoExecute        = "NoExecute"
+)
+
+//Taint is a Kubernetes taint applied to the workers of a worker pool
+type Taint struct {
+	Key    string
+	Value  string
+	Effect string
+}
+
+//taintsMap returns the taints the way the service expects them, key to
+//value:effect
+func taintsMap(taints []Taint) map[string]string {
+	m := make(map[string]string, len(taints))
+	for _, t := range taints {
+		m[t.Key] = t.Value + ":" + t.Effect
+	}
+	return m
+}
+
+//ParseTaints parses the taints of GetWorkerPoolResponse, sorted by key
+func ParseTaints(taints map[string]string) []Taint {
+	parsed := make([]Taint, 0, len(taints))
+	for key, v := range taints {
+		t := Taint{Key: key, Value: v}
+		if i := strings.LastIndex(v, ":"); i >= 0 {
+			t.Value, t.Effect = v[:i], v[i+1:]
+		}
+		parsed = append(parsed, t)
+	}
+	sort.Slice(parsed, func(i, j int) bool {
+		return parsed[i].Key < parsed[j].Key
+	})
+	return parsed
+}
+
+// SetWorkerPoolTaints replaces the taints of a worker pool
+func (w *workerpool) SetWorkerPoolTaints(clusterNameOrID, workerPoolNameOrID string, taints []Taint, target ClusterTargetHeader) error {
+	return w.UpdateWorkerPoolTaints(WorkerPoolTaintRequest{
+		Cluster:    clusterNameOrID,
+		WorkerPool: workerPoolNameOrID,
+		Taints:     taintsMap(taints),
+	}, target)
+}
+
+// DeleteWorkerPoolTaints removes all the taints of a worker pool
+func (w *workerpool) DeleteWorkerPoolTaints(clusterNameOrID, workerPoolNameOrID string, target ClusterTargetHeader) error {
+	return w.SetWorkerPoolTaints(clusterNameOrID, workerPoolNameOrID, nil, target)
+}
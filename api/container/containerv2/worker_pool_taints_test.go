@@ -0,0 +1,70 @@
+package containerv2
+
+import (
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Worker pool taints", func() {
+	var server *ghttp.Server
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("SetWorkerPoolTaints", func() {
+		It("should send the taints as value:effect", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, "/v2/setWorkerPoolTaints"),
+					ghttp.VerifyJSON(`{"cluster":"c1","workerpool":"gpu","taints":{"dedicated":"gpu:NoSchedule","maintenance":":NoExecute"}}`),
+					ghttp.RespondWith(http.StatusOK, ``),
+				),
+			)
+			err := newWorkerPool(server.URL()).SetWorkerPoolTaints("c1", "gpu", []Taint{
+				{Key: "dedicated", Value: "gpu", Effect: TaintEffectNoSchedule},
+				{Key: "maintenance", Effect: TaintEffectNoExecute},
+			}, ClusterTargetHeader{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+		It("should return the error of the service", func() {
+			server.AppendHandlers(ghttp.RespondWith(http.StatusBadRequest, `{"code":"E0150","description":"Invalid taint effect"}`))
+			err := newWorkerPool(server.URL()).SetWorkerPoolTaints("c1", "gpu", []Taint{
+				{Key: "dedicated", Value: "gpu", Effect: "Never"},
+			}, ClusterTargetHeader{})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("DeleteWorkerPoolTaints", func() {
+		It("should send no taints", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, "/v2/setWorkerPoolTaints"),
+					ghttp.VerifyJSON(`{"cluster":"c1","workerpool":"gpu","taints":{}}`),
+					ghttp.RespondWith(http.StatusOK, ``),
+				),
+			)
+			err := newWorkerPool(server.URL()).DeleteWorkerPoolTaints("c1", "gpu", ClusterTargetHeader{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("ParseTaints", func() {
+		It("should parse the taints of a worker pool sorted by key", func() {
+			Expect(ParseTaints(map[string]string{
+				"maintenance": ":NoExecute",
+				"dedicated":   "gpu:NoSchedule",
+			})).To(Equal([]Taint{
+				{Key: "dedicated", Value: "gpu", Effect: TaintEffectNoSchedule},
+				{Key: "maintenance", Effect: TaintEffectNoExecute},
+			}))
+		})
+	})
+})
@@ -0,0 +1,67 @@
+package containerv2
+
+//WorkerPoolOperatingSystemRequest changes the operating system of the workers
+//of a worker pool, applied when they are next replaced or updated
+type WorkerPoolOperatingSystemRequest struct {
+	Cluster         string `json:"cluster" description:"cluster name"`
+	WorkerPool      string `json:"workerpool" description:"worker Pool name"`
+	OperatingSystem string `json:"operatingSystem" description:"operating system of the workers"`
+}
+
+//WorkerPoolUpdate lists the fields of a worker pool changed in place by
+//UpdateWorkerPool, the zero values leave their field unchanged. The flavor and
+//the dedicated host pool of a worker pool cannot be changed in place, a new
+//worker pool must be created instead.
+type WorkerPoolUpdate struct {
+	OperatingSystem string
+	//WorkerCount is the number of workers per zone
+	WorkerCount int
+	//Labels replace the labels of the pool when not nil, an empty map removes them
+	Labels map[string]string
+	//Taints replace the taints of the pool when not nil, an empty slice removes them
+	Taints []Taint
+}
+
+// SetWorkerPoolOperatingSystem changes the operating system of a worker pool
+func (w *workerpool) SetWorkerPoolOperatingSystem(osRequest WorkerPoolOperatingSystemRequest, target ClusterTargetHeader) error {
+	_, err := w.client.Post("/v2/setWorkerPoolOperatingSystem", osRequest, nil, target.ToMap())
+	return err
+}
+
+// UpdateWorkerPool changes the fields of the update in place, one request per
+// field. It stops at the first failure, the fields changed before stay changed.
+func (w *workerpool) UpdateWorkerPool(clusterNameOrID, workerPoolNameOrID string, update WorkerPoolUpdate, target ClusterTargetHeader) error {
+	if update.OperatingSystem != "" {
+		err := w.SetWorkerPoolOperatingSystem(WorkerPoolOperatingSystemRequest{
+			Cluster:         clusterNameOrID,
+			WorkerPool:      workerPoolNameOrID,
+			OperatingSystem: update.OperatingSystem,
+		}, target)
+		if err != nil {
+			return err
+		}
+	}
+	if update.Labels != nil {
+		err := w.setLabels(WorkerPoolLabelRequest{
+			Cluster:    clusterNameOrID,
+			WorkerPool: workerPoolNameOrID,
+			Labels:     update.Labels,
+		}, target)
+		if err != nil {
+			return err
+		}
+	}
+	if update.Taints != nil {
+		if err := w.SetWorkerPoolTaints(clusterNameOrID, workerPoolNameOrID, update.Taints, target); err != nil {
+			return err
+		}
+	}
+	if update.WorkerCount > 0 {
+		return w.ResizeWorkerPool(ResizeWorkerPoolReq{
+			Cluster:    clusterNameOrID,
+			Workerpool: workerPoolNameOrID,
+			Size:       int64(update.WorkerCount),
+		}, target)
+	}
+	return nil
+}
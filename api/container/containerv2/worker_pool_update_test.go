@@ -0,0 +1,75 @@
+package containerv2
+
+import (
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Worker pool update", func() {
+	var server *ghttp.Server
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("UpdateWorkerPool", func() {
+		It("should change every field of the update", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, "/v2/setWorkerPoolOperatingSystem"),
+					ghttp.VerifyJSON(`{"cluster":"c1","workerpool":"edge","operatingSystem":"UBUNTU_24_64"}`),
+					ghttp.RespondWith(http.StatusOK, ``),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, "/v2/setWorkerPoolLabels"),
+					ghttp.VerifyJSON(`{"cluster":"c1","workerpool":"edge","labels":{}}`),
+					ghttp.RespondWith(http.StatusOK, ``),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, "/v2/setWorkerPoolTaints"),
+					ghttp.VerifyJSON(`{"cluster":"c1","workerpool":"edge","taints":{"dedicated":"edge:NoSchedule"}}`),
+					ghttp.RespondWith(http.StatusOK, ``),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, "/v2/resizeWorkerPool"),
+					ghttp.VerifyJSON(`{"cluster":"c1","workerpool":"edge","size":3}`),
+					ghttp.RespondWith(http.StatusOK, ``),
+				),
+			)
+			err := newWorkerPool(server.URL()).UpdateWorkerPool("c1", "edge", WorkerPoolUpdate{
+				OperatingSystem: "UBUNTU_24_64",
+				WorkerCount:     3,
+				Labels:          map[string]string{},
+				Taints:          []Taint{{Key: "dedicated", Value: "edge", Effect: TaintEffectNoSchedule}},
+			}, ClusterTargetHeader{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(4))
+		})
+		It("should only change the fields set", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, "/v2/resizeWorkerPool"),
+					ghttp.RespondWith(http.StatusOK, ``),
+				),
+			)
+			err := newWorkerPool(server.URL()).UpdateWorkerPool("c1", "edge", WorkerPoolUpdate{WorkerCount: 2}, ClusterTargetHeader{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+		It("should stop at the first failure", func() {
+			server.AppendHandlers(ghttp.RespondWith(http.StatusBadRequest, `{"code":"E3c1d","description":"The operating system is not supported"}`))
+			err := newWorkerPool(server.URL()).UpdateWorkerPool("c1", "edge", WorkerPoolUpdate{
+				OperatingSystem: "WINDOWS",
+				WorkerCount:     3,
+			}, ClusterTargetHeader{})
+			Expect(err).To(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/IBM-Cloud/bluemix-go/client"
+	"github.com/IBM-Cloud/bluemix-go/helpers"
 )
 
 //Worker ...
@@ -87,15 +88,23 @@ type VolumeRequest struct {
 }
 
 //Workers ...
+//go:generate counterfeiter . Workers
 type Workers interface {
 	ListByWorkerPool(clusterIDOrName, workerPoolIDOrName string, showDeleted bool, target ClusterTargetHeader) ([]Worker, error)
 	ListWorkers(clusterIDOrName string, showDeleted bool, target ClusterTargetHeader) ([]Worker, error)
 	Get(clusterIDOrName, workerID string, target ClusterTargetHeader) (Worker, error)
 	ReplaceWokerNode(clusterIDOrName, workerID string, target ClusterTargetHeader) (string, error)
+	ReplaceWorker(clusterIDOrName, workerID string, update bool, target ClusterTargetHeader) (string, error)
+	RebootWorker(clusterIDOrName, workerID string, target ClusterTargetHeader) error
+	ReloadWorker(clusterIDOrName, workerID string, target ClusterTargetHeader) error
 	ListStorageAttachemnts(clusterIDOrName, workerID string, target ClusterTargetHeader) (VoulemeAttachments, error)
 	GetStorageAttachment(clusterIDOrName, workerID, volumeAttachmentID string, target ClusterTargetHeader) (VoulemeAttachment, error)
 	CreateStorageAttachment(payload VolumeRequest, target ClusterTargetHeader) (VoulemeAttachment, error)
 	DeleteStorageAttachment(payload VolumeRequest, target ClusterTargetHeader) (string, error)
+	ReplaceWorkers(req BulkWorkerRequest, target ClusterTargetHeader) (helpers.BulkResults, error)
+	RebootWorkers(req BulkWorkerRequest, target ClusterTargetHeader) (helpers.BulkResults, error)
+	ReloadWorkers(req BulkWorkerRequest, target ClusterTargetHeader) (helpers.BulkResults, error)
+	DeleteWorkers(req BulkWorkerRequest, target ClusterTargetHeader) (helpers.BulkResults, error)
 }
 
 type worker struct {
@@ -144,11 +153,18 @@ func (r *worker) Get(clusterIDOrName, workerID string, target ClusterTargetHeade
 	return worker, err
 }
 
+//ReplaceWokerNode replaces the VPC worker, updating it to the latest patch version
 func (r *worker) ReplaceWokerNode(clusterIDOrName, workerID string, target ClusterTargetHeader) (string, error) {
+	return r.ReplaceWorker(clusterIDOrName, workerID, true, target)
+}
+
+//ReplaceWorker replaces the VPC worker with a new one of the same version, or
+//of the latest patch version of the cluster when update is true
+func (r *worker) ReplaceWorker(clusterIDOrName, workerID string, update bool, target ClusterTargetHeader) (string, error) {
 	payload := ReplaceWorker{
 		ClusterIDOrName: clusterIDOrName,
 		WorkerID:        workerID,
-		Update:          true,
+		Update:          update,
 	}
 	var response string
 	_, err := r.client.Post("/v2/vpc/replaceWorker", payload, &response, target.ToMap())
@@ -158,6 +174,24 @@ func (r *worker) ReplaceWokerNode(clusterIDOrName, workerID string, target Clust
 	return response, err
 }
 
+//RebootWorker reboots the worker. IKS exposes worker reboot only through the
+//v1 worker API, which serves classic and VPC clusters alike.
+func (r *worker) RebootWorker(clusterIDOrName, workerID string, target ClusterTargetHeader) error {
+	return r.workerAction(clusterIDOrName, workerID, "reboot", target)
+}
+
+//ReloadWorker reloads the operating system of the classic worker and applies
+//the latest patch version, the workloads of the worker are rescheduled
+func (r *worker) ReloadWorker(clusterIDOrName, workerID string, target ClusterTargetHeader) error {
+	return r.workerAction(clusterIDOrName, workerID, "reload", target)
+}
+
+func (r *worker) workerAction(clusterIDOrName, workerID, action string, target ClusterTargetHeader) error {
+	rawURL := fmt.Sprintf("/v1/clusters/%s/workers/%s", clusterIDOrName, workerID)
+	_, err := r.client.Put(rawURL, workerActionRequest{Action: action}, nil, target.ToMap())
+	return err
+}
+
 // ListStorageAttachemnts returns list of attached storage blaocks to a worker node
 func (r *worker) ListStorageAttachemnts(clusterIDOrName, workerID string, target ClusterTargetHeader) (VoulemeAttachments, error) {
 	rawURL := fmt.Sprintf("/v2/storage/getAttachments?cluster=%s&worker=%s", clusterIDOrName, workerID)
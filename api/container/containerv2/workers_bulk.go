@@ -0,0 +1,138 @@
+package containerv2
+
+import (
+	"fmt"
+
+	"github.com/IBM-Cloud/bluemix-go/helpers"
+)
+
+const defaultBulkWorkerConcurrency = 10
+
+//WorkerSelector selects the workers of a cluster a bulk operation applies to.
+//WorkerIDs takes precedence; otherwise workers are selected from WorkerPool
+//and/or from every worker pool whose labels contain all of Labels.
+//
+//IKS keeps labels on worker pools only, they are applied as node labels to
+//every worker of the pool. Labels therefore selects the workers whose pool
+//labels match, node labels added in Kubernetes afterwards are not visible to
+//the IKS API and can not be used to select workers.
+type WorkerSelector struct {
+	WorkerIDs  []string
+	WorkerPool string
+	Labels     map[string]string
+}
+
+//BulkWorkerRequest ...
+type BulkWorkerRequest struct {
+	Cluster  string
+	Selector WorkerSelector
+	//Concurrency is the maximum number of workers processed at once, defaults to 10
+	Concurrency int
+}
+
+//ReplaceWorkers replaces every selected worker, the results hold the worker IDs
+func (r *worker) ReplaceWorkers(req BulkWorkerRequest, target ClusterTargetHeader) (helpers.BulkResults, error) {
+	return r.bulk(req, target, func(workerID string) error {
+		_, err := r.ReplaceWokerNode(req.Cluster, workerID, target)
+		return err
+	})
+}
+
+//RebootWorkers reboots every selected worker. IKS exposes worker reboot only
+//through the v1 worker API, which serves classic and VPC clusters alike.
+func (r *worker) RebootWorkers(req BulkWorkerRequest, target ClusterTargetHeader) (helpers.BulkResults, error) {
+	return r.bulk(req, target, func(workerID string) error {
+		return r.RebootWorker(req.Cluster, workerID, target)
+	})
+}
+
+//ReloadWorkers reloads every selected classic worker
+func (r *worker) ReloadWorkers(req BulkWorkerRequest, target ClusterTargetHeader) (helpers.BulkResults, error) {
+	return r.bulk(req, target, func(workerID string) error {
+		return r.ReloadWorker(req.Cluster, workerID, target)
+	})
+}
+
+//DeleteWorkers removes every selected worker from the cluster. IKS exposes
+//worker removal only through the v1 worker API, which serves classic and VPC
+//clusters alike.
+func (r *worker) DeleteWorkers(req BulkWorkerRequest, target ClusterTargetHeader) (helpers.BulkResults, error) {
+	return r.bulk(req, target, func(workerID string) error {
+		rawURL := fmt.Sprintf("/v1/clusters/%s/workers/%s", req.Cluster, workerID)
+		_, err := r.client.Delete(rawURL, target.ToMap())
+		return err
+	})
+}
+
+type workerActionRequest struct {
+	Action string `json:"action"`
+}
+
+func (r *worker) bulk(req BulkWorkerRequest, target ClusterTargetHeader, op func(workerID string) error) (helpers.BulkResults, error) {
+	workerIDs, err := r.selectWorkers(req.Cluster, req.Selector, target)
+	if err != nil {
+		return nil, err
+	}
+	concurrency := req.Concurrency
+	if concurrency < 1 {
+		concurrency = defaultBulkWorkerConcurrency
+	}
+
+	//once the client context is done no further worker is scheduled, the
+	//workers not processed are reported with the context error
+	errs, err := helpers.RunBulk(r.client.Context(), len(workerIDs), concurrency, func(i int) error {
+		return op(workerIDs[i])
+	})
+	results := make(helpers.BulkResults, len(workerIDs))
+	for i, id := range workerIDs {
+		results[i] = helpers.BulkResult{ID: id, Err: errs[i]}
+	}
+	return results, err
+}
+
+func (r *worker) selectWorkers(cluster string, selector WorkerSelector, target ClusterTargetHeader) ([]string, error) {
+	if len(selector.WorkerIDs) > 0 {
+		return selector.WorkerIDs, nil
+	}
+	if selector.WorkerPool == "" && len(selector.Labels) == 0 {
+		return nil, fmt.Errorf("A worker selector is required for bulk worker operations")
+	}
+
+	pools := []string{selector.WorkerPool}
+	if len(selector.Labels) > 0 {
+		workerPools, err := newWorkerPoolAPI(r.client).ListWorkerPools(cluster, target)
+		if err != nil {
+			return nil, err
+		}
+		pools = pools[:0]
+		for _, wp := range workerPools {
+			if selector.WorkerPool != "" && selector.WorkerPool != wp.ID && selector.WorkerPool != wp.PoolName {
+				continue
+			}
+			if labelsMatch(wp.Labels, selector.Labels) {
+				pools = append(pools, wp.ID)
+			}
+		}
+	}
+
+	workerIDs := []string{}
+	for _, pool := range pools {
+		workers, err := r.ListByWorkerPool(cluster, pool, false, target)
+		if err != nil {
+			return nil, err
+		}
+		for _, w := range workers {
+			workerIDs = append(workerIDs, w.ID)
+		}
+	}
+	return workerIDs, nil
+}
+
+func labelsMatch(labels, selector map[string]string) bool {
+	for k, v := range selector {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,149 @@
+package containerv2
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bulk worker operations", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("RebootWorkers", func() {
+		Context("When workers are selected by id", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.RouteToHandler(http.MethodPut, "/v1/clusters/mycluster/workers/worker1",
+					ghttp.CombineHandlers(
+						ghttp.VerifyJSON(`{"action":"reboot"}`),
+						ghttp.RespondWith(http.StatusNoContent, ``),
+					))
+				server.RouteToHandler(http.MethodPut, "/v1/clusters/mycluster/workers/worker2",
+					ghttp.RespondWith(http.StatusInternalServerError, `Failed to reboot worker`))
+			})
+
+			It("should return the result of every worker", func() {
+				req := BulkWorkerRequest{
+					Cluster:     "mycluster",
+					Selector:    WorkerSelector{WorkerIDs: []string{"worker1", "worker2"}},
+					Concurrency: 2,
+				}
+				results, err := newWorker(server.URL()).RebootWorkers(req, ClusterTargetHeader{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(results).To(HaveLen(2))
+				Expect(results[0].ID).To(Equal("worker1"))
+				Expect(results[0].Err).NotTo(HaveOccurred())
+				failed := results.Failed()
+				Expect(failed).To(HaveLen(1))
+				Expect(failed[0].ID).To(Equal("worker2"))
+			})
+		})
+	})
+
+	Describe("ReloadWorkers", func() {
+		Context("When workers are selected by id", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.RouteToHandler(http.MethodPut, "/v1/clusters/mycluster/workers/worker1",
+					ghttp.CombineHandlers(
+						ghttp.VerifyJSON(`{"action":"reload"}`),
+						ghttp.RespondWith(http.StatusNoContent, ``),
+					))
+			})
+
+			It("should reload every worker", func() {
+				req := BulkWorkerRequest{
+					Cluster:  "mycluster",
+					Selector: WorkerSelector{WorkerIDs: []string{"worker1"}},
+				}
+				results, err := newWorker(server.URL()).ReloadWorkers(req, ClusterTargetHeader{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(results.Failed()).To(BeEmpty())
+				Expect(server.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+	})
+
+	Describe("ReplaceWorkers", func() {
+		Context("When the context is canceled during the batch", func() {
+			var cancel context.CancelFunc
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.RouteToHandler(http.MethodPost, "/v2/vpc/replaceWorker", func(w http.ResponseWriter, r *http.Request) {
+					cancel()
+					w.WriteHeader(http.StatusNoContent)
+				})
+			})
+
+			It("should not schedule the remaining workers", func() {
+				var ctx context.Context
+				ctx, cancel = context.WithCancel(context.Background())
+				defer cancel()
+				req := BulkWorkerRequest{
+					Cluster:     "mycluster",
+					Selector:    WorkerSelector{WorkerIDs: []string{"worker1", "worker2", "worker3"}},
+					Concurrency: 1,
+				}
+				results, err := newContainerService(server.URL()).WithContext(ctx).Workers().ReplaceWorkers(req, ClusterTargetHeader{})
+				Expect(err).To(Equal(context.Canceled))
+				Expect(results).To(HaveLen(3))
+				Expect(results[1].Err).To(Equal(context.Canceled))
+				Expect(results[2].Err).To(Equal(context.Canceled))
+				Expect(server.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+	})
+
+	Describe("DeleteWorkers", func() {
+		Context("When workers are selected by worker pool labels", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/vpc/getWorkerPools"),
+						ghttp.RespondWith(http.StatusOK, `[
+							{"id":"pool1","poolName":"default","labels":{"env":"prod"}},
+							{"id":"pool2","poolName":"edge","labels":{"env":"dev"}}
+						]`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/vpc/getWorkers", "cluster=mycluster&showDeleted=false&pool=pool1"),
+						ghttp.RespondWith(http.StatusOK, `[{"id":"worker1"}]`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, "/v1/clusters/mycluster/workers/worker1"),
+						ghttp.RespondWith(http.StatusNoContent, ``),
+					),
+				)
+			})
+
+			It("should delete only the workers of matching pools", func() {
+				req := BulkWorkerRequest{
+					Cluster:  "mycluster",
+					Selector: WorkerSelector{Labels: map[string]string{"env": "prod"}},
+				}
+				results, err := newWorker(server.URL()).DeleteWorkers(req, ClusterTargetHeader{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(results).To(HaveLen(1))
+				Expect(results.Failed()).To(BeEmpty())
+			})
+		})
+		Context("When no selector is given", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+			})
+
+			It("should return error", func() {
+				_, err := newWorker(server.URL()).DeleteWorkers(BulkWorkerRequest{Cluster: "mycluster"}, ClusterTargetHeader{})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
@@ -177,6 +177,113 @@ var _ = Describe("Workers", func() {
 		})
 	})
 
+	Describe("ReplaceWorker", func() {
+		Context("When the worker is replaced", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v2/vpc/replaceWorker"),
+						ghttp.VerifyJSON(`{"cluster":"test","workerID":"kube-w1","update":false}`),
+						ghttp.RespondWith(http.StatusOK, `"kube-w2"`),
+					),
+				)
+			})
+
+			It("should replace the worker without updating it", func() {
+				id, err := newWorker(server.URL()).ReplaceWorker("test", "kube-w1", false, ClusterTargetHeader{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(id).To(Equal("kube-w2"))
+			})
+		})
+		Context("When the worker is replaced and updated", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v2/vpc/replaceWorker"),
+						ghttp.VerifyJSON(`{"cluster":"test","workerID":"kube-w1","update":true}`),
+						ghttp.RespondWith(http.StatusOK, `"kube-w2"`),
+					),
+				)
+			})
+
+			It("should send the update", func() {
+				_, err := newWorker(server.URL()).ReplaceWorker("test", "kube-w1", true, ClusterTargetHeader{})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+		Context("When replace of worker is unsuccessful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v2/vpc/replaceWorker"),
+						ghttp.RespondWith(http.StatusConflict, `{"code":"E3b9c","description":"The worker is already being replaced"}`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				_, err := newWorker(server.URL()).ReplaceWorker("test", "kube-w1", true, ClusterTargetHeader{})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("RebootWorker", func() {
+		BeforeEach(func() {
+			server = ghttp.NewServer()
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPut, "/v1/clusters/test/workers/kube-w1"),
+					ghttp.VerifyJSON(`{"action":"reboot"}`),
+					ghttp.RespondWith(http.StatusNoContent, ``),
+				),
+			)
+		})
+
+		It("should send the reboot action", func() {
+			err := newWorker(server.URL()).RebootWorker("test", "kube-w1", ClusterTargetHeader{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("ReloadWorker", func() {
+		Context("When the worker is reloaded", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, "/v1/clusters/test/workers/kube-w1"),
+						ghttp.VerifyJSON(`{"action":"reload"}`),
+						ghttp.RespondWith(http.StatusNoContent, ``),
+					),
+				)
+			})
+
+			It("should send the reload action", func() {
+				err := newWorker(server.URL()).ReloadWorker("test", "kube-w1", ClusterTargetHeader{})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+		Context("When reload of worker is unsuccessful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, "/v1/clusters/test/workers/kube-w1"),
+						ghttp.RespondWith(http.StatusBadRequest, `{"code":"E0206","description":"The worker is not a classic worker"}`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				err := newWorker(server.URL()).ReloadWorker("test", "kube-w1", ClusterTargetHeader{})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
 })
 
 func newWorker(url string) Workers {
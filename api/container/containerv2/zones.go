@@ -0,0 +1,92 @@
+package containerv2
+
+import (
+	"net/url"
+	"sort"
+
+	"github.com/IBM-Cloud/bluemix-go/client"
+)
+
+//ZoneInfo is an availability zone clusters can be created in. For classic
+//infrastructure the zone is a datacenter.
+type ZoneInfo struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+	Provider    string `json:"provider"`
+	Datacenter  string `json:"datacenter,omitempty"`
+	Metro       string `json:"metro"`
+	Region      string `json:"region"`
+	Country     string `json:"country"`
+	Geography   string `json:"geography"`
+	//Multizone is true when the zone can be part of a multizone cluster
+	Multizone bool `json:"multizone"`
+}
+
+//FindZone returns the zone of the list with the ID, to validate the zones of
+//a worker pool against the zones of its provider and region
+func FindZone(zones []ZoneInfo, id string) (ZoneInfo, bool) {
+	for _, z := range zones {
+		if z.ID == id {
+			return z, true
+		}
+	}
+	return ZoneInfo{}, false
+}
+
+//MultizoneZones returns the zones of the list which can be part of a multizone
+//cluster, sorted by ID. When metro is set only the zones of the metro are
+//returned, the zones of a classic multizone cluster must share their metro.
+func MultizoneZones(zones []ZoneInfo, metro string) []ZoneInfo {
+	multizone := []ZoneInfo{}
+	for _, z := range zones {
+		if z.Multizone && (metro == "" || z.Metro == metro) {
+			multizone = append(multizone, z)
+		}
+	}
+	sort.Slice(multizone, func(i, j int) bool {
+		return multizone[i].ID < multizone[j].ID
+	})
+	return multizone
+}
+
+//Zones interface
+//go:generate counterfeiter . Zones
+type Zones interface {
+	GetZones(provider, region string, target ClusterTargetHeader) ([]ZoneInfo, error)
+}
+
+type zones struct {
+	client *client.Client
+}
+
+func newZonesAPI(c *client.Client) Zones {
+	return &zones{
+		client: c,
+	}
+}
+
+//GetZones returns the zones of the provider, e.g. vpc-gen2 or classic. When
+//region is set only the zones whose region matches it are returned.
+func (r *zones) GetZones(provider, region string, target ClusterTargetHeader) ([]ZoneInfo, error) {
+	query := url.Values{}
+	query.Set("provider", provider)
+	if region != "" {
+		query.Set("location", region)
+	}
+	successV := []ZoneInfo{}
+	_, err := r.client.Get("/v2/getZones?"+query.Encode(), &successV, target.ToMap())
+	if err != nil {
+		return nil, err
+	}
+	if region == "" {
+		return successV, nil
+	}
+	filtered := []ZoneInfo{}
+	for _, z := range successV {
+		if z.Region == region {
+			filtered = append(filtered, z)
+		}
+	}
+	return filtered, nil
+}
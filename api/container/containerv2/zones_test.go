@@ -0,0 +1,139 @@
+package containerv2
+
+import (
+	"log"
+	"net/http"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/client"
+	bluemixHttp "github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/session"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Zones", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("GetZones", func() {
+		Context("When the zones of a region are read", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/getZones", "location=us-south&provider=vpc-gen2"),
+						ghttp.RespondWith(http.StatusOK, `[
+							{"id": "us-south-1", "name": "us-south-1", "provider": "vpc-gen2", "metro": "dal", "region": "us-south", "multizone": true},
+							{"id": "us-south-2", "name": "us-south-2", "provider": "vpc-gen2", "metro": "dal", "region": "us-south", "multizone": true},
+							{"id": "us-east-1", "name": "us-east-1", "provider": "vpc-gen2", "metro": "wdc", "region": "us-east", "multizone": true},
+							{"id": "unknown-1", "name": "unknown-1", "provider": "vpc-gen2"}
+						]`),
+					),
+				)
+			})
+
+			It("should return only the zones of the region", func() {
+				zones, err := newZones(server.URL()).GetZones("vpc-gen2", "us-south", ClusterTargetHeader{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(zones).To(HaveLen(2))
+				Expect(zones[0].ID).Should(Equal("us-south-1"))
+				Expect(zones[0].Metro).Should(Equal("dal"))
+				Expect(zones[0].Multizone).Should(BeTrue())
+				Expect(zones[1].ID).Should(Equal("us-south-2"))
+			})
+		})
+		Context("When no region is given", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/getZones", "provider=classic"),
+						ghttp.RespondWith(http.StatusOK, `[
+							{"id": "dal10", "name": "dal10", "provider": "classic", "datacenter": "dal10", "region": "us-south", "multizone": true},
+							{"id": "che01", "name": "che01", "provider": "classic", "datacenter": "che01", "region": "jp-tok", "multizone": false}
+						]`),
+					),
+				)
+			})
+
+			It("should return every zone", func() {
+				zones, err := newZones(server.URL()).GetZones("classic", "", ClusterTargetHeader{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(zones).To(HaveLen(2))
+				Expect(zones[0].Datacenter).Should(Equal("dal10"))
+				Expect(zones[1].Multizone).Should(BeFalse())
+			})
+		})
+		Context("When read of zones is unsuccessful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/getZones"),
+						ghttp.RespondWith(http.StatusInternalServerError, `Failed to read zones`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				_, err := newZones(server.URL()).GetZones("vpc-gen2", "us-south", ClusterTargetHeader{})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("FindZone", func() {
+		It("should find the zone with the ID", func() {
+			zones := []ZoneInfo{{ID: "us-south-1"}, {ID: "us-south-2"}}
+			z, ok := FindZone(zones, "us-south-2")
+			Expect(ok).To(BeTrue())
+			Expect(z.ID).To(Equal("us-south-2"))
+			_, ok = FindZone(zones, "us-east-1")
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("MultizoneZones", func() {
+		zones := []ZoneInfo{
+			{ID: "dal12", Metro: "dal", Multizone: true},
+			{ID: "che01", Metro: "che", Multizone: false},
+			{ID: "dal10", Metro: "dal", Multizone: true},
+			{ID: "wdc04", Metro: "wdc", Multizone: true},
+		}
+
+		It("should return the multizone zones sorted by ID", func() {
+			Expect(MultizoneZones(zones, "")).To(Equal([]ZoneInfo{
+				{ID: "dal10", Metro: "dal", Multizone: true},
+				{ID: "dal12", Metro: "dal", Multizone: true},
+				{ID: "wdc04", Metro: "wdc", Multizone: true},
+			}))
+		})
+		It("should return the multizone zones of the metro", func() {
+			Expect(MultizoneZones(zones, "dal")).To(HaveLen(2))
+			Expect(MultizoneZones(zones, "che")).To(BeEmpty())
+		})
+	})
+})
+
+func newZones(url string) Zones {
+
+	sess, err := session.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+	conf := sess.Config.Copy()
+	conf.HTTPClient = bluemixHttp.NewHTTPClient(conf)
+	conf.Endpoint = &url
+
+	client := client.Client{
+		Config:      conf,
+		ServiceName: bluemix.VpcContainerService,
+	}
+	return newZonesAPI(&client)
+}
@@ -0,0 +1,75 @@
+package cosv1
+
+import (
+	gohttp "net/http"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/authentication"
+	"github.com/IBM-Cloud/bluemix-go/client"
+	"github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/rest"
+	"github.com/IBM-Cloud/bluemix-go/session"
+)
+
+//COSServiceAPI is the Cloud Object Storage resource configuration client ...
+type COSServiceAPI interface {
+	Buckets() Buckets
+	BackupPolicies() BackupPolicies
+}
+
+//ErrCodeAPICreation ...
+const ErrCodeAPICreation = "APICreationError"
+
+//cosService holds the client
+type cosService struct {
+	*client.Client
+}
+
+//New ...
+func New(sess *session.Session) (COSServiceAPI, error) {
+	config := sess.Config.Copy()
+	err := config.ValidateConfigForService(bluemix.COSConfigService)
+	if err != nil {
+		return nil, err
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.NewHTTPClient(config)
+	}
+	tokenRefreher, err := authentication.NewIAMAuthRepository(config, &rest.Client{
+		DefaultHeader: gohttp.Header{
+			"X-Original-User-Agent": []string{config.UserAgent},
+			"User-Agent":            []string{http.UserAgent()},
+		},
+		HTTPClient: config.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if config.IAMAccessToken == "" {
+		err := authentication.PopulateTokens(tokenRefreher, config)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if config.Endpoint == nil {
+		ep, err := config.EndpointLocator.COSConfigEndpoint()
+		if err != nil {
+			return nil, err
+		}
+		config.Endpoint = &ep
+	}
+
+	return &cosService{
+		Client: client.New(config, bluemix.COSConfigService, tokenRefreher),
+	}, nil
+}
+
+//Buckets implements the bucket configuration API
+func (c *cosService) Buckets() Buckets {
+	return newBucketsAPI(c.Client)
+}
+
+//BackupPolicies implements the bucket backup policies API
+func (c *cosService) BackupPolicies() BackupPolicies {
+	return newBackupPoliciesAPI(c.Client)
+}
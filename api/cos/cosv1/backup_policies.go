@@ -0,0 +1,83 @@
+package cosv1
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/IBM-Cloud/bluemix-go/client"
+)
+
+//BackupTypeContinuous ...
+const BackupTypeContinuous = "continuous"
+
+//InitialRetention is how long the backups are kept in the backup vault
+type InitialRetention struct {
+	DeleteAfterDays int `json:"delete_after_days"`
+}
+
+//BackupPolicyReq ...
+type BackupPolicyReq struct {
+	PolicyName           string           `json:"policy_name"`
+	TargetBackupVaultCRN string           `json:"target_backup_vault_crn"`
+	BackupType           string           `json:"backup_type"`
+	InitialRetention     InitialRetention `json:"initial_retention"`
+}
+
+//BackupPolicy copies the objects of a bucket to a backup vault
+type BackupPolicy struct {
+	BackupPolicyReq
+	PolicyID     string `json:"policy_id"`
+	PolicyStatus string `json:"policy_status"`
+	CreatedAt    string `json:"created_at"`
+}
+
+type backupPolicyList struct {
+	BackupPolicies []BackupPolicy `json:"backup_policies"`
+}
+
+//BackupPolicies ...
+type BackupPolicies interface {
+	CreateBackupPolicy(bucket string, policyReq BackupPolicyReq) (BackupPolicy, error)
+	ListBackupPolicies(bucket string) ([]BackupPolicy, error)
+	GetBackupPolicy(bucket string, policyID string) (BackupPolicy, error)
+	DeleteBackupPolicy(bucket string, policyID string) error
+}
+
+type backupPolicies struct {
+	client *client.Client
+}
+
+func newBackupPoliciesAPI(c *client.Client) BackupPolicies {
+	return &backupPolicies{
+		client: c,
+	}
+}
+
+func (r *backupPolicies) CreateBackupPolicy(bucket string, policyReq BackupPolicyReq) (BackupPolicy, error) {
+	policy := BackupPolicy{}
+	if policyReq.BackupType == "" {
+		policyReq.BackupType = BackupTypeContinuous
+	}
+	_, err := r.client.Post(fmt.Sprintf("/v1/buckets/%s/backup_policies", url.PathEscape(bucket)), &policyReq, &policy)
+	return policy, err
+}
+
+func (r *backupPolicies) ListBackupPolicies(bucket string) ([]BackupPolicy, error) {
+	policies := backupPolicyList{}
+	_, err := r.client.Get(fmt.Sprintf("/v1/buckets/%s/backup_policies", url.PathEscape(bucket)), &policies)
+	if err != nil {
+		return []BackupPolicy{}, err
+	}
+	return policies.BackupPolicies, nil
+}
+
+func (r *backupPolicies) GetBackupPolicy(bucket string, policyID string) (BackupPolicy, error) {
+	policy := BackupPolicy{}
+	_, err := r.client.Get(fmt.Sprintf("/v1/buckets/%s/backup_policies/%s", url.PathEscape(bucket), url.PathEscape(policyID)), &policy)
+	return policy, err
+}
+
+func (r *backupPolicies) DeleteBackupPolicy(bucket string, policyID string) error {
+	_, err := r.client.Delete(fmt.Sprintf("/v1/buckets/%s/backup_policies/%s", url.PathEscape(bucket), url.PathEscape(policyID)))
+	return err
+}
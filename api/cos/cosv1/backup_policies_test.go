@@ -0,0 +1,110 @@
+package cosv1
+
+import (
+	"log"
+	"net/http"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/client"
+	bluemixHttp "github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/session"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BackupPolicies", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+	Describe("CreateBackupPolicy", func() {
+		Context("When creation is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v1/buckets/compliance-bucket/backup_policies"),
+						ghttp.VerifyJSON(`{"policy_name":"daily","target_backup_vault_crn":"crn:v1:bluemix:public:cloud-object-storage:global:a/4ea1882a2d3401ed1e459979941966ea:1a0ec336-f391-4091-a6fb-5e084a4c56f4:backup-vault:vault1","backup_type":"continuous","initial_retention":{"delete_after_days":30}}`),
+						ghttp.RespondWith(http.StatusCreated, `{
+							"policy_id": "44d3dd41-d616-4d25-911a-9ef7e6a8b0c5",
+							"policy_name": "daily",
+							"policy_status": "pending",
+							"backup_type": "continuous",
+							"initial_retention": {"delete_after_days": 30}
+						}`),
+					),
+				)
+			})
+
+			It("should default to continuous backups", func() {
+				policy, err := newBackupPolicies(server.URL()).CreateBackupPolicy("compliance-bucket", BackupPolicyReq{
+					PolicyName:           "daily",
+					TargetBackupVaultCRN: "crn:v1:bluemix:public:cloud-object-storage:global:a/4ea1882a2d3401ed1e459979941966ea:1a0ec336-f391-4091-a6fb-5e084a4c56f4:backup-vault:vault1",
+					InitialRetention:     InitialRetention{DeleteAfterDays: 30},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(policy.PolicyID).Should(Equal("44d3dd41-d616-4d25-911a-9ef7e6a8b0c5"))
+				Expect(policy.PolicyStatus).Should(Equal("pending"))
+				Expect(policy.InitialRetention.DeleteAfterDays).Should(Equal(30))
+			})
+		})
+	})
+	Describe("ListBackupPolicies", func() {
+		Context("When list is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v1/buckets/compliance-bucket/backup_policies"),
+						ghttp.RespondWith(http.StatusOK, `{"backup_policies": [{"policy_id": "p1", "policy_name": "daily", "policy_status": "active"}]}`),
+					),
+				)
+			})
+
+			It("should return the policies", func() {
+				policies, err := newBackupPolicies(server.URL()).ListBackupPolicies("compliance-bucket")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(policies).Should(HaveLen(1))
+				Expect(policies[0].PolicyName).Should(Equal("daily"))
+			})
+		})
+	})
+	Describe("DeleteBackupPolicy", func() {
+		Context("When deletion fails", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, "/v1/buckets/compliance-bucket/backup_policies/p1"),
+						ghttp.RespondWith(http.StatusNotFound, `{"errors": [{"code": "not_found", "message": "Policy not found"}]}`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				err := newBackupPolicies(server.URL()).DeleteBackupPolicy("compliance-bucket", "p1")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
+
+func newBackupPolicies(url string) BackupPolicies {
+
+	sess, err := session.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+	conf := sess.Config.Copy()
+	conf.HTTPClient = bluemixHttp.NewHTTPClient(conf)
+	conf.Endpoint = &url
+
+	client := client.Client{
+		Config:      conf,
+		ServiceName: bluemix.COSConfigService,
+	}
+	return newBackupPoliciesAPI(&client)
+}
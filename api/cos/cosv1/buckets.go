@@ -0,0 +1,117 @@
+package cosv1
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/IBM-Cloud/bluemix-go/client"
+)
+
+//Object lock retention modes
+const (
+	ObjectLockEnabled        = "Enabled"
+	ObjectLockModeCompliance = "COMPLIANCE"
+	ObjectLockModeGovernance = "GOVERNANCE"
+)
+
+//Firewall restricts the access to the bucket
+type Firewall struct {
+	AllowedIP          []string `json:"allowed_ip,omitempty"`
+	DeniedIP           []string `json:"denied_ip,omitempty"`
+	AllowedNetworkType []string `json:"allowed_network_type,omitempty"`
+}
+
+//ActivityTracking ...
+type ActivityTracking struct {
+	ReadDataEvents     bool   `json:"read_data_events"`
+	WriteDataEvents    bool   `json:"write_data_events"`
+	ActivityTrackerCRN string `json:"activity_tracker_crn,omitempty"`
+}
+
+//MetricsMonitoring ...
+type MetricsMonitoring struct {
+	UsageMetricsEnabled   bool   `json:"usage_metrics_enabled"`
+	RequestMetricsEnabled bool   `json:"request_metrics_enabled"`
+	MetricsMonitoringCRN  string `json:"metrics_monitoring_crn,omitempty"`
+}
+
+//DefaultRetention is applied to the objects written without an explicit retention
+type DefaultRetention struct {
+	Mode  string `json:"mode"`
+	Days  int    `json:"days,omitempty"`
+	Years int    `json:"years,omitempty"`
+}
+
+//ObjectLockRule ...
+type ObjectLockRule struct {
+	DefaultRetention DefaultRetention `json:"default_retention"`
+}
+
+//ObjectLockConfiguration keeps the objects of the bucket immutable (WORM).
+//Object lock requires versioning and can not be disabled once enabled. It is
+//set with the S3 API of the bucket, the resource configuration cannot change it.
+type ObjectLockConfiguration struct {
+	ObjectLockEnabled string          `json:"object_lock_enabled"`
+	Rule              *ObjectLockRule `json:"rule,omitempty"`
+}
+
+//Bucket is the resource configuration of a bucket
+type Bucket struct {
+	Name                    string                   `json:"name"`
+	CRN                     string                   `json:"crn"`
+	ServiceInstanceID       string                   `json:"service_instance_id"`
+	ServiceInstanceCRN      string                   `json:"service_instance_crn"`
+	TimeCreated             string                   `json:"time_created"`
+	TimeUpdated             string                   `json:"time_updated"`
+	ObjectCount             int64                    `json:"object_count"`
+	BytesUsed               int64                    `json:"bytes_used"`
+	HardQuota               int64                    `json:"hard_quota"`
+	Firewall                *Firewall                `json:"firewall,omitempty"`
+	ActivityTracking        *ActivityTracking        `json:"activity_tracking,omitempty"`
+	MetricsMonitoring       *MetricsMonitoring       `json:"metrics_monitoring,omitempty"`
+	ObjectLockConfiguration *ObjectLockConfiguration `json:"object_lock_configuration,omitempty"`
+}
+
+//BucketPatch holds the configuration to update, nil fields are not changed
+type BucketPatch struct {
+	HardQuota         *int64             `json:"hard_quota,omitempty"`
+	Firewall          *Firewall          `json:"firewall,omitempty"`
+	ActivityTracking  *ActivityTracking  `json:"activity_tracking,omitempty"`
+	MetricsMonitoring *MetricsMonitoring `json:"metrics_monitoring,omitempty"`
+}
+
+//Buckets ...
+type Buckets interface {
+	GetBucketConfig(bucket string) (Bucket, string, error)
+	UpdateBucketConfig(bucket string, patch BucketPatch, etag string) error
+}
+
+type buckets struct {
+	client *client.Client
+}
+
+func newBucketsAPI(c *client.Client) Buckets {
+	return &buckets{
+		client: c,
+	}
+}
+
+//GetBucketConfig returns the configuration of the bucket and its ETag
+func (r *buckets) GetBucketConfig(bucket string) (Bucket, string, error) {
+	config := Bucket{}
+	resp, err := r.client.Get(fmt.Sprintf("/v1/b/%s", url.PathEscape(bucket)), &config)
+	if err != nil {
+		return config, "", err
+	}
+	return config, resp.Header.Get("Etag"), nil
+}
+
+//UpdateBucketConfig updates the bucket configuration, etag is optional
+func (r *buckets) UpdateBucketConfig(bucket string, patch BucketPatch, etag string) error {
+	header := make(map[string]string)
+	if etag != "" {
+		header["If-Match"] = etag
+	}
+	_, err := r.client.Patch(fmt.Sprintf("/v1/b/%s", url.PathEscape(bucket)), &patch, nil, header)
+	return err
+}
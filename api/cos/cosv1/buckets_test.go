@@ -0,0 +1,110 @@
+package cosv1
+
+import (
+	"log"
+	"net/http"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/client"
+	bluemixHttp "github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/session"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Buckets", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+	Describe("GetBucketConfig", func() {
+		Context("When get is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v1/b/compliance-bucket"),
+						ghttp.RespondWith(http.StatusOK, `{
+							"name": "compliance-bucket",
+							"crn": "crn:v1:bluemix:public:cloud-object-storage:global:a/4ea1882a2d3401ed1e459979941966ea:1a0ec336-f391-4091-a6fb-5e084a4c56f4:bucket:compliance-bucket",
+							"object_count": 12,
+							"bytes_used": 2048,
+							"object_lock_configuration": {
+								"object_lock_enabled": "Enabled",
+								"rule": {"default_retention": {"mode": "COMPLIANCE", "years": 7}}
+							}
+						}`, http.Header{"Etag": []string{"abc123"}}),
+					),
+				)
+			})
+
+			It("should return the bucket configuration", func() {
+				bucket, etag, err := newBuckets(server.URL()).GetBucketConfig("compliance-bucket")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(etag).Should(Equal("abc123"))
+				Expect(bucket.ObjectCount).Should(Equal(int64(12)))
+				Expect(bucket.ObjectLockConfiguration.ObjectLockEnabled).Should(Equal(ObjectLockEnabled))
+				Expect(bucket.ObjectLockConfiguration.Rule.DefaultRetention.Years).Should(Equal(7))
+			})
+		})
+	})
+	Describe("UpdateBucketConfig", func() {
+		Context("When the firewall is updated", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPatch, "/v1/b/compliance-bucket"),
+						ghttp.VerifyHeaderKV("If-Match", "abc123"),
+						ghttp.VerifyJSON(`{"firewall":{"allowed_ip":["10.0.0.0/8"]}}`),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("should update the bucket", func() {
+				patch := BucketPatch{
+					Firewall: &Firewall{AllowedIP: []string{"10.0.0.0/8"}},
+				}
+				err := newBuckets(server.URL()).UpdateBucketConfig("compliance-bucket", patch, "abc123")
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+		Context("When the ETag does not match", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPatch, "/v1/b/compliance-bucket"),
+						ghttp.RespondWith(http.StatusPreconditionFailed, `{"errors": [{"code": "precondition_failed", "message": "ETag does not match"}]}`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				err := newBuckets(server.URL()).UpdateBucketConfig("compliance-bucket", BucketPatch{}, "old")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
+
+func newBuckets(url string) Buckets {
+
+	sess, err := session.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+	conf := sess.Config.Copy()
+	conf.HTTPClient = bluemixHttp.NewHTTPClient(conf)
+	conf.Endpoint = &url
+
+	client := client.Client{
+		Config:      conf,
+		ServiceName: bluemix.COSConfigService,
+	}
+	return newBucketsAPI(&client)
+}
@@ -0,0 +1,13 @@
+package cosv1
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestCosv1(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Cosv1 Suite")
+}
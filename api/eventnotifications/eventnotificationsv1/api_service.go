@@ -0,0 +1,75 @@
+package eventnotificationsv1
+
+import (
+	gohttp "net/http"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/authentication"
+	"github.com/IBM-Cloud/bluemix-go/client"
+	"github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/rest"
+	"github.com/IBM-Cloud/bluemix-go/session"
+)
+
+//EventNotificationsServiceAPI is the Event Notifications client ...
+type EventNotificationsServiceAPI interface {
+	Templates() Templates
+	Integrations() Integrations
+}
+
+//ErrCodeAPICreation ...
+const ErrCodeAPICreation = "APICreationError"
+
+//enService holds the client
+type enService struct {
+	*client.Client
+}
+
+//New ...
+func New(sess *session.Session) (EventNotificationsServiceAPI, error) {
+	config := sess.Config.Copy()
+	err := config.ValidateConfigForService(bluemix.EventNotificationsService)
+	if err != nil {
+		return nil, err
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.NewHTTPClient(config)
+	}
+	tokenRefreher, err := authentication.NewIAMAuthRepository(config, &rest.Client{
+		DefaultHeader: gohttp.Header{
+			"X-Original-User-Agent": []string{config.UserAgent},
+			"User-Agent":            []string{http.UserAgent()},
+		},
+		HTTPClient: config.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if config.IAMAccessToken == "" {
+		err := authentication.PopulateTokens(tokenRefreher, config)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if config.Endpoint == nil {
+		ep, err := config.EndpointLocator.EventNotificationsEndpoint()
+		if err != nil {
+			return nil, err
+		}
+		config.Endpoint = &ep
+	}
+
+	return &enService{
+		Client: client.New(config, bluemix.EventNotificationsService, tokenRefreher),
+	}, nil
+}
+
+//Templates implements the templates API
+func (c *enService) Templates() Templates {
+	return newTemplatesAPI(c.Client)
+}
+
+//Integrations implements the integrations API
+func (c *enService) Integrations() Integrations {
+	return newIntegrationsAPI(c.Client)
+}
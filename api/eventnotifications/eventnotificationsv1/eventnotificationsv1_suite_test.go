@@ -0,0 +1,13 @@
+package eventnotificationsv1
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestEventnotificationsv1(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Eventnotificationsv1 Suite")
+}
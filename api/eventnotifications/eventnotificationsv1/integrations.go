@@ -0,0 +1,99 @@
+package eventnotificationsv1
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/IBM-Cloud/bluemix-go/client"
+)
+
+//Integration types
+const (
+	IntegrationTypeKeyProtect = "kms"
+	IntegrationTypeHPCS       = "hs-crypto"
+)
+
+//IntegrationMetadata points to the root key used to encrypt the notification payloads
+type IntegrationMetadata struct {
+	Endpoint  string `json:"endpoint"`
+	CRN       string `json:"crn"`
+	RootKeyID string `json:"root_key_id"`
+}
+
+//IntegrationReq ...
+type IntegrationReq struct {
+	Type     string              `json:"type"`
+	Metadata IntegrationMetadata `json:"metadata"`
+}
+
+//Integration ...
+type Integration struct {
+	ID        string              `json:"id"`
+	Type      string              `json:"type"`
+	Metadata  IntegrationMetadata `json:"metadata"`
+	CreatedAt string              `json:"created_at"`
+	UpdatedAt string              `json:"updated_at"`
+}
+
+type integrationList struct {
+	TotalCount   int           `json:"total_count"`
+	Integrations []Integration `json:"integrations"`
+}
+
+//Integrations manages the key management integration of an instance. Every
+//instance comes with a default integration which is replaced to bring your
+//own Key Protect or Hyper Protect Crypto Services key.
+type Integrations interface {
+	ListIntegrations(instanceID string) ([]Integration, error)
+	GetIntegration(instanceID string, integrationID string) (Integration, error)
+	ReplaceIntegration(instanceID string, integrationID string, integrationReq IntegrationReq) (Integration, error)
+	SetKeyProtectIntegration(instanceID string, metadata IntegrationMetadata) (Integration, error)
+}
+
+type integrations struct {
+	client *client.Client
+}
+
+func newIntegrationsAPI(c *client.Client) Integrations {
+	return &integrations{
+		client: c,
+	}
+}
+
+func (r *integrations) ListIntegrations(instanceID string) ([]Integration, error) {
+	var list integrationList
+	_, err := r.client.Get(fmt.Sprintf("/event-notifications/v1/instances/%s/integrations", url.PathEscape(instanceID)), &list)
+	if err != nil {
+		return nil, err
+	}
+	return list.Integrations, nil
+}
+
+func (r *integrations) GetIntegration(instanceID string, integrationID string) (Integration, error) {
+	var successV Integration
+	_, err := r.client.Get(fmt.Sprintf("/event-notifications/v1/instances/%s/integrations/%s", url.PathEscape(instanceID), url.PathEscape(integrationID)), &successV)
+	return successV, err
+}
+
+func (r *integrations) ReplaceIntegration(instanceID string, integrationID string, integrationReq IntegrationReq) (Integration, error) {
+	var successV Integration
+	_, err := r.client.Put(fmt.Sprintf("/event-notifications/v1/instances/%s/integrations/%s", url.PathEscape(instanceID), url.PathEscape(integrationID)), integrationReq, &successV)
+	return successV, err
+}
+
+//SetKeyProtectIntegration points the key management integration of the instance to a Key Protect root key
+func (r *integrations) SetKeyProtectIntegration(instanceID string, metadata IntegrationMetadata) (Integration, error) {
+	list, err := r.ListIntegrations(instanceID)
+	if err != nil {
+		return Integration{}, err
+	}
+	for _, integration := range list {
+		if integration.Type == IntegrationTypeKeyProtect || integration.Type == IntegrationTypeHPCS {
+			return r.ReplaceIntegration(instanceID, integration.ID, IntegrationReq{
+				Type:     IntegrationTypeKeyProtect,
+				Metadata: metadata,
+			})
+		}
+	}
+	return Integration{}, fmt.Errorf("No key management integration found for instance %s", instanceID)
+}
@@ -0,0 +1,104 @@
+package eventnotificationsv1
+
+import (
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Integrations", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+	Describe("SetKeyProtectIntegration", func() {
+		Context("When the default integration is replaced", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/event-notifications/v1/instances/i1/integrations"),
+						ghttp.RespondWith(http.StatusOK, `{"total_count": 1, "integrations": [{"id": "9fab83da-98cb-4f18-a7ba-b6f0435c9673", "type": "kms"}]}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, "/event-notifications/v1/instances/i1/integrations/9fab83da-98cb-4f18-a7ba-b6f0435c9673"),
+						ghttp.VerifyJSON(`{"type":"kms","metadata":{"endpoint":"https://us-south.kms.cloud.ibm.com","crn":"crn:v1:bluemix:public:kms:us-south:a/acc:kp1::","root_key_id":"rk1"}}`),
+						ghttp.RespondWith(http.StatusOK, `{"id": "9fab83da-98cb-4f18-a7ba-b6f0435c9673", "type": "kms", "metadata": {"root_key_id": "rk1"}}`),
+					),
+				)
+			})
+
+			It("should return the updated integration", func() {
+				integration, err := newIntegrations(server.URL()).SetKeyProtectIntegration("i1", IntegrationMetadata{
+					Endpoint:  "https://us-south.kms.cloud.ibm.com",
+					CRN:       "crn:v1:bluemix:public:kms:us-south:a/acc:kp1::",
+					RootKeyID: "rk1",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(integration.Metadata.RootKeyID).Should(Equal("rk1"))
+			})
+		})
+		Context("When the instance has other integrations", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/event-notifications/v1/instances/i1/integrations"),
+						ghttp.RespondWith(http.StatusOK, `{"total_count": 2, "integrations": [{"id": "cos1", "type": "collect_failed_events"}, {"id": "hpcs1", "type": "hs-crypto"}]}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, "/event-notifications/v1/instances/i1/integrations/hpcs1"),
+						ghttp.VerifyJSON(`{"type":"kms","metadata":{"endpoint":"","crn":"","root_key_id":"rk1"}}`),
+						ghttp.RespondWith(http.StatusOK, `{"id": "hpcs1", "type": "kms", "metadata": {"root_key_id": "rk1"}}`),
+					),
+				)
+			})
+
+			It("should replace the key management integration", func() {
+				integration, err := newIntegrations(server.URL()).SetKeyProtectIntegration("i1", IntegrationMetadata{RootKeyID: "rk1"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(integration.ID).Should(Equal("hpcs1"))
+			})
+		})
+		Context("When the instance has no key management integration", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/event-notifications/v1/instances/i1/integrations"),
+						ghttp.RespondWith(http.StatusOK, `{"total_count": 1, "integrations": [{"id": "cos1", "type": "collect_failed_events"}]}`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				_, err := newIntegrations(server.URL()).SetKeyProtectIntegration("i1", IntegrationMetadata{})
+				Expect(err).To(HaveOccurred())
+				Expect(server.ReceivedRequests()).Should(HaveLen(1))
+			})
+		})
+		Context("When the instance has no integration", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/event-notifications/v1/instances/i1/integrations"),
+						ghttp.RespondWith(http.StatusOK, `{"total_count": 0, "integrations": []}`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				_, err := newIntegrations(server.URL()).SetKeyProtectIntegration("i1", IntegrationMetadata{})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
+
+func newIntegrations(url string) Integrations {
+	return newIntegrationsAPI(newTestClient(url))
+}
@@ -0,0 +1,119 @@
+package eventnotificationsv1
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+
+	"github.com/IBM-Cloud/bluemix-go/client"
+)
+
+//Template types
+const (
+	TemplateTypeEmailNotification = "smtp_custom.notification"
+	TemplateTypeEmailInvitation   = "smtp_custom.invitation"
+	TemplateTypeWebhook           = "webhook.notification"
+)
+
+//TemplateParams holds the payload of the template, the body is base64 encoded
+type TemplateParams struct {
+	Body    string `json:"body"`
+	Subject string `json:"subject,omitempty"`
+}
+
+//TemplateReq ...
+type TemplateReq struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	Type        string         `json:"type"`
+	Params      TemplateParams `json:"params"`
+}
+
+//NewTemplateReq returns a template request with the body encoded as expected by the service
+func NewTemplateReq(name, templateType, subject, body string) TemplateReq {
+	return TemplateReq{
+		Name: name,
+		Type: templateType,
+		Params: TemplateParams{
+			Body:    base64.StdEncoding.EncodeToString([]byte(body)),
+			Subject: subject,
+		},
+	}
+}
+
+//Template ...
+type Template struct {
+	ID                string   `json:"id"`
+	Name              string   `json:"name"`
+	Description       string   `json:"description"`
+	Type              string   `json:"type"`
+	SubscriptionCount int      `json:"subscription_count"`
+	SubscriptionNames []string `json:"subscription_names"`
+	UpdatedAt         string   `json:"updated_at"`
+}
+
+type templateList struct {
+	TotalCount int        `json:"total_count"`
+	Offset     int        `json:"offset"`
+	Limit      int        `json:"limit"`
+	Templates  []Template `json:"templates"`
+}
+
+//Templates ...
+type Templates interface {
+	CreateTemplate(instanceID string, templateReq TemplateReq) (Template, error)
+	GetTemplate(instanceID string, templateID string) (Template, error)
+	ListTemplates(instanceID string) ([]Template, error)
+	UpdateTemplate(instanceID string, templateID string, templateReq TemplateReq) (Template, error)
+	DeleteTemplate(instanceID string, templateID string) error
+}
+
+type templates struct {
+	client *client.Client
+}
+
+func newTemplatesAPI(c *client.Client) Templates {
+	return &templates{
+		client: c,
+	}
+}
+
+func (r *templates) CreateTemplate(instanceID string, templateReq TemplateReq) (Template, error) {
+	var successV Template
+	_, err := r.client.Post(fmt.Sprintf("/event-notifications/v1/instances/%s/templates", url.PathEscape(instanceID)), templateReq, &successV)
+	return successV, err
+}
+
+func (r *templates) GetTemplate(instanceID string, templateID string) (Template, error) {
+	var successV Template
+	_, err := r.client.Get(fmt.Sprintf("/event-notifications/v1/instances/%s/templates/%s", url.PathEscape(instanceID), url.PathEscape(templateID)), &successV)
+	return successV, err
+}
+
+func (r *templates) ListTemplates(instanceID string) ([]Template, error) {
+	all := []Template{}
+	offset := 0
+	for {
+		var list templateList
+		_, err := r.client.Get(fmt.Sprintf("/event-notifications/v1/instances/%s/templates?limit=100&offset=%d", url.PathEscape(instanceID), offset), &list)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, list.Templates...)
+		offset += len(list.Templates)
+		if len(list.Templates) == 0 || offset >= list.TotalCount {
+			return all, nil
+		}
+	}
+}
+
+func (r *templates) UpdateTemplate(instanceID string, templateID string, templateReq TemplateReq) (Template, error) {
+	var successV Template
+	_, err := r.client.Put(fmt.Sprintf("/event-notifications/v1/instances/%s/templates/%s", url.PathEscape(instanceID), url.PathEscape(templateID)), templateReq, &successV)
+	return successV, err
+}
+
+func (r *templates) DeleteTemplate(instanceID string, templateID string) error {
+	_, err := r.client.Delete(fmt.Sprintf("/event-notifications/v1/instances/%s/templates/%s", url.PathEscape(instanceID), url.PathEscape(templateID)))
+	return err
+}
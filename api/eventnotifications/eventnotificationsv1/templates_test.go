@@ -0,0 +1,87 @@
+package eventnotificationsv1
+
+import (
+	"log"
+	"net/http"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/client"
+	bluemixHttp "github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/session"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Templates", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+	Describe("CreateTemplate", func() {
+		Context("When creation is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/event-notifications/v1/instances/9xxxxx-Oxxxx-0xxxx/templates"),
+						ghttp.VerifyJSON(`{"name":"incident","type":"smtp_custom.notification","params":{"body":"PGh0bWw+e3tkYXRhLmFsZXJ0fX08L2h0bWw+","subject":"Incident {{data.id}}"}}`),
+						ghttp.RespondWith(http.StatusCreated, `{"id": "t1", "name": "incident", "type": "smtp_custom.notification", "subscription_count": 0}`),
+					),
+				)
+			})
+
+			It("should encode the body and return the template", func() {
+				req := NewTemplateReq("incident", TemplateTypeEmailNotification, "Incident {{data.id}}", "<html>{{data.alert}}</html>")
+				template, err := newTemplates(server.URL()).CreateTemplate("9xxxxx-Oxxxx-0xxxx", req)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(template.ID).Should(Equal("t1"))
+			})
+		})
+	})
+	Describe("ListTemplates", func() {
+		Context("When templates span two pages", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/event-notifications/v1/instances/i1/templates", "limit=100&offset=0"),
+						ghttp.RespondWith(http.StatusOK, `{"total_count": 2, "offset": 0, "limit": 1, "templates": [{"id": "t1", "type": "webhook.notification"}]}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/event-notifications/v1/instances/i1/templates", "limit=100&offset=1"),
+						ghttp.RespondWith(http.StatusOK, `{"total_count": 2, "offset": 1, "limit": 1, "templates": [{"id": "t2", "type": "smtp_custom.invitation"}]}`),
+					),
+				)
+			})
+
+			It("should return all the templates", func() {
+				templates, err := newTemplates(server.URL()).ListTemplates("i1")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(templates).Should(HaveLen(2))
+				Expect(templates[1].Type).Should(Equal(TemplateTypeEmailInvitation))
+			})
+		})
+	})
+})
+
+func newTestClient(url string) *client.Client {
+	sess, err := session.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+	conf := sess.Config.Copy()
+	conf.HTTPClient = bluemixHttp.NewHTTPClient(conf)
+	conf.Endpoint = &url
+
+	return &client.Client{
+		Config:      conf,
+		ServiceName: bluemix.EventNotificationsService,
+	}
+}
+
+func newTemplates(url string) Templates {
+	return newTemplatesAPI(newTestClient(url))
+}
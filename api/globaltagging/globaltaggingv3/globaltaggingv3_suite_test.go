@@ -0,0 +1,13 @@
+package globaltaggingv3
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestGlobalTaggingv3(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "GlobalTaggingv3 Suite")
+}
@@ -3,6 +3,7 @@ package globaltaggingv3
 import (
 	"fmt"
 	"github.com/IBM-Cloud/bluemix-go/client"
+	"github.com/IBM-Cloud/bluemix-go/helpers"
 )
 
 type TaggingResult struct {
@@ -20,6 +21,7 @@ type TagUpdateResult struct {
 type TagResult struct {
 	ResourceID  string `json:"resource_id"`
 	IsError     string `json:"isError"`
+	Error       bool   `json:"is_error"`
 	Response    string `json:"response"`
 	Message     string `json:"message"`
 	Code        string `json:"code"`
@@ -45,6 +47,8 @@ type Tags interface {
 	AttachTags(resourceID string, taglist []string) (TagUpdateResult, error)
 	DetachTags(resourceID string, taglist []string) (TagUpdateResult, error)
 	DeleteTag(tag string) (TagUpdateResult, error)
+	AttachTagsBulk(req BulkTagRequest) (helpers.BulkResults, error)
+	DetachTagsBulk(req BulkTagRequest) (helpers.BulkResults, error)
 }
 
 type tags struct {
@@ -0,0 +1,120 @@
+package globaltaggingv3
+
+import (
+	"fmt"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/bluemix-go/helpers"
+)
+
+//ErrCodeTagOperationFailed is the code of the error reported for a resource the service didn't tag or untag
+const ErrCodeTagOperationFailed = "TagOperationFailed"
+
+const (
+	//maxBulkTagChunkSize is the maximum number of resources of an attach or
+	//detach request accepted by the service
+	maxBulkTagChunkSize       = 100
+	defaultBulkTagConcurrency = 5
+)
+
+//BulkTagRequest ...
+type BulkTagRequest struct {
+	ResourceIDs []string
+	TagNames    []string
+	//ChunkSize is the number of resources sent per request, defaults to and is capped at 100
+	ChunkSize int
+	//Concurrency is the maximum number of requests sent at once, defaults to 5
+	Concurrency int
+}
+
+//AttachTagsBulk attaches the tags to every resource, sending the resources in
+//chunks. The results hold the resource IDs. A failed request fails every
+//resource of its chunk, the other chunks are still sent.
+func (r *tags) AttachTagsBulk(req BulkTagRequest) (helpers.BulkResults, error) {
+	return r.bulk(req, "/v3/tags/attach")
+}
+
+//DetachTagsBulk detaches the tags from every resource, sending the resources
+//in chunks like AttachTagsBulk
+func (r *tags) DetachTagsBulk(req BulkTagRequest) (helpers.BulkResults, error) {
+	return r.bulk(req, "/v3/tags/detach")
+}
+
+func (r *tags) bulk(req BulkTagRequest, rawURL string) (helpers.BulkResults, error) {
+	if len(req.TagNames) == 0 {
+		return nil, fmt.Errorf("At least one tag name is required for bulk tag operations")
+	}
+	chunkSize := req.ChunkSize
+	if chunkSize < 1 || chunkSize > maxBulkTagChunkSize {
+		chunkSize = maxBulkTagChunkSize
+	}
+	concurrency := req.Concurrency
+	if concurrency < 1 {
+		concurrency = defaultBulkTagConcurrency
+	}
+
+	//once the client context is done no further chunk is sent, the resources
+	//not processed are reported with the context error
+	ids := req.ResourceIDs
+	results := make(helpers.BulkResults, len(ids))
+	chunks := (len(ids) + chunkSize - 1) / chunkSize
+	bounds := func(chunk int) (int, int) {
+		start, end := chunk*chunkSize, (chunk+1)*chunkSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		return start, end
+	}
+	errs, err := helpers.RunBulk(r.client.Context(), chunks, concurrency, func(chunk int) error {
+		start, end := bounds(chunk)
+		r.sendChunk(rawURL, ids[start:end], req.TagNames, results[start:end])
+		return nil
+	})
+	for chunk, chunkErr := range errs {
+		if chunkErr == nil {
+			continue
+		}
+		start, end := bounds(chunk)
+		for i := start; i < end; i++ {
+			results[i] = helpers.BulkResult{ID: ids[i], Err: chunkErr}
+		}
+	}
+	return results, err
+}
+
+//sendChunk fills results with the outcome of the request for the resources
+func (r *tags) sendChunk(rawURL string, resourceIDs, tagNames []string, results helpers.BulkResults) {
+	body := TaggingBody{TagNames: tagNames}
+	for _, id := range resourceIDs {
+		body.TagResources = append(body.TagResources, TagResource{ResourceID: id})
+	}
+	var updated TagUpdateResult
+	_, err := r.client.Post(rawURL, &body, &updated)
+
+	byResource := map[string]TagResult{}
+	for _, res := range updated.Results {
+		byResource[res.ResourceID] = res
+	}
+	for i, id := range resourceIDs {
+		results[i] = helpers.BulkResult{ID: id, Err: err}
+		if err != nil {
+			continue
+		}
+		res, ok := byResource[id]
+		switch {
+		case !ok:
+			results[i].Err = bmxerror.New(ErrCodeTagOperationFailed, "No result returned for the resource")
+		case res.Error || res.IsError == "true":
+			results[i].Err = bmxerror.New(ErrCodeTagOperationFailed, tagResultMessage(res))
+		}
+	}
+}
+
+func tagResultMessage(res TagResult) string {
+	for _, m := range []string{res.Message, res.Description, res.Response} {
+		if m != "" {
+			return m
+		}
+	}
+	return fmt.Sprintf("HTTP %d", res.HttpCode)
+}
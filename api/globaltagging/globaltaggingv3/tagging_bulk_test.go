@@ -0,0 +1,126 @@
+package globaltaggingv3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bulk tagging", func() {
+	var server *ghttp.Server
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	//respondPerResource fails the resources listed in failed and tags the others
+	respondPerResource := func(failed map[string]bool) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			var body TaggingBody
+			Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+			Expect(body.TagNames).To(Equal([]string{"env:prod"}))
+			Expect(len(body.TagResources)).To(BeNumerically("<=", 2))
+			result := TagUpdateResult{}
+			for _, res := range body.TagResources {
+				result.Results = append(result.Results, TagResult{
+					ResourceID: res.ResourceID,
+					Error:      failed[res.ResourceID],
+					Message:    fmt.Sprintf("%s not found", res.ResourceID),
+				})
+			}
+			w.Header().Set("Content-Type", "application/json")
+			Expect(json.NewEncoder(w).Encode(result)).To(Succeed())
+		}
+	}
+
+	Describe("AttachTagsBulk", func() {
+		Context("When some resources fail", func() {
+			BeforeEach(func() {
+				server.RouteToHandler(http.MethodPost, "/v3/tags/attach", respondPerResource(map[string]bool{"crn3": true}))
+			})
+
+			It("should report the result of every resource", func() {
+				results, err := newTagging(server.URL()).AttachTagsBulk(BulkTagRequest{
+					ResourceIDs: []string{"crn1", "crn2", "crn3", "crn4", "crn5"},
+					TagNames:    []string{"env:prod"},
+					ChunkSize:   2,
+					Concurrency: 2,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(server.ReceivedRequests()).To(HaveLen(3))
+				Expect(results).To(HaveLen(5))
+				for i, res := range results {
+					Expect(res.ID).To(Equal(fmt.Sprintf("crn%d", i+1)))
+				}
+				failed := results.Failed()
+				Expect(failed).To(HaveLen(1))
+				Expect(failed[0].ID).To(Equal("crn3"))
+				Expect(failed[0].Err.Error()).To(ContainSubstring("crn3 not found"))
+			})
+		})
+		Context("When a request fails", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.RespondWith(http.StatusBadRequest, `{"errors": [{"message": "bad request"}]}`),
+					respondPerResource(nil),
+				)
+			})
+
+			It("should fail the resources of its chunk only", func() {
+				results, err := newTagging(server.URL()).AttachTagsBulk(BulkTagRequest{
+					ResourceIDs: []string{"crn1", "crn2", "crn3"},
+					TagNames:    []string{"env:prod"},
+					ChunkSize:   2,
+					Concurrency: 1,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				failed := results.Failed()
+				Expect(failed).To(HaveLen(2))
+				Expect(failed[0].ID).To(Equal("crn1"))
+				Expect(failed[1].ID).To(Equal("crn2"))
+			})
+		})
+		Context("When the context is canceled during the batch", func() {
+			var ctx context.Context
+			var cancel context.CancelFunc
+			BeforeEach(func() {
+				ctx, cancel = context.WithCancel(context.Background())
+				server.AppendHandlers(func(w http.ResponseWriter, req *http.Request) {
+					cancel()
+					respondPerResource(nil)(w, req)
+				})
+			})
+
+			It("should report the context error for the chunks not sent", func() {
+				results, err := newTaggingAPI(newTaggingClient(server.URL()).WithContext(ctx)).AttachTagsBulk(BulkTagRequest{
+					ResourceIDs: []string{"crn1", "crn2", "crn3", "crn4", "crn5"},
+					TagNames:    []string{"env:prod"},
+					ChunkSize:   2,
+					Concurrency: 1,
+				})
+				Expect(err).To(Equal(context.Canceled))
+				Expect(server.ReceivedRequests()).To(HaveLen(1))
+				Expect(results).To(HaveLen(5))
+				for i, res := range results[2:] {
+					Expect(res.ID).To(Equal(fmt.Sprintf("crn%d", i+3)))
+					Expect(res.Err).To(Equal(context.Canceled))
+				}
+			})
+		})
+	})
+	Describe("DetachTagsBulk", func() {
+		It("should require tag names", func() {
+			_, err := newTagging(server.URL()).DetachTagsBulk(BulkTagRequest{ResourceIDs: []string{"crn1"}})
+			Expect(err).To(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,109 @@
+package iamv1
+
+import (
+	"fmt"
+
+	"github.com/IBM-Cloud/bluemix-go/client"
+)
+
+const (
+	_AccountSettingsPath = "/v1/accounts/%s/settings/identity"
+	_IfMatchHeader       = "If-Match"
+)
+
+//Two-factor authentication levels of IAM identity account settings. The
+//LEVEL values require the given factor for every user, TOTP and TOTP4ALL
+//require a time-based one-time passcode for non-federated and for all IBMid
+//users respectively.
+const (
+	MFANone        = "NONE"
+	MFANoneNoROPC  = "NONE_NO_ROPC"
+	MFATOTP        = "TOTP"
+	MFATOTP4All    = "TOTP4ALL"
+	MFALevel1Email = "LEVEL1"
+	MFALevel2TOTP  = "LEVEL2"
+	MFALevel3U2F   = "LEVEL3"
+)
+
+//UserMFA is the two-factor authentication level required for a single user,
+//overriding the level of the account
+type UserMFA struct {
+	IAMID string `json:"iam_id"`
+	MFA   string `json:"mfa"`
+}
+
+//AccountSettings are the IAM identity settings of an account
+type AccountSettings struct {
+	AccountID string    `json:"account_id,omitempty"`
+	EntityTag string    `json:"entity_tag,omitempty"`
+	MFA       string    `json:"mfa,omitempty"`
+	UserMFA   []UserMFA `json:"user_mfa"`
+}
+
+//AccountSettingsRepository manages the IAM identity settings of an account
+type AccountSettingsRepository interface {
+	Get(accountID string) (AccountSettings, error)
+	//GetUserMFA returns the two-factor authentication level required for the
+	//user, the level of the account when the user has none of its own
+	GetUserMFA(accountID, iamID string) (string, error)
+	//SetUserMFA sets the two-factor authentication level required for the
+	//user, an empty mfa removes the user level so that the account level applies
+	SetUserMFA(accountID, iamID, mfa string) error
+}
+
+type accountSettingsRepository struct {
+	client *client.Client
+}
+
+//NewAccountSettingsRepository ...
+func NewAccountSettingsRepository(c *client.Client) AccountSettingsRepository {
+	return &accountSettingsRepository{
+		client: c,
+	}
+}
+
+func (r *accountSettingsRepository) Get(accountID string) (AccountSettings, error) {
+	settings := AccountSettings{}
+	_, err := r.client.Get(fmt.Sprintf(_AccountSettingsPath, accountID), &settings)
+	return settings, err
+}
+
+func (r *accountSettingsRepository) GetUserMFA(accountID, iamID string) (string, error) {
+	settings, err := r.Get(accountID)
+	if err != nil {
+		return "", err
+	}
+	for _, u := range settings.UserMFA {
+		if u.IAMID == iamID {
+			return u.MFA, nil
+		}
+	}
+	return settings.MFA, nil
+}
+
+func (r *accountSettingsRepository) SetUserMFA(accountID, iamID, mfa string) error {
+	settings, err := r.Get(accountID)
+	if err != nil {
+		return err
+	}
+	userMFA := []UserMFA{}
+	for _, u := range settings.UserMFA {
+		if u.IAMID != iamID {
+			userMFA = append(userMFA, u)
+		}
+	}
+	if mfa != "" {
+		userMFA = append(userMFA, UserMFA{IAMID: iamID, MFA: mfa})
+	}
+	entityTag := settings.EntityTag
+	if entityTag == "" {
+		entityTag = "*"
+	}
+	payload := struct {
+		UserMFA []UserMFA `json:"user_mfa"`
+	}{
+		UserMFA: userMFA,
+	}
+	_, err = r.client.Put(fmt.Sprintf(_AccountSettingsPath, accountID), payload, nil, map[string]string{_IfMatchHeader: entityTag})
+	return err
+}
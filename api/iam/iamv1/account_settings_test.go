@@ -0,0 +1,149 @@
+package iamv1
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/IBM-Cloud/bluemix-go"
+
+	"github.com/IBM-Cloud/bluemix-go/client"
+	"github.com/IBM-Cloud/bluemix-go/session"
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const accountSettingsResponse = `{
+	"account_id": "acc",
+	"entity_tag": "2-abc",
+	"mfa": "NONE",
+	"user_mfa": [
+		{"iam_id": "IBMid-1", "mfa": "LEVEL3"},
+		{"iam_id": "IBMid-2", "mfa": "TOTP"}
+	]
+}`
+
+var _ = Describe("Account settings Repository", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("GetUserMFA() method", func() {
+		Context("When the user has its own level", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v1/accounts/acc/settings/identity"),
+						ghttp.RespondWith(http.StatusOK, accountSettingsResponse),
+					),
+				)
+			})
+
+			It("should return the level of the user", func() {
+				mfa, err := newTestAccountSettingsRepo(server.URL()).GetUserMFA("acc", "IBMid-1")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(mfa).Should(Equal(MFALevel3U2F))
+			})
+		})
+		Context("When the user has no level of its own", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v1/accounts/acc/settings/identity"),
+						ghttp.RespondWith(http.StatusOK, accountSettingsResponse),
+					),
+				)
+			})
+
+			It("should return the level of the account", func() {
+				mfa, err := newTestAccountSettingsRepo(server.URL()).GetUserMFA("acc", "IBMid-3")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(mfa).Should(Equal(MFANone))
+			})
+		})
+	})
+
+	Describe("SetUserMFA() method", func() {
+		Context("When the level of a user is changed", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v1/accounts/acc/settings/identity"),
+						ghttp.RespondWith(http.StatusOK, accountSettingsResponse),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, "/v1/accounts/acc/settings/identity"),
+						ghttp.VerifyHeaderKV("If-Match", "2-abc"),
+						ghttp.VerifyJSON(`{"user_mfa": [{"iam_id": "IBMid-2", "mfa": "TOTP"}, {"iam_id": "IBMid-1", "mfa": "LEVEL1"}]}`),
+						ghttp.RespondWith(http.StatusOK, `{}`),
+					),
+				)
+			})
+
+			It("should replace only the level of the user", func() {
+				err := newTestAccountSettingsRepo(server.URL()).SetUserMFA("acc", "IBMid-1", MFALevel1Email)
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+		})
+		Context("When the level of a user is removed", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v1/accounts/acc/settings/identity"),
+						ghttp.RespondWith(http.StatusOK, accountSettingsResponse),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, "/v1/accounts/acc/settings/identity"),
+						ghttp.VerifyJSON(`{"user_mfa": [{"iam_id": "IBMid-2", "mfa": "TOTP"}]}`),
+						ghttp.RespondWith(http.StatusOK, `{}`),
+					),
+				)
+			})
+
+			It("should keep the other users", func() {
+				err := newTestAccountSettingsRepo(server.URL()).SetUserMFA("acc", "IBMid-1", "")
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+		})
+		Context("When the settings changed concurrently", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v1/accounts/acc/settings/identity"),
+						ghttp.RespondWith(http.StatusOK, accountSettingsResponse),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, "/v1/accounts/acc/settings/identity"),
+						ghttp.RespondWith(http.StatusConflict, `{"errorCode": "BXNIM0509E", "errorMessage": "Entity tag does not match"}`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				err := newTestAccountSettingsRepo(server.URL()).SetUserMFA("acc", "IBMid-1", MFALevel2TOTP)
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+})
+
+func newTestAccountSettingsRepo(url string) AccountSettingsRepository {
+	sess, err := session.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+	conf := sess.Config.Copy()
+	conf.Endpoint = &url
+	client := client.Client{
+		Config:      conf,
+		ServiceName: bluemix.IAMService,
+	}
+	return NewAccountSettingsRepository(&client)
+}
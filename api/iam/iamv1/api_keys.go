@@ -53,8 +53,25 @@ func (r APIKeyResource) ToModel() models.APIKey {
 	}
 }
 
+//APIKeyDetails is the metadata of an API key as returned by the IAM identity service
+type APIKeyDetails struct {
+	ID          string `json:"id"`
+	EntityTag   string `json:"entity_tag"`
+	Crn         string `json:"crn"`
+	Locked      bool   `json:"locked"`
+	CreatedAt   string `json:"created_at"`
+	CreatedBy   string `json:"created_by"`
+	ModifiedAt  string `json:"modified_at"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	IAMID       string `json:"iam_id"`
+	AccountID   string `json:"account_id"`
+}
+
 const (
 	_API_Key_Operation_Path_Root = "/apikeys/"
+
+	apiKeyDetailsHeader = "IAM-ApiKey"
 )
 
 type APIKeyRepository interface {
@@ -64,6 +81,7 @@ type APIKeyRepository interface {
 	Create(key models.APIKey) (*models.APIKey, error)
 	Delete(uuid string) error
 	Update(uuid string, version string, key models.APIKey) (*models.APIKey, error)
+	GetDetails(apiKey string) (*APIKeyDetails, error)
 }
 
 type apiKeyRepository struct {
@@ -149,3 +167,15 @@ func (r *apiKeyRepository) Update(uuid string, version string, key models.APIKey
 	keyToReturn := keyUpdated.ToModel()
 	return &keyToReturn, nil
 }
+
+//GetDetails resolves an API key value to the metadata of the key
+func (r *apiKeyRepository) GetDetails(apiKey string) (*APIKeyDetails, error) {
+	details := APIKeyDetails{}
+	_, err := r.client.Get("/v1/apikeys/details", &details, map[string]string{
+		apiKeyDetailsHeader: apiKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &details, nil
+}
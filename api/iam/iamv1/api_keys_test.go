@@ -679,6 +679,56 @@ var _ = Describe("ApiKeys Repository", func() {
 			})
 		})
 	})
+
+	Describe("GetDetails", func() {
+		Context("When the API key exists", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v1/apikeys/details"),
+						ghttp.VerifyHeaderKV("IAM-ApiKey", "my-api-key"),
+						ghttp.RespondWith(http.StatusOK, `
+						{
+							"id": "ApiKey-92fefdd1-4557-4c7d-8a1c-f6da7ee2ff3a",
+							"name": "test",
+							"locked": true,
+							"created_at": "2017-02-20T12:55+0000",
+							"created_by": "IBMid-270004WA4U",
+							"iam_id": "IBMid-270004WA4U",
+							"account_id": "abc"
+						}`),
+					),
+				)
+			})
+
+			It("should return the API key details", func() {
+				details, err := newTestAPIKeyRepo(server.URL()).GetDetails("my-api-key")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(details.ID).Should(Equal("ApiKey-92fefdd1-4557-4c7d-8a1c-f6da7ee2ff3a"))
+				Expect(details.Locked).Should(BeTrue())
+				Expect(details.CreatedBy).Should(Equal("IBMid-270004WA4U"))
+			})
+		})
+
+		Context("When the API key is unknown", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v1/apikeys/details"),
+						ghttp.RespondWith(http.StatusNotFound, `{"errorCode": "BXNIM0418E", "errorMessage": "API key not found"}`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				details, err := newTestAPIKeyRepo(server.URL()).GetDetails("unknown")
+				Expect(err).Should(HaveOccurred())
+				Expect(details).Should(BeNil())
+			})
+		})
+	})
 })
 
 func newTestAPIKeyRepo(url string) APIKeyRepository {
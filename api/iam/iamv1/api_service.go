@@ -19,6 +19,7 @@ type IAMServiceAPI interface {
 	ServicePolicies() ServicePolicyRepository
 	UserPolicies() UserPolicyRepository
 	Identity() Identity
+	AccountSettings() AccountSettingsRepository
 }
 
 //ErrCodeAPICreation ...
@@ -97,3 +98,8 @@ func (a *iamService) UserPolicies() UserPolicyRepository {
 func (a *iamService) Identity() Identity {
 	return NewIdentity(a.Client)
 }
+
+//AccountSettingsAPI
+func (a *iamService) AccountSettings() AccountSettingsRepository {
+	return NewAccountSettingsRepository(a.Client)
+}
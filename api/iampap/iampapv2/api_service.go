@@ -14,6 +14,7 @@ import (
 //IAMPAPAPIV2 is the resource client ...
 type IAMPAPAPIV2 interface {
 	IAMRoles() RoleRepository
+	PolicyTemplates() PolicyTemplateRepository
 }
 
 //ErrCodeAPICreation ...
@@ -67,3 +68,8 @@ func New(sess *session.Session) (IAMPAPAPIV2, error) {
 func (a *roleService) IAMRoles() RoleRepository {
 	return NewRoleRepository(a.Client)
 }
+
+//PolicyTemplate API
+func (a *roleService) PolicyTemplates() PolicyTemplateRepository {
+	return NewPolicyTemplateRepository(a.Client)
+}
@@ -0,0 +1,206 @@
+package iampapv2
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/IBM-Cloud/bluemix-go/client"
+)
+
+//TemplateAttribute ...
+type TemplateAttribute struct {
+	Key      string `json:"key"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
+//TemplateResource ...
+type TemplateResource struct {
+	Attributes []TemplateAttribute `json:"attributes"`
+}
+
+//TemplateRole ...
+type TemplateRole struct {
+	RoleID string `json:"role_id"`
+}
+
+//TemplateControl ...
+type TemplateControl struct {
+	Grant struct {
+		Roles []TemplateRole `json:"roles"`
+	} `json:"grant"`
+}
+
+//TemplatePolicy is the policy created in every account the template is assigned to
+type TemplatePolicy struct {
+	Type        string            `json:"type"`
+	Description string            `json:"description,omitempty"`
+	Resource    *TemplateResource `json:"resource,omitempty"`
+	Control     *TemplateControl  `json:"control,omitempty"`
+}
+
+//PolicyTemplate ...
+type PolicyTemplate struct {
+	ID               string         `json:"id,omitempty"`
+	Name             string         `json:"name"`
+	Description      string         `json:"description,omitempty"`
+	AccountID        string         `json:"account_id"`
+	Version          string         `json:"version,omitempty"`
+	Committed        bool           `json:"committed,omitempty"`
+	State            string         `json:"state,omitempty"`
+	Policy           TemplatePolicy `json:"policy"`
+	Href             string         `json:"href,omitempty"`
+	CreatedAt        string         `json:"created_at,omitempty"`
+	CreatedByID      string         `json:"created_by_id,omitempty"`
+	LastModifiedAt   string         `json:"last_modified_at,omitempty"`
+	LastModifiedByID string         `json:"last_modified_by_id,omitempty"`
+}
+
+//TemplateVersion identifies a committed policy template version
+type TemplateVersion struct {
+	ID      string `json:"id"`
+	Version string `json:"version"`
+}
+
+//AssignmentTarget is the child account or account group receiving the templates
+type AssignmentTarget struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+//PolicyAssignmentRequest ...
+type PolicyAssignmentRequest struct {
+	Target    AssignmentTarget  `json:"target"`
+	Templates []TemplateVersion `json:"templates"`
+}
+
+//PolicyAssignment ...
+type PolicyAssignment struct {
+	ID               string           `json:"id"`
+	AccountID        string           `json:"account_id"`
+	Target           AssignmentTarget `json:"target"`
+	Template         TemplateVersion  `json:"template"`
+	Status           string           `json:"status"`
+	Href             string           `json:"href,omitempty"`
+	CreatedAt        string           `json:"created_at,omitempty"`
+	CreatedByID      string           `json:"created_by_id,omitempty"`
+	LastModifiedAt   string           `json:"last_modified_at,omitempty"`
+	LastModifiedByID string           `json:"last_modified_by_id,omitempty"`
+}
+
+type policyTemplateList struct {
+	PolicyTemplates []PolicyTemplate `json:"policy_templates"`
+}
+
+type policyAssignmentList struct {
+	PolicyAssignments []PolicyAssignment `json:"policy_assignments"`
+}
+
+//PolicyTemplateRepository manages enterprise policy templates and their assignments
+type PolicyTemplateRepository interface {
+	List(accountID string) ([]PolicyTemplate, error)
+	Create(template PolicyTemplate) (PolicyTemplate, error)
+	Get(templateID, version string) (PolicyTemplate, string, error)
+	CreateVersion(templateID string, template PolicyTemplate) (PolicyTemplate, error)
+	Update(templateID, version string, template PolicyTemplate, etag string) (PolicyTemplate, error)
+	Commit(templateID, version, etag string) error
+	Delete(templateID string) error
+	Assign(accountID string, request PolicyAssignmentRequest) ([]PolicyAssignment, error)
+	GetAssignment(assignmentID string) (PolicyAssignment, error)
+	ListAssignments(accountID string) ([]PolicyAssignment, error)
+	DeleteAssignment(assignmentID string) error
+}
+
+type policyTemplateRepository struct {
+	client *client.Client
+}
+
+func NewPolicyTemplateRepository(c *client.Client) PolicyTemplateRepository {
+	return &policyTemplateRepository{
+		client: c,
+	}
+}
+
+func (r *policyTemplateRepository) List(accountID string) ([]PolicyTemplate, error) {
+	res := policyTemplateList{}
+	_, err := r.client.Get(fmt.Sprintf("/v1/policy_templates?account_id=%s", url.QueryEscape(accountID)), &res)
+	if err != nil {
+		return []PolicyTemplate{}, err
+	}
+	return res.PolicyTemplates, nil
+}
+
+func (r *policyTemplateRepository) Create(template PolicyTemplate) (PolicyTemplate, error) {
+	res := PolicyTemplate{}
+	_, err := r.client.Post("/v1/policy_templates", &template, &res)
+	return res, err
+}
+
+func (r *policyTemplateRepository) Get(templateID, version string) (PolicyTemplate, string, error) {
+	res := PolicyTemplate{}
+	response, err := r.client.Get(fmt.Sprintf("/v1/policy_templates/%s/versions/%s", templateID, version), &res)
+	if err != nil {
+		return PolicyTemplate{}, "", err
+	}
+	return res, response.Header.Get("Etag"), nil
+}
+
+func (r *policyTemplateRepository) CreateVersion(templateID string, template PolicyTemplate) (PolicyTemplate, error) {
+	res := PolicyTemplate{}
+	_, err := r.client.Post(fmt.Sprintf("/v1/policy_templates/%s/versions", templateID), &template, &res)
+	return res, err
+}
+
+func (r *policyTemplateRepository) Update(templateID, version string, template PolicyTemplate, etag string) (PolicyTemplate, error) {
+	res := PolicyTemplate{}
+	header := make(map[string]string)
+
+	header["IF-Match"] = etag
+	_, err := r.client.Put(fmt.Sprintf("/v1/policy_templates/%s/versions/%s", templateID, version), &template, &res, header)
+	return res, err
+}
+
+//Commit makes a template version immutable so that it can be assigned
+func (r *policyTemplateRepository) Commit(templateID, version, etag string) error {
+	header := make(map[string]string)
+
+	header["IF-Match"] = etag
+	_, err := r.client.Post(fmt.Sprintf("/v1/policy_templates/%s/versions/%s/commit", templateID, version), nil, nil, header)
+	return err
+}
+
+func (r *policyTemplateRepository) Delete(templateID string) error {
+	_, err := r.client.Delete(fmt.Sprintf("/v1/policy_templates/%s", templateID))
+	return err
+}
+
+//Assign rolls the template versions out to the target, one assignment per template
+func (r *policyTemplateRepository) Assign(accountID string, request PolicyAssignmentRequest) ([]PolicyAssignment, error) {
+	res := policyAssignmentList{}
+	_, err := r.client.Post(fmt.Sprintf("/v1/policy_assignments?account_id=%s", url.QueryEscape(accountID)), &request, &res)
+	if err != nil {
+		return []PolicyAssignment{}, err
+	}
+	return res.PolicyAssignments, nil
+}
+
+func (r *policyTemplateRepository) GetAssignment(assignmentID string) (PolicyAssignment, error) {
+	res := PolicyAssignment{}
+	_, err := r.client.Get(fmt.Sprintf("/v1/policy_assignments/%s", assignmentID), &res)
+	return res, err
+}
+
+func (r *policyTemplateRepository) ListAssignments(accountID string) ([]PolicyAssignment, error) {
+	res := policyAssignmentList{}
+	_, err := r.client.Get(fmt.Sprintf("/v1/policy_assignments?account_id=%s", url.QueryEscape(accountID)), &res)
+	if err != nil {
+		return []PolicyAssignment{}, err
+	}
+	return res.PolicyAssignments, nil
+}
+
+//DeleteAssignment removes the policies created by the assignment
+func (r *policyTemplateRepository) DeleteAssignment(assignmentID string) error {
+	_, err := r.client.Delete(fmt.Sprintf("/v1/policy_assignments/%s", assignmentID))
+	return err
+}
@@ -0,0 +1,142 @@
+package iampapv2
+
+import (
+	"log"
+	"net/http"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/client"
+	"github.com/IBM-Cloud/bluemix-go/session"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("PolicyTemplateRepository", func() {
+	var (
+		server *ghttp.Server
+	)
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("Create()", func() {
+		Context("When create one policy template", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v1/policy_templates"),
+						ghttp.VerifyJSON(`{
+							"name": "kms-viewer",
+							"account_id": "acc",
+							"policy": {
+								"type": "access",
+								"resource": {"attributes": [{"key": "serviceName", "operator": "stringEquals", "value": "kms"}]},
+								"control": {"grant": {"roles": [{"role_id": "crn:v1:bluemix:public:iam::::role:Viewer"}]}}
+							}
+						}`),
+						ghttp.RespondWith(http.StatusCreated, `{
+							"id": "policyTemplate-1",
+							"name": "kms-viewer",
+							"account_id": "acc",
+							"version": "1",
+							"committed": false,
+							"state": "active",
+							"policy": {"type": "access"}
+						}`),
+					),
+				)
+			})
+
+			It("should return the created template", func() {
+				control := &TemplateControl{}
+				control.Grant.Roles = []TemplateRole{{RoleID: "crn:v1:bluemix:public:iam::::role:Viewer"}}
+				template, err := newTestPolicyTemplateRepo(server.URL()).Create(PolicyTemplate{
+					Name:      "kms-viewer",
+					AccountID: "acc",
+					Policy: TemplatePolicy{
+						Type: "access",
+						Resource: &TemplateResource{
+							Attributes: []TemplateAttribute{{Key: "serviceName", Operator: "stringEquals", Value: "kms"}},
+						},
+						Control: control,
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(template.ID).Should(Equal("policyTemplate-1"))
+				Expect(template.Version).Should(Equal("1"))
+			})
+		})
+	})
+
+	Describe("Assign()", func() {
+		Context("When templates are assigned to an account group", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v1/policy_assignments", "account_id=acc"),
+						ghttp.VerifyJSON(`{"target":{"type":"AccountGroup","id":"group-1"},"templates":[{"id":"policyTemplate-1","version":"1"}]}`),
+						ghttp.RespondWith(http.StatusCreated, `{
+							"policy_assignments": [{
+								"id": "assignment-1",
+								"account_id": "acc",
+								"target": {"type": "AccountGroup", "id": "group-1"},
+								"template": {"id": "policyTemplate-1", "version": "1"},
+								"status": "in_progress"
+							}]
+						}`),
+					),
+				)
+			})
+
+			It("should return one assignment per template", func() {
+				assignments, err := newTestPolicyTemplateRepo(server.URL()).Assign("acc", PolicyAssignmentRequest{
+					Target:    AssignmentTarget{Type: "AccountGroup", ID: "group-1"},
+					Templates: []TemplateVersion{{ID: "policyTemplate-1", Version: "1"}},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(assignments).Should(HaveLen(1))
+				Expect(assignments[0].Status).Should(Equal("in_progress"))
+			})
+		})
+	})
+
+	Describe("Delete()", func() {
+		Context("When template has active assignments", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, "/v1/policy_templates/policyTemplate-1"),
+						ghttp.RespondWith(http.StatusConflict, `{
+							"errors": [{"code": "template_assigned", "message": "Template has active assignments"}]
+						}`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				err := newTestPolicyTemplateRepo(server.URL()).Delete("policyTemplate-1")
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).Should(ContainSubstring("409"))
+			})
+		})
+	})
+})
+
+func newTestPolicyTemplateRepo(url string) PolicyTemplateRepository {
+	sess, err := session.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+	conf := sess.Config.Copy()
+	conf.Endpoint = &url
+	client := client.Client{
+		Config:      conf,
+		ServiceName: bluemix.IAMPAPServicev2,
+	}
+	return NewPolicyTemplateRepository(&client)
+}
@@ -0,0 +1,230 @@
+package iamuumv2
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/IBM-Cloud/bluemix-go/client"
+)
+
+//Assignment target types
+const (
+	AssignmentTargetAccount      = "Account"
+	AssignmentTargetAccountGroup = "AccountGroup"
+)
+
+//TemplateMembers are the users and service IDs added to the access group in every child account
+type TemplateMembers struct {
+	Users    []string `json:"users,omitempty"`
+	Services []string `json:"services,omitempty"`
+}
+
+//TemplateRuleCondition ...
+type TemplateRuleCondition struct {
+	Claim    string `json:"claim"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
+//TemplateRule is a dynamic rule rolled out with the access group
+type TemplateRule struct {
+	Name       string                  `json:"name"`
+	Expiration int                     `json:"expiration"`
+	RealmName  string                  `json:"realm_name"`
+	Conditions []TemplateRuleCondition `json:"conditions"`
+}
+
+//TemplateAssertions ...
+type TemplateAssertions struct {
+	Rules []TemplateRule `json:"rules,omitempty"`
+}
+
+//TemplateGroup describes the access group created in the child accounts
+type TemplateGroup struct {
+	Name        string              `json:"name"`
+	Description string              `json:"description,omitempty"`
+	Members     *TemplateMembers    `json:"members,omitempty"`
+	Assertions  *TemplateAssertions `json:"assertions,omitempty"`
+}
+
+//PolicyTemplateReference points to a policy template version attached to the access group
+type PolicyTemplateReference struct {
+	ID      string `json:"id"`
+	Version string `json:"version"`
+}
+
+//AccessGroupTemplate ...
+type AccessGroupTemplate struct {
+	ID                       string                    `json:"id,omitempty"`
+	Name                     string                    `json:"name"`
+	Description              string                    `json:"description,omitempty"`
+	AccountID                string                    `json:"account_id"`
+	Version                  string                    `json:"version,omitempty"`
+	Committed                bool                      `json:"committed,omitempty"`
+	Group                    *TemplateGroup            `json:"group,omitempty"`
+	PolicyTemplateReferences []PolicyTemplateReference `json:"policy_template_references,omitempty"`
+	Href                     string                    `json:"href,omitempty"`
+	CreatedAt                string                    `json:"created_at,omitempty"`
+	CreatedByID              string                    `json:"created_by_id,omitempty"`
+	LastModifiedAt           string                    `json:"last_modified_at,omitempty"`
+	LastModifiedByID         string                    `json:"last_modified_by_id,omitempty"`
+}
+
+//TemplateAssignmentRequest assigns a committed template version to a child account or account group
+type TemplateAssignmentRequest struct {
+	TemplateID      string `json:"template_id"`
+	TemplateVersion string `json:"template_version"`
+	TargetType      string `json:"target_type"`
+	Target          string `json:"target"`
+}
+
+//TemplateAssignment ...
+type TemplateAssignment struct {
+	TemplateAssignmentRequest
+	ID               string `json:"id"`
+	AccountID        string `json:"account_id"`
+	Operation        string `json:"operation"`
+	Status           string `json:"status"`
+	Href             string `json:"href,omitempty"`
+	CreatedAt        string `json:"created_at,omitempty"`
+	CreatedByID      string `json:"created_by_id,omitempty"`
+	LastModifiedAt   string `json:"last_modified_at,omitempty"`
+	LastModifiedByID string `json:"last_modified_by_id,omitempty"`
+}
+
+type groupTemplates struct {
+	PaginationFields
+	GroupTemplates []AccessGroupTemplate `json:"group_templates"`
+}
+
+func (g *groupTemplates) Resources() []interface{} {
+	r := make([]interface{}, len(g.GroupTemplates))
+	for i := range g.GroupTemplates {
+		r[i] = g.GroupTemplates[i]
+	}
+	return r
+}
+
+type templateAssignments struct {
+	PaginationFields
+	Assignments []TemplateAssignment `json:"assignments"`
+}
+
+func (a *templateAssignments) Resources() []interface{} {
+	r := make([]interface{}, len(a.Assignments))
+	for i := range a.Assignments {
+		r[i] = a.Assignments[i]
+	}
+	return r
+}
+
+//AccessGroupTemplateRepository manages enterprise access group templates and their assignments
+type AccessGroupTemplateRepository interface {
+	List(accountID string) ([]AccessGroupTemplate, error)
+	Create(template AccessGroupTemplate) (AccessGroupTemplate, error)
+	Get(templateID, version string) (template AccessGroupTemplate, etag string, err error)
+	CreateVersion(templateID string, template AccessGroupTemplate) (AccessGroupTemplate, error)
+	Update(templateID, version string, template AccessGroupTemplate, etag string) (AccessGroupTemplate, error)
+	Commit(templateID, version, etag string) error
+	Delete(templateID string) error
+	Assign(request TemplateAssignmentRequest) (TemplateAssignment, error)
+	GetAssignment(assignmentID string) (TemplateAssignment, error)
+	ListAssignments(accountID, templateID string) ([]TemplateAssignment, error)
+	DeleteAssignment(assignmentID string) error
+}
+
+type accessGroupTemplateRepository struct {
+	client *client.Client
+}
+
+func NewAccessGroupTemplateRepository(c *client.Client) AccessGroupTemplateRepository {
+	return &accessGroupTemplateRepository{
+		client: c,
+	}
+}
+
+func (r *accessGroupTemplateRepository) List(accountID string) ([]AccessGroupTemplate, error) {
+	var templates []AccessGroupTemplate
+	_, err := r.client.GetPaginated(fmt.Sprintf("/v1/group_templates?account_id=%s", url.QueryEscape(accountID)), NewPaginatedResourcesHandler(&groupTemplates{}), func(v interface{}) bool {
+		templates = append(templates, v.(AccessGroupTemplate))
+		return true
+	})
+	if err != nil {
+		return []AccessGroupTemplate{}, err
+	}
+	return templates, nil
+}
+
+func (r *accessGroupTemplateRepository) Create(template AccessGroupTemplate) (AccessGroupTemplate, error) {
+	res := AccessGroupTemplate{}
+	_, err := r.client.Post("/v1/group_templates", &template, &res)
+	return res, err
+}
+
+func (r *accessGroupTemplateRepository) Get(templateID, version string) (AccessGroupTemplate, string, error) {
+	res := AccessGroupTemplate{}
+	resp, err := r.client.Get(fmt.Sprintf("/v1/group_templates/%s/versions/%s", url.PathEscape(templateID), url.PathEscape(version)), &res)
+	if err != nil {
+		return res, "", err
+	}
+	return res, resp.Header.Get("Etag"), nil
+}
+
+func (r *accessGroupTemplateRepository) CreateVersion(templateID string, template AccessGroupTemplate) (AccessGroupTemplate, error) {
+	res := AccessGroupTemplate{}
+	_, err := r.client.Post(fmt.Sprintf("/v1/group_templates/%s/versions", url.PathEscape(templateID)), &template, &res)
+	return res, err
+}
+
+func (r *accessGroupTemplateRepository) Update(templateID, version string, template AccessGroupTemplate, etag string) (AccessGroupTemplate, error) {
+	res := AccessGroupTemplate{}
+	header := map[string]string{"If-Match": etag}
+	_, err := r.client.Put(fmt.Sprintf("/v1/group_templates/%s/versions/%s", url.PathEscape(templateID), url.PathEscape(version)), &template, &res, header)
+	return res, err
+}
+
+//Commit makes a template version immutable so that it can be assigned
+func (r *accessGroupTemplateRepository) Commit(templateID, version, etag string) error {
+	header := map[string]string{"If-Match": etag}
+	_, err := r.client.Post(fmt.Sprintf("/v1/group_templates/%s/versions/%s/commit", url.PathEscape(templateID), url.PathEscape(version)), nil, nil, header)
+	return err
+}
+
+func (r *accessGroupTemplateRepository) Delete(templateID string) error {
+	_, err := r.client.Delete(fmt.Sprintf("/v1/group_templates/%s", url.PathEscape(templateID)))
+	return err
+}
+
+func (r *accessGroupTemplateRepository) Assign(request TemplateAssignmentRequest) (TemplateAssignment, error) {
+	res := TemplateAssignment{}
+	_, err := r.client.Post("/v1/group_assignments", &request, &res)
+	return res, err
+}
+
+func (r *accessGroupTemplateRepository) GetAssignment(assignmentID string) (TemplateAssignment, error) {
+	res := TemplateAssignment{}
+	_, err := r.client.Get(fmt.Sprintf("/v1/group_assignments/%s", url.PathEscape(assignmentID)), &res)
+	return res, err
+}
+
+func (r *accessGroupTemplateRepository) ListAssignments(accountID, templateID string) ([]TemplateAssignment, error) {
+	var assignments []TemplateAssignment
+	path := fmt.Sprintf("/v1/group_assignments?account_id=%s", url.QueryEscape(accountID))
+	if templateID != "" {
+		path += "&template_id=" + url.QueryEscape(templateID)
+	}
+	_, err := r.client.GetPaginated(path, NewPaginatedResourcesHandler(&templateAssignments{}), func(v interface{}) bool {
+		assignments = append(assignments, v.(TemplateAssignment))
+		return true
+	})
+	if err != nil {
+		return []TemplateAssignment{}, err
+	}
+	return assignments, nil
+}
+
+//DeleteAssignment removes the access group from the assigned accounts
+func (r *accessGroupTemplateRepository) DeleteAssignment(assignmentID string) error {
+	_, err := r.client.Delete(fmt.Sprintf("/v1/group_assignments/%s", url.PathEscape(assignmentID)))
+	return err
+}
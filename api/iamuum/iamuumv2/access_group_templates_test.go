@@ -0,0 +1,179 @@
+package iamuumv2
+
+import (
+	"log"
+	"net/http"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/client"
+	"github.com/IBM-Cloud/bluemix-go/session"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("AccessGroupTemplateRepository", func() {
+	var (
+		server *ghttp.Server
+	)
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("List()", func() {
+		Context("When templates are returned across pages", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v1/group_templates", "account_id=abc"),
+						ghttp.RespondWith(http.StatusOK, `{
+							"limit": 1,
+							"offset": 0,
+							"total_count": 2,
+							"next": {"href": "https://iam.cloud.ibm.com/v1/group_templates?account_id=abc&offset=1"},
+							"group_templates": [{"id": "AccessGroupTemplateId-1", "name": "admins", "account_id": "abc", "version": "1"}]
+						}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v1/group_templates", "account_id=abc&offset=1"),
+						ghttp.RespondWith(http.StatusOK, `{
+							"limit": 1,
+							"offset": 1,
+							"total_count": 2,
+							"group_templates": [{"id": "AccessGroupTemplateId-2", "name": "viewers", "account_id": "abc", "version": "3", "committed": true}]
+						}`),
+					),
+				)
+			})
+
+			It("should return all templates", func() {
+				templates, err := newTestAccessGroupTemplateRepo(server.URL()).List("abc")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(templates).Should(HaveLen(2))
+				Expect(templates[1].Name).Should(Equal("viewers"))
+				Expect(templates[1].Committed).Should(BeTrue())
+			})
+		})
+	})
+
+	Describe("Get()", func() {
+		Context("When template version exists", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v1/group_templates/AccessGroupTemplateId-1/versions/2"),
+						ghttp.RespondWith(http.StatusOK, `{
+							"id": "AccessGroupTemplateId-1",
+							"name": "admins",
+							"account_id": "abc",
+							"version": "2",
+							"group": {"name": "admins", "members": {"users": ["IBMid-123"]}},
+							"policy_template_references": [{"id": "policyTemplate-1", "version": "1"}]
+						}`, http.Header{"Etag": []string{"W/\"abc-123\""}}),
+					),
+				)
+			})
+
+			It("should return the template and its etag", func() {
+				template, etag, err := newTestAccessGroupTemplateRepo(server.URL()).Get("AccessGroupTemplateId-1", "2")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(etag).Should(Equal("W/\"abc-123\""))
+				Expect(template.Group.Members.Users).Should(ConsistOf("IBMid-123"))
+				Expect(template.PolicyTemplateReferences).Should(HaveLen(1))
+			})
+		})
+	})
+
+	Describe("Commit()", func() {
+		Context("When commit is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v1/group_templates/AccessGroupTemplateId-1/versions/2/commit"),
+						ghttp.VerifyHeaderKV("If-Match", "W/\"abc-123\""),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("should not return error", func() {
+				err := newTestAccessGroupTemplateRepo(server.URL()).Commit("AccessGroupTemplateId-1", "2", "W/\"abc-123\"")
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("Assign()", func() {
+		Context("When assignment is accepted", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v1/group_assignments"),
+						ghttp.VerifyJSON(`{"template_id":"AccessGroupTemplateId-1","template_version":"2","target_type":"Account","target":"child-1"}`),
+						ghttp.RespondWith(http.StatusAccepted, `{
+							"id": "TemplateAssignment-1",
+							"account_id": "abc",
+							"template_id": "AccessGroupTemplateId-1",
+							"template_version": "2",
+							"target_type": "Account",
+							"target": "child-1",
+							"operation": "assign",
+							"status": "accepted"
+						}`),
+					),
+				)
+			})
+
+			It("should return the assignment", func() {
+				assignment, err := newTestAccessGroupTemplateRepo(server.URL()).Assign(TemplateAssignmentRequest{
+					TemplateID:      "AccessGroupTemplateId-1",
+					TemplateVersion: "2",
+					TargetType:      AssignmentTargetAccount,
+					Target:          "child-1",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(assignment.ID).Should(Equal("TemplateAssignment-1"))
+				Expect(assignment.Status).Should(Equal("accepted"))
+			})
+		})
+
+		Context("When template version is not committed", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v1/group_assignments"),
+						ghttp.RespondWith(http.StatusBadRequest, `{
+							"errors": [{"code": "template_not_committed", "message": "Template version is not committed"}]
+						}`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				_, err := newTestAccessGroupTemplateRepo(server.URL()).Assign(TemplateAssignmentRequest{TemplateID: "AccessGroupTemplateId-1", TemplateVersion: "3"})
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).Should(ContainSubstring("400"))
+			})
+		})
+	})
+})
+
+func newTestAccessGroupTemplateRepo(url string) AccessGroupTemplateRepository {
+	sess, err := session.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+	conf := sess.Config.Copy()
+	conf.Endpoint = &url
+	client := client.Client{
+		Config:      conf,
+		ServiceName: bluemix.IAMUUMServicev2,
+	}
+	return NewAccessGroupTemplateRepository(&client)
+}
@@ -16,6 +16,7 @@ type IAMUUMServiceAPIv2 interface {
 	AccessGroup() AccessGroupRepository
 	AccessGroupMember() AccessGroupMemberRepositoryV2
 	DynamicRule() DynamicRuleRepository
+	AccessGroupTemplate() AccessGroupTemplateRepository
 }
 
 //ErrCodeAPICreation ...
@@ -79,3 +80,8 @@ func (a *iamuumService) AccessGroupMember() AccessGroupMemberRepositoryV2 {
 func (a *iamuumService) DynamicRule() DynamicRuleRepository {
 	return NewDynamicRuleRepository(a.Client)
 }
+
+// AccessGroupTemplate API
+func (a *iamuumService) AccessGroupTemplate() AccessGroupTemplateRepository {
+	return NewAccessGroupTemplateRepository(a.Client)
+}
@@ -24,6 +24,7 @@ type ICDServiceAPI interface {
 	Connections() Connections
 	AutoScaling() AutoScaling
 	Configurations() Configurations
+	PostgreSQL() PostgreSQL
 }
 
 //ICDService holds the client
@@ -107,3 +108,8 @@ func (c *icdService) Connections() Connections {
 func (c *icdService) AutoScaling() AutoScaling {
 	return newAutoScalingAPI(c.Client)
 }
+
+//PostgreSQL implements PostgreSQL replication and extensions API
+func (c *icdService) PostgreSQL() PostgreSQL {
+	return newPostgreSQLAPI(c.Client)
+}
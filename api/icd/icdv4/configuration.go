@@ -1,6 +1,7 @@
 package icdv4
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM-Cloud/bluemix-go/client"
@@ -13,6 +14,8 @@ type ConfigurationReq struct {
 
 type Configurations interface {
 	UpdateConfiguration(icdId string, configurationReq ConfigurationReq) (Task, error)
+	//UpdateConfigurationAndWait waits for the task applying the configuration to complete
+	UpdateConfigurationAndWait(ctx context.Context, icdId string, configurationReq ConfigurationReq) (Task, error)
 	GetConfiguration(icdId string) (interface{}, error)
 }
 
@@ -45,3 +48,8 @@ func (r *configurations) GetConfiguration(icdId string) (interface{}, error) {
 	}
 	return taskResult, nil
 }
+
+func (r *configurations) UpdateConfigurationAndWait(ctx context.Context, icdId string, configurationReq ConfigurationReq) (Task, error) {
+	task, err := r.UpdateConfiguration(icdId, configurationReq)
+	return waitForTaskResult(ctx, r.client, task, err)
+}
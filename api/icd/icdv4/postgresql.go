@@ -0,0 +1,126 @@
+package icdv4
+
+import (
+	"fmt"
+
+	"github.com/IBM-Cloud/bluemix-go/client"
+	"github.com/IBM-Cloud/bluemix-go/utils"
+)
+
+//Logical replication output plugins
+const (
+	ReplicationPluginWal2JSON = "wal2json"
+	ReplicationPluginPgOutput = "pgoutput"
+)
+
+// LogicalReplicationSlot is read by a CDC client, e.g. Debezium, to stream the
+// changes of a database. The wal_level configuration of the deployment must be
+// set to logical before creating a slot.
+type LogicalReplicationSlot struct {
+	Name         string `json:"name"`
+	DatabaseName string `json:"database_name"`
+	PluginType   string `json:"plugin_type"`
+}
+
+type LogicalReplicationSlotReq struct {
+	LogicalReplicationSlot LogicalReplicationSlot `json:"logical_replication_slot"`
+}
+
+type LogicalReplicationSlots struct {
+	LogicalReplicationSlots []LogicalReplicationSlot `json:"logical_replication_slots"`
+}
+
+type Extension struct {
+	Name        string `json:"name"`
+	Version     string `json:"version,omitempty"`
+	Description string `json:"description,omitempty"`
+	Installed   bool   `json:"installed,omitempty"`
+}
+
+type ExtensionReq struct {
+	Extension Extension `json:"extension"`
+}
+
+type Extensions struct {
+	Extensions []Extension `json:"extensions"`
+}
+
+type PostgreSQL interface {
+	GetLogicalReplicationSlots(icdId string) ([]LogicalReplicationSlot, error)
+	CreateLogicalReplicationSlot(icdId string, slotReq LogicalReplicationSlotReq) (Task, error)
+	DeleteLogicalReplicationSlot(icdId string, slotName string) (Task, error)
+	GetExtensions(icdId string) ([]Extension, error)
+	CreateExtension(icdId string, extensionReq ExtensionReq) (Task, error)
+	DeleteExtension(icdId string, extensionName string) (Task, error)
+}
+
+type postgresql struct {
+	client *client.Client
+}
+
+func newPostgreSQLAPI(c *client.Client) PostgreSQL {
+	return &postgresql{
+		client: c,
+	}
+}
+
+func (r *postgresql) GetLogicalReplicationSlots(icdId string) ([]LogicalReplicationSlot, error) {
+	slots := LogicalReplicationSlots{}
+	rawURL := fmt.Sprintf("/v4/ibm/deployments/%s/postgresql/logical_replication_slots", utils.EscapeUrlParm(icdId))
+	_, err := r.client.Get(rawURL, &slots)
+	if err != nil {
+		return slots.LogicalReplicationSlots, err
+	}
+	return slots.LogicalReplicationSlots, nil
+}
+
+func (r *postgresql) CreateLogicalReplicationSlot(icdId string, slotReq LogicalReplicationSlotReq) (Task, error) {
+	taskResult := TaskResult{}
+	rawURL := fmt.Sprintf("/v4/ibm/deployments/%s/postgresql/logical_replication_slots", utils.EscapeUrlParm(icdId))
+	_, err := r.client.Post(rawURL, &slotReq, &taskResult)
+	if err != nil {
+		return taskResult.Task, err
+	}
+	return taskResult.Task, nil
+}
+
+func (r *postgresql) DeleteLogicalReplicationSlot(icdId string, slotName string) (Task, error) {
+	taskResult := TaskResult{}
+	rawURL := fmt.Sprintf("/v4/ibm/deployments/%s/postgresql/logical_replication_slots/%s", utils.EscapeUrlParm(icdId), utils.EscapeUrlParm(slotName))
+	_, err := r.client.DeleteWithResp(rawURL, &taskResult)
+	if err != nil {
+		return taskResult.Task, err
+	}
+	return taskResult.Task, nil
+}
+
+//GetExtensions returns the extensions supported by the deployment and whether they are installed
+func (r *postgresql) GetExtensions(icdId string) ([]Extension, error) {
+	extensions := Extensions{}
+	rawURL := fmt.Sprintf("/v4/ibm/deployments/%s/postgresql/extensions", utils.EscapeUrlParm(icdId))
+	_, err := r.client.Get(rawURL, &extensions)
+	if err != nil {
+		return extensions.Extensions, err
+	}
+	return extensions.Extensions, nil
+}
+
+func (r *postgresql) CreateExtension(icdId string, extensionReq ExtensionReq) (Task, error) {
+	taskResult := TaskResult{}
+	rawURL := fmt.Sprintf("/v4/ibm/deployments/%s/postgresql/extensions", utils.EscapeUrlParm(icdId))
+	_, err := r.client.Post(rawURL, &extensionReq, &taskResult)
+	if err != nil {
+		return taskResult.Task, err
+	}
+	return taskResult.Task, nil
+}
+
+func (r *postgresql) DeleteExtension(icdId string, extensionName string) (Task, error) {
+	taskResult := TaskResult{}
+	rawURL := fmt.Sprintf("/v4/ibm/deployments/%s/postgresql/extensions/%s", utils.EscapeUrlParm(icdId), utils.EscapeUrlParm(extensionName))
+	_, err := r.client.DeleteWithResp(rawURL, &taskResult)
+	if err != nil {
+		return taskResult.Task, err
+	}
+	return taskResult.Task, nil
+}
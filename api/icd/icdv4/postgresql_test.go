@@ -0,0 +1,144 @@
+package icdv4
+
+import (
+	"log"
+	"net/http"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/client"
+	bluemixHttp "github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/session"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PostgreSQL", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+	Describe("CreateLogicalReplicationSlot", func() {
+		Context("When creation is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v4/ibm/deployments/crn:v1:bluemix:public:databases-for-postgresql:us-south:a/4ea1882a2d3401ed1e459979941966ea:f3ad3a86-c1c4-4f27-a2cf-9f7ab9fe2df0::/postgresql/logical_replication_slots"),
+						ghttp.VerifyJSON(`{"logical_replication_slot":{"name":"debezium","database_name":"inventory","plugin_type":"wal2json"}}`),
+						ghttp.RespondWith(http.StatusAccepted, `
+                           {
+                            "task": {
+                              "id": "5abb6a7d11a1a5001479a0ad",
+                              "description": "Creating logical replication slot",
+                              "status": "running",
+                              "deployment_id": "59b14b19874a1c0018009482",
+                              "progress_percent": 5,
+                              "created_at": "2018-03-28T10:21:30Z"
+                            }
+                          }
+                        `),
+					),
+				)
+			})
+
+			It("should return the slot creation task", func() {
+				target := "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/4ea1882a2d3401ed1e459979941966ea:f3ad3a86-c1c4-4f27-a2cf-9f7ab9fe2df0::"
+				slotReq := LogicalReplicationSlotReq{
+					LogicalReplicationSlot: LogicalReplicationSlot{
+						Name:         "debezium",
+						DatabaseName: "inventory",
+						PluginType:   ReplicationPluginWal2JSON,
+					},
+				}
+				task, err := newPostgreSQL(server.URL()).CreateLogicalReplicationSlot(target, slotReq)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.Id).Should(Equal("5abb6a7d11a1a5001479a0ad"))
+				Expect(task.Status).Should(Equal("running"))
+			})
+		})
+		Context("When wal_level is not logical", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.SetAllowUnhandledRequests(true)
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v4/ibm/deployments/5abb6a7d11a1a5001479a0ac/postgresql/logical_replication_slots"),
+						ghttp.RespondWith(http.StatusUnprocessableEntity, `{"errors": "wal_level must be set to logical"}`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				_, err := newPostgreSQL(server.URL()).CreateLogicalReplicationSlot("5abb6a7d11a1a5001479a0ac", LogicalReplicationSlotReq{})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+	Describe("DeleteLogicalReplicationSlot", func() {
+		Context("When deletion is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, "/v4/ibm/deployments/5abb6a7d11a1a5001479a0ac/postgresql/logical_replication_slots/debezium"),
+						ghttp.RespondWith(http.StatusAccepted, `{"task": {"id": "5abb6a7d11a1a5001479a0ae", "status": "running"}}`),
+					),
+				)
+			})
+
+			It("should return the deletion task", func() {
+				task, err := newPostgreSQL(server.URL()).DeleteLogicalReplicationSlot("5abb6a7d11a1a5001479a0ac", "debezium")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.Id).Should(Equal("5abb6a7d11a1a5001479a0ae"))
+			})
+		})
+	})
+	Describe("GetExtensions", func() {
+		Context("When get is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v4/ibm/deployments/5abb6a7d11a1a5001479a0ac/postgresql/extensions"),
+						ghttp.RespondWith(http.StatusOK, `
+                           {
+                            "extensions": [
+                              {"name": "pg_stat_statements", "version": "1.8", "installed": true},
+                              {"name": "postgis", "version": "3.1.4"}
+                            ]
+                          }
+                        `),
+					),
+				)
+			})
+
+			It("should return the supported extensions", func() {
+				extensions, err := newPostgreSQL(server.URL()).GetExtensions("5abb6a7d11a1a5001479a0ac")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(extensions).Should(HaveLen(2))
+				Expect(extensions[0].Installed).Should(BeTrue())
+				Expect(extensions[1].Name).Should(Equal("postgis"))
+			})
+		})
+	})
+})
+
+func newPostgreSQL(url string) PostgreSQL {
+
+	sess, err := session.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+	conf := sess.Config.Copy()
+	conf.HTTPClient = bluemixHttp.NewHTTPClient(conf)
+	conf.Endpoint = &url
+
+	client := client.Client{
+		Config:      conf,
+		ServiceName: bluemix.ICDService,
+	}
+	return newPostgreSQLAPI(&client)
+}
@@ -1,6 +1,7 @@
 package icdv4
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM-Cloud/bluemix-go/client"
@@ -92,6 +93,8 @@ type Groups interface {
 	GetDefaultGroups(groupType string) (GroupList, error)
 	GetGroups(icdId string) (GroupList, error)
 	UpdateGroup(icdId string, groupId string, groupReq GroupReq) (Task, error)
+	//UpdateGroupAndWait waits for the task scaling the group to complete
+	UpdateGroupAndWait(ctx context.Context, icdId string, groupId string, groupReq GroupReq) (Task, error)
 }
 
 type groups struct {
@@ -133,3 +136,8 @@ func (r *groups) UpdateGroup(icdId string, groupId string, groupReq GroupReq) (T
 	}
 	return taskResult.Task, nil
 }
+
+func (r *groups) UpdateGroupAndWait(ctx context.Context, icdId string, groupId string, groupReq GroupReq) (Task, error) {
+	task, err := r.UpdateGroup(icdId, groupId, groupReq)
+	return waitForTaskResult(ctx, r.client, task, err)
+}
@@ -1,27 +1,39 @@
 package icdv4
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/client"
+	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/bluemix-go/utils"
 )
 
-// type TaskResult struct {
-//       Task Task `json:"task"`
-// }
+//Task statuses
+const (
+	TaskStatusQueued    = "queued"
+	TaskStatusRunning   = "running"
+	TaskStatusCompleted = "completed"
+	TaskStatusFailed    = "failed"
+)
 
-// type Task struct {
-//       Id string `json:"id"`
-//       Description string `json:"description"`
-//       Status string `json:"status"`
-//       DeploymentId string `json:"deployment_id"`
-//       ProgressPercent int `json:"progress_percent"`
-//       CreatedAt string `json:"created_at"`
+//ErrCodeTaskFailed ...
+const ErrCodeTaskFailed = "TaskFailed"
 
-// }
+var (
+	//taskPollInterval is the first delay between two task polls, it doubles up to taskMaxPollInterval
+	taskPollInterval    = 2 * time.Second
+	taskMaxPollInterval = 30 * time.Second
+)
 
+// Tasks is shared by all the operations returning a Task, e.g. scaling,
+// whitelist, user and configuration updates, to track the task to completion.
 type Tasks interface {
 	GetTask(taskId string) (Task, error)
+	WaitForTask(ctx context.Context, taskId string) (Task, error)
 }
 
 type tasks struct {
@@ -43,3 +55,43 @@ func (r *tasks) GetTask(taskId string) (Task, error) {
 	}
 	return taskResult.Task, nil
 }
+
+// WaitForTask polls the task with an exponential backoff until it completes,
+// fails or ctx is done. Tasks are removed once finished, so a task which is
+// no longer found after it was seen is considered completed. A task which is
+// never found, e.g. because of a mistyped ID, returns the not found error.
+func (r *tasks) WaitForTask(ctx context.Context, taskId string) (Task, error) {
+	backoff := helpers.Backoff{InitialDelay: taskPollInterval, MaxDelay: taskMaxPollInterval}
+	var task Task
+	seen := false
+	err := helpers.PollUntil(ctx, backoff, func() (bool, error) {
+		var err error
+		task, err = r.GetTask(taskId)
+		if err != nil {
+			if rf, ok := err.(bmxerror.RequestFailure); ok && rf.StatusCode() == http.StatusNotFound && seen {
+				task = Task{Id: taskId, Status: TaskStatusCompleted, ProgressPercent: 100}
+				return true, nil
+			}
+			return false, err
+		}
+		seen = true
+		switch task.Status {
+		case TaskStatusCompleted, "":
+			return true, nil
+		case TaskStatusFailed:
+			return false, bmxerror.New(ErrCodeTaskFailed,
+				fmt.Sprintf("Task %s (%s) failed for deployment %s", taskId, task.Description, task.DeploymentId))
+		}
+		return false, nil
+	})
+	return task, err
+}
+
+//waitForTaskResult waits for the task returned by a mutation, the error of the
+//mutation is returned as is
+func waitForTaskResult(ctx context.Context, c *client.Client, task Task, err error) (Task, error) {
+	if err != nil || task.Id == "" {
+		return task, err
+	}
+	return newTaskAPI(c).WaitForTask(ctx, task.Id)
+}
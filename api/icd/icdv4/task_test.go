@@ -1,10 +1,13 @@
 package icdv4
 
 import (
+	"context"
 	"log"
 	"net/http"
+	"time"
 
 	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/client"
 	bluemixHttp "github.com/IBM-Cloud/bluemix-go/http"
 	"github.com/IBM-Cloud/bluemix-go/session"
@@ -76,6 +79,107 @@ var _ = Describe("Tasks", func() {
 			})
 		})
 	})
+	Describe("WaitForTask", func() {
+		BeforeEach(func() {
+			taskPollInterval = time.Millisecond
+		})
+		AfterEach(func() {
+			taskPollInterval = 2 * time.Second
+		})
+		Context("When the task completes", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v4/ibm/tasks/5abb6a7d11a1a5001479a0ac"),
+						ghttp.RespondWith(http.StatusOK, `{"task": {"id": "5abb6a7d11a1a5001479a0ac", "status": "running", "progress_percent": 5}}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v4/ibm/tasks/5abb6a7d11a1a5001479a0ac"),
+						ghttp.RespondWith(http.StatusOK, `{"task": {"id": "5abb6a7d11a1a5001479a0ac", "status": "completed", "progress_percent": 100}}`),
+					),
+				)
+			})
+
+			It("should poll until the task is completed", func() {
+				task, err := newTask(server.URL()).WaitForTask(context.Background(), "5abb6a7d11a1a5001479a0ac")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.Status).Should(Equal(TaskStatusCompleted))
+				Expect(server.ReceivedRequests()).Should(HaveLen(2))
+			})
+		})
+		Context("When the task is no longer found", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v4/ibm/tasks/5abb6a7d11a1a5001479a0ac"),
+						ghttp.RespondWith(http.StatusOK, `{"task": {"id": "5abb6a7d11a1a5001479a0ac", "status": "running", "progress_percent": 80}}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v4/ibm/tasks/5abb6a7d11a1a5001479a0ac"),
+						ghttp.RespondWith(http.StatusNotFound, `{"errors": "Not Found"}`),
+					),
+				)
+			})
+
+			It("should consider the task completed", func() {
+				task, err := newTask(server.URL()).WaitForTask(context.Background(), "5abb6a7d11a1a5001479a0ac")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.Status).Should(Equal(TaskStatusCompleted))
+			})
+		})
+		Context("When the task is never found", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v4/ibm/tasks/mistyped"),
+						ghttp.RespondWith(http.StatusNotFound, `{"errors": "Not Found"}`),
+					),
+				)
+			})
+
+			It("should return the not found error", func() {
+				_, err := newTask(server.URL()).WaitForTask(context.Background(), "mistyped")
+				Expect(err).To(HaveOccurred())
+				Expect(err.(bmxerror.RequestFailure).StatusCode()).Should(Equal(http.StatusNotFound))
+			})
+		})
+		Context("When the task fails", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v4/ibm/tasks/5abb6a7d11a1a5001479a0ac"),
+						ghttp.RespondWith(http.StatusOK, `{"task": {"id": "5abb6a7d11a1a5001479a0ac", "description": "Scaling database deployment", "status": "failed"}}`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				_, err := newTask(server.URL()).WaitForTask(context.Background(), "5abb6a7d11a1a5001479a0ac")
+				Expect(err).To(HaveOccurred())
+				Expect(err.(bmxerror.Error).Code()).Should(Equal(ErrCodeTaskFailed))
+			})
+		})
+		Context("When the context is cancelled", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.RouteToHandler(http.MethodGet, "/v4/ibm/tasks/5abb6a7d11a1a5001479a0ac",
+					ghttp.RespondWith(http.StatusOK, `{"task": {"id": "5abb6a7d11a1a5001479a0ac", "status": "running"}}`),
+				)
+			})
+
+			It("should stop waiting", func() {
+				ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+				defer cancel()
+				task, err := newTask(server.URL()).WaitForTask(ctx, "5abb6a7d11a1a5001479a0ac")
+				Expect(err).Should(Equal(context.DeadlineExceeded))
+				Expect(task.Status).Should(Equal(TaskStatusRunning))
+			})
+		})
+	})
 })
 
 func newTask(url string) Tasks {
@@ -1,6 +1,7 @@
 package icdv4
 
 import (
+	"context"
 	"fmt"
 	"github.com/IBM-Cloud/bluemix-go/client"
 	"github.com/IBM-Cloud/bluemix-go/utils"
@@ -32,6 +33,11 @@ type Users interface {
 	CreateUser(icdId string, userReq UserReq) (Task, error)
 	UpdateUser(icdId string, userName string, userReq UserReq) (Task, error)
 	DeleteUser(icdId string, userName string) (Task, error)
+	//CreateUserAndWait, UpdateUserAndWait and DeleteUserAndWait wait for the
+	//task of the operation to complete
+	CreateUserAndWait(ctx context.Context, icdId string, userReq UserReq) (Task, error)
+	UpdateUserAndWait(ctx context.Context, icdId string, userName string, userReq UserReq) (Task, error)
+	DeleteUserAndWait(ctx context.Context, icdId string, userName string) (Task, error)
 }
 
 type users struct {
@@ -73,3 +79,18 @@ func (r *users) DeleteUser(icdId string, userName string) (Task, error) {
 	}
 	return taskResult.Task, nil
 }
+
+func (r *users) CreateUserAndWait(ctx context.Context, icdId string, userReq UserReq) (Task, error) {
+	task, err := r.CreateUser(icdId, userReq)
+	return waitForTaskResult(ctx, r.client, task, err)
+}
+
+func (r *users) UpdateUserAndWait(ctx context.Context, icdId string, userName string, userReq UserReq) (Task, error) {
+	task, err := r.UpdateUser(icdId, userName, userReq)
+	return waitForTaskResult(ctx, r.client, task, err)
+}
+
+func (r *users) DeleteUserAndWait(ctx context.Context, icdId string, userName string) (Task, error) {
+	task, err := r.DeleteUser(icdId, userName)
+	return waitForTaskResult(ctx, r.client, task, err)
+}
@@ -1,8 +1,10 @@
 package icdv4
 
 import (
+	"context"
 	"log"
 	"net/http"
+	"time"
 
 	bluemix "github.com/IBM-Cloud/bluemix-go"
 	"github.com/IBM-Cloud/bluemix-go/client"
@@ -20,6 +22,53 @@ var _ = Describe("Users", func() {
 	AfterEach(func() {
 		server.Close()
 	})
+	Describe("CreateUserAndWait", func() {
+		BeforeEach(func() {
+			taskPollInterval = time.Millisecond
+		})
+		AfterEach(func() {
+			taskPollInterval = 2 * time.Second
+		})
+		Context("When the user is created", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v4/ibm/deployments/icd1/users"),
+						ghttp.RespondWith(http.StatusCreated, `{"task": {"id": "task1", "status": "running"}}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v4/ibm/tasks/task1"),
+						ghttp.RespondWith(http.StatusOK, `{"task": {"id": "task1", "status": "completed", "progress_percent": 100}}`),
+					),
+				)
+			})
+
+			It("should wait for the task to complete", func() {
+				task, err := newUser(server.URL()).CreateUserAndWait(context.Background(), "icd1", UserReq{User: User{UserName: "admin", Password: "password"}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.Status).Should(Equal(TaskStatusCompleted))
+				Expect(server.ReceivedRequests()).Should(HaveLen(2))
+			})
+		})
+		Context("When the creation fails", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v4/ibm/deployments/icd1/users"),
+						ghttp.RespondWith(http.StatusInternalServerError, `Failed to create user`),
+					),
+				)
+			})
+
+			It("should not wait", func() {
+				_, err := newUser(server.URL()).CreateUserAndWait(context.Background(), "icd1", UserReq{})
+				Expect(err).To(HaveOccurred())
+				Expect(server.ReceivedRequests()).Should(HaveLen(1))
+			})
+		})
+	})
 	Describe("Create", func() {
 		Context("When creation is successful", func() {
 			BeforeEach(func() {
@@ -1,6 +1,7 @@
 package icdv4
 
 import (
+	"context"
 	"fmt"
 	"github.com/IBM-Cloud/bluemix-go/client"
 	"github.com/IBM-Cloud/bluemix-go/utils"
@@ -23,6 +24,10 @@ type Whitelists interface {
 	CreateWhitelist(icdId string, whitelistReq WhitelistReq) (Task, error)
 	GetWhitelist(icdId string) (Whitelist, error)
 	DeleteWhitelist(icdId string, ipAddress string) (Task, error)
+	//CreateWhitelistAndWait and DeleteWhitelistAndWait wait for the task of
+	//the operation to complete
+	CreateWhitelistAndWait(ctx context.Context, icdId string, whitelistReq WhitelistReq) (Task, error)
+	DeleteWhitelistAndWait(ctx context.Context, icdId string, ipAddress string) (Task, error)
 }
 
 type whitelists struct {
@@ -64,3 +69,13 @@ func (r *whitelists) DeleteWhitelist(icdId string, ipAddress string) (Task, erro
 	}
 	return taskResult.Task, nil
 }
+
+func (r *whitelists) CreateWhitelistAndWait(ctx context.Context, icdId string, whitelistReq WhitelistReq) (Task, error) {
+	task, err := r.CreateWhitelist(icdId, whitelistReq)
+	return waitForTaskResult(ctx, r.client, task, err)
+}
+
+func (r *whitelists) DeleteWhitelistAndWait(ctx context.Context, icdId string, ipAddress string) (Task, error) {
+	task, err := r.DeleteWhitelist(icdId, ipAddress)
+	return waitForTaskResult(ctx, r.client, task, err)
+}
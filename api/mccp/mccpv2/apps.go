@@ -10,7 +10,6 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/client"
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/bluemix-go/rest"
-	"github.com/IBM-Cloud/bluemix-go/trace"
 )
 
 //AppState ...
@@ -495,7 +494,7 @@ func (r *app) WaitForAppStatus(waitForThisState, appGUID string, maxWaitTime tim
 	for {
 		select {
 		case <-timeout:
-			trace.Logger.Printf("Timed out while checking the app status for %q.  Waited for %q for the state to be %q", appGUID, maxWaitTime, waitForThisState)
+			r.client.Logger().Warn("Timed out while checking the app status", "app", appGUID, "waited", maxWaitTime, "state", waitForThisState)
 			return status, nil
 		case <-tick.C:
 			appFields, err := r.Get(appGUID)
@@ -503,7 +502,7 @@ func (r *app) WaitForAppStatus(waitForThisState, appGUID string, maxWaitTime tim
 				return "", err
 			}
 			status = appFields.Entity.PackageState
-			trace.Logger.Println("apps.Entity.PackageState  ===>>> ", status)
+			r.client.Logger().Debug("Checked the app package state", "app", appGUID, "state", status)
 			if status == waitForThisState || status == AppFailedState {
 				return status, nil
 			}
@@ -519,7 +518,7 @@ func (r *app) WaitForInstanceStatus(waitForThisState, appGUID string, maxWaitTim
 	for {
 		select {
 		case <-timeout:
-			trace.Logger.Printf("Timed out while checking the app status for %q. Waited for %q for the state to be %q", appGUID, maxWaitTime, waitForThisState)
+			r.client.Logger().Warn("Timed out while checking the app status", "app", appGUID, "waited", maxWaitTime, "state", waitForThisState)
 			return status, nil
 		case <-tick.C:
 			appStat, err := r.Stat(appGUID)
@@ -4,6 +4,7 @@ import (
 	gohttp "net/http"
 
 	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/api/globalsearch/globalsearchv2"
 	"github.com/IBM-Cloud/bluemix-go/authentication"
 	"github.com/IBM-Cloud/bluemix-go/client"
 	"github.com/IBM-Cloud/bluemix-go/http"
@@ -22,6 +23,7 @@ const ErrCodeAPICreation = "APICreationError"
 //resourceControllerService holds the client
 type resourceControllerService struct {
 	*client.Client
+	sess *session.Session
 }
 
 //New ...
@@ -59,10 +61,19 @@ func New(sess *session.Session) (ResourceControllerAPIV2, error) {
 	}
 	return &resourceControllerService{
 		Client: client.New(config, bluemix.ResourceControllerServicev2, tokenRefreher),
+		sess:   sess,
 	}, nil
 }
 
 //ResourceController API
 func (a *resourceControllerService) ResourceServiceInstanceV2() ResourceServiceInstanceRepository {
-	return newResourceServiceInstanceAPI(a.Client)
+	r := newResourceServiceInstanceAPI(a.Client).(*resourceServiceInstance)
+	r.newSearch = func() (globalsearchv2.Searches, error) {
+		search, err := globalsearchv2.New(a.sess)
+		if err != nil {
+			return nil, err
+		}
+		return search.Searches(), nil
+	}
+	return r
 }
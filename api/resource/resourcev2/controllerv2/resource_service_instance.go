@@ -1,10 +1,13 @@
 package controllerv2
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 
+	"github.com/IBM-Cloud/bluemix-go/api/globalsearch/globalsearchv2"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/client"
 	"github.com/IBM-Cloud/bluemix-go/models"
@@ -49,10 +52,14 @@ const ErrCodeResourceServiceInstanceDoesnotExist = "ResourceServiceInstanceDoesn
 type ResourceServiceInstanceRepository interface {
 	ListInstances(query ServiceInstanceQuery) ([]models.ServiceInstanceV2, error)
 	GetInstance(serviceInstanceID string) (models.ServiceInstanceV2, error)
+	//FindInstances returns the instances of the service, such as "cloudantnosqldb",
+	//carrying all the tags of tagFilters, in the resource group when its ID is not empty
+	FindInstances(service string, tagFilters []string, resourceGroupID string) ([]models.ServiceInstanceV2, error)
 }
 
 type resourceServiceInstance struct {
-	client *client.Client
+	client    *client.Client
+	newSearch func() (globalsearchv2.Searches, error)
 }
 
 func newResourceServiceInstanceAPI(c *client.Client) ResourceServiceInstanceRepository {
@@ -110,6 +117,67 @@ func (r *resourceServiceInstance) GetInstance(serviceInstanceID string) (models.
 	return instance, err
 }
 
+//FindInstances looks up the CRNs of the matching instances with global search
+//and returns the resource controller instances with those CRNs
+func (r *resourceServiceInstance) FindInstances(service string, tagFilters []string, resourceGroupID string) ([]models.ServiceInstanceV2, error) {
+	if service == "" {
+		return nil, errors.New("The service name is required to find instances")
+	}
+	if r.newSearch == nil {
+		return nil, errors.New("FindInstances requires a client created with New")
+	}
+	search, err := r.newSearch()
+	if err != nil {
+		return nil, err
+	}
+
+	terms := []string{"family:resource_controller", "type:resource-instance", "service_name:" + searchValue(service)}
+	if resourceGroupID != "" {
+		terms = append(terms, "resource_group_id:"+searchValue(resourceGroupID))
+	}
+	for _, tag := range tagFilters {
+		terms = append(terms, "tags:"+searchValue(tag))
+	}
+	body := globalsearchv2.SearchBody{
+		Query:  strings.Join(terms, " AND "),
+		Fields: []string{"crn"},
+	}
+	crns := map[string]bool{}
+	for {
+		result, err := search.PostQuery(body)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range result.Items {
+			crns[item.CRN] = true
+		}
+		if !result.MoreData || result.Token == "" {
+			break
+		}
+		body.Token = result.Token
+	}
+	if len(crns) == 0 {
+		return []models.ServiceInstanceV2{}, nil
+	}
+
+	instances, err := r.ListInstances(ServiceInstanceQuery{ResourceGroupID: resourceGroupID})
+	if err != nil {
+		return nil, err
+	}
+	found := []models.ServiceInstanceV2{}
+	for _, instance := range instances {
+		if crns[instance.Crn.String()] {
+			found = append(found, instance)
+		}
+	}
+	return found, nil
+}
+
+//searchValue quotes the value of a global search query term
+func searchValue(v string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(v) + `"`
+}
+
 func filterInstancesByName(instances []models.ServiceInstanceV2, name string) []models.ServiceInstanceV2 {
 	ret := []models.ServiceInstanceV2{}
 	for _, instance := range instances {
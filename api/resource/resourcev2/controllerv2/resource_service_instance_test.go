@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/api/globalsearch/globalsearchv2"
 
 	"github.com/IBM-Cloud/bluemix-go/client"
 	"github.com/IBM-Cloud/bluemix-go/session"
@@ -213,6 +214,106 @@ var _ = Describe("ServiceInstances", func() {
 
 	})
 
+	Describe("FindInstances()", func() {
+		var searchServer *ghttp.Server
+		BeforeEach(func() {
+			server = ghttp.NewServer()
+			searchServer = ghttp.NewServer()
+		})
+		AfterEach(func() {
+			searchServer.Close()
+		})
+		newFindRepo := func() ResourceServiceInstanceRepository {
+			repo := newTestServiceInstanceRepo(server.URL()).(*resourceServiceInstance)
+			repo.newSearch = func() (globalsearchv2.Searches, error) {
+				endpoint := searchServer.URL()
+				sess, err := session.New(&bluemix.Config{
+					Endpoint:        &endpoint,
+					IAMAccessToken:  "Bearer token",
+					IAMRefreshToken: "refresh",
+				})
+				if err != nil {
+					return nil, err
+				}
+				search, err := globalsearchv2.New(sess)
+				if err != nil {
+					return nil, err
+				}
+				return search.Searches(), nil
+			}
+			return repo
+		}
+
+		Context("When instances match the tags", func() {
+			BeforeEach(func() {
+				searchServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v2/resources/search"),
+						ghttp.VerifyJSON(`{"query":"family:resource_controller AND type:resource-instance AND service_name:\"cloudantnosqldb\" AND resource_group_id:\"rg1\" AND tags:\"env:prod\"","fields":["crn"]}`),
+						ghttp.RespondWith(http.StatusOK, `{"items":[{"crn":"crn:v1:bluemix:public:cloudantnosqldb:us-south:a/acc:i1::"}],"more_data":true,"token":"t1"}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v2/resources/search"),
+						ghttp.VerifyJSON(`{"query":"family:resource_controller AND type:resource-instance AND service_name:\"cloudantnosqldb\" AND resource_group_id:\"rg1\" AND tags:\"env:prod\"","fields":["crn"],"token":"t1"}`),
+						ghttp.RespondWith(http.StatusOK, `{"items":[{"crn":"crn:v1:bluemix:public:cloudantnosqldb:us-south:a/acc:i3::"}],"more_data":false}`),
+					),
+				)
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/resource_instances"),
+						func(w http.ResponseWriter, r *http.Request) {
+							Expect(r.URL.Query().Get("resource_group_id")).To(Equal("rg1"))
+						},
+						ghttp.RespondWith(http.StatusOK, `{"rows_count":3,"resources":[
+							{"id":"i1","name":"db1","crn":"crn:v1:bluemix:public:cloudantnosqldb:us-south:a/acc:i1::"},
+							{"id":"i2","name":"db2","crn":"crn:v1:bluemix:public:cloudantnosqldb:us-south:a/acc:i2::"},
+							{"id":"i3","name":"db3","crn":"crn:v1:bluemix:public:cloudantnosqldb:us-south:a/acc:i3::"}
+						]}`),
+					),
+				)
+			})
+
+			It("should return the instances found by the search", func() {
+				instances, err := newFindRepo().FindInstances("cloudantnosqldb", []string{"env:prod"}, "rg1")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(instances).To(HaveLen(2))
+				Expect(instances[0].Name).To(Equal("db1"))
+				Expect(instances[1].Name).To(Equal("db3"))
+			})
+		})
+		Context("When no instance matches", func() {
+			BeforeEach(func() {
+				searchServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v2/resources/search"),
+						ghttp.RespondWith(http.StatusOK, `{"items":[]}`),
+					),
+				)
+			})
+
+			It("should not list the instances", func() {
+				instances, err := newFindRepo().FindInstances("cloudantnosqldb", nil, "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(instances).To(BeEmpty())
+				Expect(server.ReceivedRequests()).To(BeEmpty())
+			})
+		})
+		Context("When the search fails", func() {
+			BeforeEach(func() {
+				searchServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v2/resources/search"),
+						ghttp.RespondWith(http.StatusBadRequest, `{"error":"invalid query"}`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				_, err := newFindRepo().FindInstances("cloudantnosqldb", nil, "")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
 })
 
 func newTestServiceInstanceRepo(url string) ResourceServiceInstanceRepository {
@@ -0,0 +1,149 @@
+package schematics
+
+import (
+	"fmt"
+
+	"github.com/IBM-Cloud/bluemix-go/client"
+)
+
+//AgentInfrastructure is the private infrastructure the agent is deployed to
+type AgentInfrastructure struct {
+	InfraType            string `json:"infra_type"`
+	ClusterID            string `json:"cluster_id"`
+	ClusterResourceGroup string `json:"cluster_resource_group"`
+	CosInstanceName      string `json:"cos_instance_name"`
+	CosBucketName        string `json:"cos_bucket_name"`
+	CosBucketRegion      string `json:"cos_bucket_region"`
+}
+
+type AgentMetadata struct {
+	Name  string   `json:"name"`
+	Value []string `json:"value"`
+}
+
+type AgentPayload struct {
+	Name                string              `json:"name"`
+	ResourceGroup       string              `json:"resource_group"`
+	Version             string              `json:"version"`
+	SchematicsLocation  string              `json:"schematics_location"`
+	AgentLocation       string              `json:"agent_location"`
+	Description         string              `json:"description,omitempty"`
+	Tags                []string            `json:"tags,omitempty"`
+	AgentInfrastructure AgentInfrastructure `json:"agent_infrastructure"`
+	AgentMetadata       []AgentMetadata     `json:"agent_metadata,omitempty"`
+}
+
+//AgentJob is the last deploy or health job run against the agent
+type AgentJob struct {
+	JobID         string `json:"job_id"`
+	StatusCode    string `json:"status_code"`
+	StatusMessage string `json:"status_message"`
+	LogURL        string `json:"log_url"`
+	UpdatedAt     string `json:"updated_at"`
+}
+
+type AgentState struct {
+	State        string `json:"state"`
+	SetAt        string `json:"set_at"`
+	SetBy        string `json:"set_by"`
+	StateMessage string `json:"state_message"`
+}
+
+type AgentConfig struct {
+	AgentPayload
+	ID              string      `json:"id"`
+	CRN             string      `json:"agent_crn"`
+	UserState       AgentState  `json:"user_state"`
+	RecentDeployJob AgentJob    `json:"recent_deploy_job"`
+	RecentHealthJob AgentJob    `json:"recent_health_job"`
+	RecentPrsJob    AgentJob    `json:"recent_prs_job"`
+	CreatedAt       string      `json:"created_at"`
+	CreatedBy       string      `json:"created_by"`
+	UpdatedAt       string      `json:"updated_at"`
+	UpdatedBy       string      `json:"updated_by"`
+	SystemState     AgentState  `json:"system_state"`
+	ConnectionState AgentState  `json:"connection_state"`
+	AgentKPI        interface{} `json:"agent_kpi,omitempty"`
+}
+
+type AgentList struct {
+	TotalCount int           `json:"total_count"`
+	Limit      int           `json:"limit"`
+	Offset     int           `json:"offset"`
+	Agents     []AgentConfig `json:"agents"`
+}
+
+type agent struct {
+	client *client.Client
+}
+
+type Agents interface {
+	CreateAgent(createReq AgentPayload) (AgentConfig, error)
+	GetAgentByID(agentID string) (AgentConfig, error)
+	ListAgents() ([]AgentConfig, error)
+	UpdateAgent(agentID string, updateReq AgentPayload) (AgentConfig, error)
+	DeleteAgent(agentID string) error
+	DeployAgent(agentID string) (AgentJob, error)
+	GetAgentDeployJob(agentID string) (AgentJob, error)
+	RunAgentHealthCheck(agentID string) (AgentJob, error)
+	GetAgentHealthJob(agentID string) (AgentJob, error)
+}
+
+func newAgentAPI(c *client.Client) Agents {
+	return &agent{
+		client: c,
+	}
+}
+
+//CreateAgent registers the agent, it must then be deployed with DeployAgent
+func (r *agent) CreateAgent(createReq AgentPayload) (AgentConfig, error) {
+	var successV AgentConfig
+	_, err := r.client.Post("/v2/agents", createReq, &successV)
+	return successV, err
+}
+func (r *agent) GetAgentByID(agentID string) (AgentConfig, error) {
+	var successV AgentConfig
+	_, err := r.client.Get(fmt.Sprintf("/v2/agents/%s", agentID), &successV)
+	return successV, err
+}
+func (r *agent) ListAgents() ([]AgentConfig, error) {
+	var successV AgentList
+	_, err := r.client.Get("/v2/agents", &successV)
+	if err != nil {
+		return nil, err
+	}
+	return successV.Agents, err
+}
+func (r *agent) UpdateAgent(agentID string, updateReq AgentPayload) (AgentConfig, error) {
+	var successV AgentConfig
+	_, err := r.client.Patch(fmt.Sprintf("/v2/agents/%s", agentID), updateReq, &successV)
+	return successV, err
+}
+func (r *agent) DeleteAgent(agentID string) error {
+	_, err := r.client.Delete(fmt.Sprintf("/v2/agents/%s", agentID))
+	return err
+}
+
+//DeployAgent installs the agent on the target cluster
+func (r *agent) DeployAgent(agentID string) (AgentJob, error) {
+	var successV AgentJob
+	_, err := r.client.Put(fmt.Sprintf("/v2/agents/%s/deploy", agentID), nil, &successV)
+	return successV, err
+}
+func (r *agent) GetAgentDeployJob(agentID string) (AgentJob, error) {
+	var successV AgentJob
+	_, err := r.client.Get(fmt.Sprintf("/v2/agents/%s/deploy", agentID), &successV)
+	return successV, err
+}
+
+//RunAgentHealthCheck checks the agent can reach Schematics and the target infrastructure
+func (r *agent) RunAgentHealthCheck(agentID string) (AgentJob, error) {
+	var successV AgentJob
+	_, err := r.client.Put(fmt.Sprintf("/v2/agents/%s/health", agentID), nil, &successV)
+	return successV, err
+}
+func (r *agent) GetAgentHealthJob(agentID string) (AgentJob, error) {
+	var successV AgentJob
+	_, err := r.client.Get(fmt.Sprintf("/v2/agents/%s/health", agentID), &successV)
+	return successV, err
+}
@@ -0,0 +1,156 @@
+package schematics
+
+import (
+	"log"
+	"net/http"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/client"
+	bluemixHttp "github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/session"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("agents", func() {
+	var server *ghttp.Server
+	AfterEach(func() {
+		server.Close()
+	})
+
+	//createagent
+	Describe("Create", func() {
+		Context("When create agent is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, "/v2/agents"),
+						ghttp.VerifyJSON(`{
+							"name": "private-agent",
+							"resource_group": "Default",
+							"version": "1.0.0",
+							"schematics_location": "us-south",
+							"agent_location": "us-south",
+							"agent_infrastructure": {
+								"infra_type": "ibm_kubernetes",
+								"cluster_id": "cgf4olsd0d6pdb8c1k80",
+								"cluster_resource_group": "Default",
+								"cos_instance_name": "agent-cos",
+								"cos_bucket_name": "agent-bucket",
+								"cos_bucket_region": "us-south"
+							}
+						}`),
+						ghttp.RespondWith(http.StatusCreated, `{
+							"id": "agent-123",
+							"name": "private-agent",
+							"agent_crn": "crn:v1:bluemix:public:schematics:us-south:a/4ea1882a2d3401ed1e459979941966ea::agent:agent-123",
+							"user_state": {"state": "enable"}
+						}`),
+					),
+				)
+			})
+
+			It("should return the registered agent", func() {
+				agent, err := newAgent(server.URL()).CreateAgent(AgentPayload{
+					Name:               "private-agent",
+					ResourceGroup:      "Default",
+					Version:            "1.0.0",
+					SchematicsLocation: "us-south",
+					AgentLocation:      "us-south",
+					AgentInfrastructure: AgentInfrastructure{
+						InfraType:            "ibm_kubernetes",
+						ClusterID:            "cgf4olsd0d6pdb8c1k80",
+						ClusterResourceGroup: "Default",
+						CosInstanceName:      "agent-cos",
+						CosBucketName:        "agent-bucket",
+						CosBucketRegion:      "us-south",
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(agent.ID).To(Equal("agent-123"))
+				Expect(agent.UserState.State).To(Equal("enable"))
+			})
+		})
+	})
+
+	//deployagent
+	Describe("Deploy", func() {
+		Context("When deploy agent is successful", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, "/v2/agents/agent-123/deploy"),
+						ghttp.RespondWith(http.StatusAccepted, `{"job_id": "job-1", "status_code": "job_pending"}`),
+					),
+				)
+			})
+
+			It("should return the deploy job", func() {
+				job, err := newAgent(server.URL()).DeployAgent("agent-123")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(job.JobID).To(Equal("job-1"))
+				Expect(job.StatusCode).To(Equal("job_pending"))
+			})
+		})
+	})
+
+	//agenthealth
+	Describe("Health", func() {
+		Context("When health job is returned", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/v2/agents/agent-123/health"),
+						ghttp.RespondWith(http.StatusOK, `{"job_id": "job-2", "status_code": "job_finished", "status_message": "agent is healthy"}`),
+					),
+				)
+			})
+
+			It("should return the health job", func() {
+				job, err := newAgent(server.URL()).GetAgentHealthJob("agent-123")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(job.StatusCode).To(Equal("job_finished"))
+			})
+		})
+		Context("When agent is not found", func() {
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, "/v2/agents/agent-404/health"),
+						ghttp.RespondWith(http.StatusNotFound, `{"message": "agent not found"}`),
+					),
+				)
+			})
+
+			It("should return error", func() {
+				_, err := newAgent(server.URL()).RunAgentHealthCheck("agent-404")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+})
+
+func newAgent(url string) Agents {
+
+	sess, err := session.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+	conf := sess.Config.Copy()
+	conf.HTTPClient = bluemixHttp.NewHTTPClient(conf)
+	conf.Endpoint = &url
+
+	client := client.Client{
+		Config:      conf,
+		ServiceName: bluemix.SchematicsService,
+	}
+	return newAgentAPI(&client)
+}
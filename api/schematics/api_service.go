@@ -17,6 +17,7 @@ const ErrCodeAPICreation = "APICreationError"
 //SchematicsServiceAPI is the Aramda K8s client ...
 type SchematicsServiceAPI interface {
 	Workspaces() Workspaces
+	Agents() Agents
 
 	//TODO Add other services
 }
@@ -69,3 +70,8 @@ func New(sess *session.Session) (SchematicsServiceAPI, error) {
 func (c scService) Workspaces() Workspaces {
 	return newWorkspaceAPI(c.Client)
 }
+
+//Agents implements Agents API
+func (c scService) Agents() Agents {
+	return newAgentAPI(c.Client)
+}
@@ -2,9 +2,12 @@ package authentication
 
 import (
 	"errors"
+	"net/http"
 
 	bluemix "github.com/IBM-Cloud/bluemix-go"
 	"github.com/IBM-Cloud/bluemix-go/client"
+	bluemixHttp "github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/rest"
 )
 
 const (
@@ -22,5 +25,37 @@ func PopulateTokens(tokenProvider client.TokenProvider, c *bluemix.Config) error
 		err := tokenProvider.AuthenticateAPIKey(c.BluemixAPIKey)
 		return err
 	}
-	return errors.New("Insufficient credentials, need IBMID/IBMIDPassword or IBM Cloud API Key or IAM/IAM refresh tokens")
+	if iam, ok := tokenProvider.(*IAMAuthRepository); ok && c.IAMTokenRefresher != nil {
+		_, err := iam.RefreshToken()
+		return err
+	}
+	if c.TrustedProfileAuth() {
+		if tp, ok := tokenProvider.(client.TrustedProfileTokenProvider); ok {
+			return tp.AuthenticateTrustedProfile()
+		}
+	}
+	return errors.New("Insufficient credentials, need IBMID/IBMIDPassword or IBM Cloud API Key or IAM trusted profile or IAM/IAM refresh tokens")
+}
+
+//applyConfigHeaders adds the default headers of the config to the token
+//provider client, the headers already set on the client are kept, and the
+//UserAgentSuffix of the config to its User-Agent
+func applyConfigHeaders(config *bluemix.Config, client *rest.Client) {
+	if client == nil {
+		return
+	}
+	if config.UserAgentSuffix != "" && client.DefaultHeader.Get("User-Agent") == bluemixHttp.UserAgent() {
+		client.DefaultHeader.Set("User-Agent", bluemixHttp.ConfigUserAgent(config))
+	}
+	if len(config.DefaultHeaders) == 0 {
+		return
+	}
+	if client.DefaultHeader == nil {
+		client.DefaultHeader = http.Header{}
+	}
+	for k, v := range config.DefaultHeaders {
+		if client.DefaultHeader.Get(k) == "" {
+			client.DefaultHeader[http.CanonicalHeaderKey(k)] = append([]string(nil), v...)
+		}
+	}
 }
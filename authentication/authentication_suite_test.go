@@ -0,0 +1,13 @@
+package authentication
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestAuthentication(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Authentication Suite")
+}
@@ -0,0 +1,33 @@
+package authentication
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+//defaultCRTokenFiles are the service account tokens projected in IKS pods, by order of precedence
+var defaultCRTokenFiles = []string{"/var/run/secrets/tokens/vault-token", "/var/run/secrets/tokens/sa-token"}
+
+//readCRToken returns the compute resource token of the file, or of the first
+//default file found when file is empty. The file is read on every login since
+//the token is rotated.
+func readCRToken(file string) (string, error) {
+	files := defaultCRTokenFiles
+	if file != "" {
+		files = []string{file}
+	}
+	for _, f := range files {
+		raw, err := ioutil.ReadFile(f)
+		if err != nil {
+			if file != "" {
+				return "", fmt.Errorf("Couldn't read the compute resource token: %v", err)
+			}
+			continue
+		}
+		if token := strings.TrimSpace(string(raw)); token != "" {
+			return token, nil
+		}
+	}
+	return "", fmt.Errorf("No compute resource token found in %s", strings.Join(files, ", "))
+}
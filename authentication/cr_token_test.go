@@ -0,0 +1,85 @@
+package authentication
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Trusted profile authentication", func() {
+	var server *ghttp.Server
+	var dir string
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		var err error
+		dir, err = ioutil.TempDir("", "crtoken")
+		Expect(err).NotTo(HaveOccurred())
+	})
+	AfterEach(func() {
+		server.Close()
+		os.RemoveAll(dir)
+	})
+
+	Context("When the compute resource token file exists", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(filepath.Join(dir, "sa-token"), []byte("cr-token\n"), 0600)).To(Succeed())
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, "/identity/token"),
+					ghttp.VerifyForm(map[string][]string{
+						"grant_type": {"urn:ibm:params:oauth:grant-type:cr-token"},
+						"cr_token":   {"cr-token"},
+						"profile_id": {"Profile-1"},
+					}),
+					ghttp.RespondWith(http.StatusOK, `{"access_token": "new-access-token", "token_type": "Bearer"}`),
+				),
+			)
+		})
+
+		It("should log in with the trusted profile", func() {
+			config := &bluemix.Config{
+				IAMTrustedProfileID: "Profile-1",
+				CRTokenFile:         filepath.Join(dir, "sa-token"),
+			}
+			err := PopulateTokens(newIAMRepository(server.URL(), config), config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.IAMAccessToken).To(Equal("Bearer new-access-token"))
+		})
+	})
+	Context("When the compute resource token file is missing", func() {
+		It("should return an error without calling IAM", func() {
+			config := &bluemix.Config{
+				IAMTrustedProfileID: "Profile-1",
+				CRTokenFile:         filepath.Join(dir, "missing"),
+			}
+			err := newIAMRepository(server.URL(), config).AuthenticateTrustedProfile()
+			Expect(err).To(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+		})
+	})
+	Context("When no file is given", func() {
+		var saved []string
+		BeforeEach(func() {
+			saved = defaultCRTokenFiles
+			defaultCRTokenFiles = []string{filepath.Join(dir, "vault-token"), filepath.Join(dir, "sa-token")}
+			Expect(ioutil.WriteFile(filepath.Join(dir, "sa-token"), []byte("sa"), 0600)).To(Succeed())
+		})
+		AfterEach(func() {
+			defaultCRTokenFiles = saved
+		})
+
+		It("should read the first default file found", func() {
+			token, err := readCRToken("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(token).To(Equal("sa"))
+		})
+	})
+})
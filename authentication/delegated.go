@@ -0,0 +1,46 @@
+package authentication
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+)
+
+type delegatedTokenResponse struct {
+	DelegatedRefreshToken string `json:"delegated_refresh_token"`
+}
+
+//DelegatedRefreshToken exchanges the API key of the config for a delegated
+//refresh token, with which the receiver services, such as "kms" for Key
+//Protect or "hpcs", mint IAM tokens of the account on its behalf. A zero
+//expiry keeps the default validity set by IAM.
+func (auth *IAMAuthRepository) DelegatedRefreshToken(expiry time.Duration, receiverClientIDs ...string) (string, error) {
+	if auth.config.BluemixAPIKey == "" {
+		return "", bmxerror.New(bluemix.ErrInsufficientCredentials, "A delegated refresh token can only be requested with an IBM Cloud API Key")
+	}
+	if len(receiverClientIDs) == 0 {
+		return "", bmxerror.New(bluemix.ErrInvalidConfigurationCode, "A delegated refresh token needs the client IDs of its receivers")
+	}
+	data := map[string]string{
+		"grant_type":          "urn:ibm:params:oauth:grant-type:apikey",
+		"apikey":              auth.config.BluemixAPIKey,
+		"receiver_client_ids": strings.Join(receiverClientIDs, ","),
+	}
+	if expiry > 0 {
+		data["delegated_refresh_token_expiry"] = strconv.Itoa(int(expiry.Seconds()))
+	}
+	var tokens delegatedTokenResponse
+	err := retryTokenRequest(auth.context(), auth.config.Logger, func() error {
+		return auth.postToken("delegated_refresh_token", data, &tokens)
+	})
+	if err != nil {
+		return "", err
+	}
+	if tokens.DelegatedRefreshToken == "" {
+		return "", bmxerror.New(ErrCodeInvalidToken, "IAM returned no delegated refresh token")
+	}
+	return tokens.DelegatedRefreshToken, nil
+}
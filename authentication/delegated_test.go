@@ -0,0 +1,57 @@
+package authentication
+
+import (
+	"net/http"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Delegated refresh token", func() {
+	var server *ghttp.Server
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should request a delegated refresh token for the receivers", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/identity/token"),
+				ghttp.VerifyFormKV("grant_type", "urn:ibm:params:oauth:grant-type:apikey"),
+				ghttp.VerifyFormKV("apikey", "key"),
+				ghttp.VerifyFormKV("response_type", "delegated_refresh_token"),
+				ghttp.VerifyFormKV("receiver_client_ids", "kms,hpcs"),
+				ghttp.VerifyFormKV("delegated_refresh_token_expiry", "600"),
+				ghttp.RespondWith(http.StatusOK, `{"access_token":"access","delegated_refresh_token":"delegated","token_type":"Bearer"}`),
+			),
+		)
+		config := &bluemix.Config{BluemixAPIKey: "key", IAMAccessToken: "Bearer current"}
+		token, err := newIAMRepository(server.URL(), config).DelegatedRefreshToken(10*time.Minute, "kms", "hpcs")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token).To(Equal("delegated"))
+		Expect(config.IAMAccessToken).To(Equal("Bearer current"))
+	})
+	It("should require an API key", func() {
+		_, err := newIAMRepository(server.URL(), &bluemix.Config{IAMAccessToken: "Bearer current"}).DelegatedRefreshToken(0, "kms")
+		Expect(err).To(HaveOccurred())
+		Expect(err.(bmxerror.Error).Code()).To(Equal(bluemix.ErrInsufficientCredentials))
+		Expect(server.ReceivedRequests()).To(BeEmpty())
+	})
+	It("should return the IAM errors", func() {
+		server.AppendHandlers(
+			ghttp.RespondWith(http.StatusBadRequest, `{"errorCode":"BXNIM0109E","errorMessage":"Property missing or empty"}`),
+		)
+		_, err := newIAMRepository(server.URL(), &bluemix.Config{BluemixAPIKey: "key"}).DelegatedRefreshToken(0, "kms")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("BXNIM0109E"))
+	})
+})
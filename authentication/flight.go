@@ -0,0 +1,43 @@
+package authentication
+
+import "sync"
+
+//tokenFlight lets the concurrent token requests of a repository, such as the
+//refreshes triggered by many requests failing with 401 at once, share the
+//result of the request in flight instead of sending their own
+type tokenFlight struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+type flightCall struct {
+	done  chan struct{}
+	token string
+	err   error
+}
+
+//do runs fn unless a call with the same key is in flight, in which case it
+//waits for that call and returns its result
+func (f *tokenFlight) do(key string, fn func() (string, error)) (string, error) {
+	f.mu.Lock()
+	if f.calls == nil {
+		f.calls = map[string]*flightCall{}
+	}
+	if c, ok := f.calls[key]; ok {
+		f.mu.Unlock()
+		<-c.done
+		return c.token, c.err
+	}
+	c := &flightCall{done: make(chan struct{})}
+	f.calls[key] = c
+	f.mu.Unlock()
+
+	defer func() {
+		f.mu.Lock()
+		delete(f.calls, key)
+		f.mu.Unlock()
+		close(c.done)
+	}()
+	c.token, c.err = fn()
+	return c.token, c.err
+}
@@ -0,0 +1,63 @@
+package authentication
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Concurrent token refreshes", func() {
+	var server *ghttp.Server
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/identity/token"),
+				func(w http.ResponseWriter, r *http.Request) {
+					time.Sleep(100 * time.Millisecond)
+				},
+				ghttp.RespondWith(http.StatusOK, iamTokenResponse),
+			),
+		)
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should send a single request to IAM", func() {
+		repo := newIAMRepository(server.URL(), &bluemix.Config{IAMRefreshToken: "old-refresh-token"})
+		var wg sync.WaitGroup
+		tokens := make([]string, 10)
+		errs := make([]error, 10)
+		for i := range tokens {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				tokens[i], errs[i] = repo.RefreshToken()
+			}(i)
+		}
+		wg.Wait()
+		for i := range tokens {
+			Expect(errs[i]).NotTo(HaveOccurred())
+			Expect(tokens[i]).To(Equal("Bearer new-access-token"))
+		}
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("should let a new refresh through once the previous one is done", func() {
+		server.AppendHandlers(ghttp.RespondWith(http.StatusOK, iamTokenResponse))
+		repo := newIAMRepository(server.URL(), &bluemix.Config{IAMRefreshToken: "old-refresh-token"})
+		_, err := repo.RefreshToken()
+		Expect(err).NotTo(HaveOccurred())
+		_, err = repo.RefreshToken()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(server.ReceivedRequests()).To(HaveLen(2))
+	})
+})
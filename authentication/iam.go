@@ -1,12 +1,19 @@
 package authentication
 
 import (
+	"context"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"strings"
+	"time"
 
 	bluemix "github.com/IBM-Cloud/bluemix-go"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/bluemix-go/client"
 	"github.com/IBM-Cloud/bluemix-go/rest"
+	"github.com/IBM-Cloud/bluemix-go/trace"
 )
 
 //IAMError ...
@@ -31,6 +38,7 @@ type IAMTokenResponse struct {
 	UAAAccessToken  string `json:"uaa_token"`
 	UAARefreshToken string `json:"uaa_refresh_token"`
 	TokenType       string `json:"token_type"`
+	Expiration      int64  `json:"expiration"`
 }
 
 //IAMAuthRepository ...
@@ -38,6 +46,9 @@ type IAMAuthRepository struct {
 	config   *bluemix.Config
 	client   *rest.Client
 	endpoint string
+	ctx      context.Context
+	//flight is shared by the copies made by WithContext
+	flight *tokenFlight
 }
 
 //NewIAMAuthRepository ...
@@ -54,60 +65,184 @@ func NewIAMAuthRepository(config *bluemix.Config, client *rest.Client) (*IAMAuth
 		}
 	}
 
+	applyConfigHeaders(config, client)
 	return &IAMAuthRepository{
 		config:   config,
 		client:   client,
 		endpoint: endpoint,
+		flight:   &tokenFlight{},
 	}, nil
 }
 
+//WithContext returns a copy of the repository whose token requests, and the
+//waits between their retries, are canceled when ctx is done
+func (auth *IAMAuthRepository) WithContext(ctx context.Context) client.TokenProvider {
+	c := *auth
+	c.ctx = ctx
+	return &c
+}
+
+func (auth *IAMAuthRepository) context() context.Context {
+	if auth.ctx == nil {
+		return context.Background()
+	}
+	return auth.ctx
+}
+
 //AuthenticatePassword ...
 func (auth *IAMAuthRepository) AuthenticatePassword(username string, password string) error {
-	return auth.getToken(map[string]string{
+	_, err := auth.getToken(map[string]string{
 		"grant_type": "password",
 		"username":   username,
 		"password":   password,
 	})
+	return err
 }
 
 //AuthenticateAPIKey ...
 func (auth *IAMAuthRepository) AuthenticateAPIKey(apiKey string) error {
+	_, err := auth.flight.do("apikey", func() (string, error) {
+		return "", auth.authenticateWithCache(apiKey)
+	})
+	return err
+}
+
+func (auth *IAMAuthRepository) authenticateWithCache(apiKey string) error {
+	cache := auth.config.TokenCache
+	if cache == nil {
+		_, err := auth.authenticateAPIKey(apiKey)
+		return err
+	}
+	key := tokenCacheKey(auth.endpoint, apiKey)
+	if cached, ok := cache.Get(key); ok && cached.IAMAccessToken != auth.config.IAMAccessToken {
+		if time.Until(cached.Expiry) > tokenExpiryMargin {
+			auth.setCachedTokens(cached)
+			return nil
+		}
+		if cached.IAMRefreshToken != "" && !auth.refreshWithAPIKey() {
+			auth.config.IAMRefreshToken = cached.IAMRefreshToken
+			if tokens, err := auth.refreshToken(); err == nil {
+				auth.cacheTokens(key, tokens)
+				return nil
+			}
+		}
+	}
+	tokens, err := auth.authenticateAPIKey(apiKey)
+	if err != nil {
+		return err
+	}
+	auth.cacheTokens(key, tokens)
+	return nil
+}
+
+func (auth *IAMAuthRepository) authenticateAPIKey(apiKey string) (IAMTokenResponse, error) {
 	return auth.getToken(map[string]string{
 		"grant_type": "urn:ibm:params:oauth:grant-type:apikey",
 		"apikey":     apiKey,
 	})
 }
 
+//AuthenticateTrustedProfile logs in with the trusted profile of the config,
+//exchanging the compute resource token of the workload for an IAM token, or
+//asking the VPC instance metadata service when Config.VPCMetadataEndpoint is set
+func (auth *IAMAuthRepository) AuthenticateTrustedProfile() error {
+	_, err := auth.flight.do("trusted_profile", func() (string, error) {
+		return "", auth.authenticateTrustedProfile()
+	})
+	return err
+}
+
+func (auth *IAMAuthRepository) authenticateTrustedProfile() error {
+	if auth.config.VPCMetadataEndpoint != "" {
+		return auth.authenticateVPCInstance()
+	}
+	crToken, err := readCRToken(auth.config.CRTokenFile)
+	if err != nil {
+		return err
+	}
+	_, err = auth.getToken(map[string]string{
+		"grant_type": "urn:ibm:params:oauth:grant-type:cr-token",
+		"cr_token":   crToken,
+		"profile_id": auth.config.IAMTrustedProfileID,
+	})
+	return err
+}
+
 //AuthenticateSSO ...
 func (auth *IAMAuthRepository) AuthenticateSSO(passcode string) error {
-	return auth.getToken(map[string]string{
+	_, err := auth.getToken(map[string]string{
 		"grant_type": "urn:ibm:params:oauth:grant-type:passcode",
 		"passcode":   passcode,
 	})
+	return err
 }
 
 //RefreshToken ...
 func (auth *IAMAuthRepository) RefreshToken() (string, error) {
-	data := map[string]string{
+	return auth.flight.do("refresh_token", func() (string, error) {
+		if auth.config.IAMTokenRefresher != nil {
+			return auth.refreshFromCallback()
+		}
+		if auth.refreshWithAPIKey() {
+			if err := auth.AuthenticateAPIKey(auth.config.BluemixAPIKey); err != nil {
+				return "", err
+			}
+			return auth.config.IAMAccessToken, nil
+		}
+		if _, err := auth.refreshToken(); err != nil {
+			return "", err
+		}
+		return auth.config.IAMAccessToken, nil
+	})
+}
+
+//refreshWithAPIKey reports whether the tokens are renewed with the API key
+//instead of the refresh token
+func (auth *IAMAuthRepository) refreshWithAPIKey() bool {
+	return auth.config.TokenRefreshStrategy == bluemix.RefreshWithAPIKey && auth.config.BluemixAPIKey != ""
+}
+
+func (auth *IAMAuthRepository) refreshToken() (IAMTokenResponse, error) {
+	return auth.getToken(map[string]string{
 		"grant_type":    "refresh_token",
 		"refresh_token": auth.config.IAMRefreshToken,
-	}
+	})
+}
 
-	err := auth.getToken(data)
+//refreshFromCallback sets the access token returned by Config.IAMTokenRefresher
+func (auth *IAMAuthRepository) refreshFromCallback() (string, error) {
+	token, err := auth.config.IAMTokenRefresher(auth.context())
 	if err != nil {
 		return "", err
 	}
-
-	return auth.config.IAMAccessToken, nil
+	if token == "" {
+		return "", bmxerror.New(ErrCodeInvalidToken, "The IAM token refresher returned an empty token")
+	}
+	if !strings.HasPrefix(token, "Bearer ") {
+		token = "Bearer " + token
+	}
+	auth.config.IAMAccessToken = token
+	return token, nil
 }
 
 //GetPasscode ...
 func (auth *IAMAuthRepository) GetPasscode() (string, error) {
+	var passcode string
+	err := retryTokenRequest(auth.context(), auth.config.Logger, func() error {
+		var err error
+		passcode, err = auth.getPasscode()
+		return err
+	})
+	return passcode, err
+}
+
+func (auth *IAMAuthRepository) getPasscode() (string, error) {
 	request := rest.PostRequest(auth.endpoint+"/identity/passcode").
 		Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("bx:bx"))).
 		Field("grant_type", "refresh_token").
 		Field("refresh_token", auth.config.IAMRefreshToken).
-		Field("response_type", "cloud_iam")
+		Field("response_type", "cloud_iam").
+		WithContext(auth.context())
 
 	res := make(map[string]string, 0)
 	var apiErr IAMError
@@ -127,19 +262,44 @@ func (auth *IAMAuthRepository) GetPasscode() (string, error) {
 	return res["passcode"], nil
 }
 
-func (auth *IAMAuthRepository) getToken(data map[string]string) error {
+func (auth *IAMAuthRepository) getToken(data map[string]string) (IAMTokenResponse, error) {
+	var tokens IAMTokenResponse
+	err := retryTokenRequest(auth.context(), auth.config.Logger, func() error {
+		var err error
+		tokens, err = auth.requestToken(data)
+		return err
+	})
+	return tokens, err
+}
+
+func (auth *IAMAuthRepository) requestToken(data map[string]string) (IAMTokenResponse, error) {
+	var tokens IAMTokenResponse
+	if err := auth.postToken("cloud_iam", data, &tokens); err != nil {
+		return tokens, err
+	}
+
+	auth.config.IAMAccessToken = fmt.Sprintf("%s %s", tokens.TokenType, tokens.AccessToken)
+	auth.config.IAMRefreshToken = tokens.RefreshToken
+	auth.tokenRefreshed(data["grant_type"], tokens.Expiration)
+
+	return tokens, nil
+}
+
+//postToken sends a token request of the response type to IAM and decodes its
+//response into tokens
+func (auth *IAMAuthRepository) postToken(responseType string, data map[string]string, tokens interface{}) error {
 	request := rest.PostRequest(auth.endpoint+"/identity/token").
 		Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("bx:bx"))).
-		Field("response_type", "cloud_iam")
+		Field("response_type", responseType).
+		WithContext(auth.context())
 
 	for k, v := range data {
 		request.Field(k, v)
 	}
 
-	var tokens IAMTokenResponse
 	var apiErr IAMError
 
-	resp, err := auth.client.Do(request, &tokens, &apiErr)
+	resp, err := auth.client.Do(request, tokens, &apiErr)
 	if err != nil {
 		return err
 	}
@@ -156,9 +316,49 @@ func (auth *IAMAuthRepository) getToken(data map[string]string) error {
 		}
 		return bmxerror.NewRequestFailure(apiErr.ErrorCode, apiErr.Description(), resp.StatusCode)
 	}
+	return nil
+}
 
-	auth.config.IAMAccessToken = fmt.Sprintf("%s %s", tokens.TokenType, tokens.AccessToken)
-	auth.config.IAMRefreshToken = tokens.RefreshToken
+//tokenRefreshed passes the tokens of the config to Config.OnTokenRefresh
+func (auth *IAMAuthRepository) tokenRefreshed(grantType string, expiration int64) {
+	if auth.config.OnTokenRefresh == nil {
+		return
+	}
+	event := bluemix.TokenEvent{
+		GrantType:    grantType,
+		AccessToken:  auth.config.IAMAccessToken,
+		RefreshToken: auth.config.IAMRefreshToken,
+	}
+	if expiration > 0 {
+		event.Expiry = time.Unix(expiration, 0)
+	}
+	auth.config.OnTokenRefresh(event)
+}
 
-	return nil
+//tokenExpiryMargin is the validity a cached token must have left to be reused
+const tokenExpiryMargin = time.Minute
+
+func tokenCacheKey(endpoint, apiKey string) string {
+	digest := sha256.Sum256([]byte(endpoint + "\n" + apiKey))
+	return hex.EncodeToString(digest[:])
+}
+
+func (auth *IAMAuthRepository) setCachedTokens(cached bluemix.CachedTokens) {
+	auth.config.IAMAccessToken = cached.IAMAccessToken
+	auth.config.IAMRefreshToken = cached.IAMRefreshToken
+}
+
+//cacheTokens stores the tokens of the config, failures to store them are only traced
+func (auth *IAMAuthRepository) cacheTokens(key string, tokens IAMTokenResponse) {
+	if tokens.Expiration == 0 {
+		return
+	}
+	err := auth.config.TokenCache.Set(key, bluemix.CachedTokens{
+		IAMAccessToken:  auth.config.IAMAccessToken,
+		IAMRefreshToken: auth.config.IAMRefreshToken,
+		Expiry:          time.Unix(tokens.Expiration, 0),
+	})
+	if err != nil {
+		trace.For(auth.config.Logger).Warn("Couldn't cache the IAM tokens", "error", err)
+	}
 }
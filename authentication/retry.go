@@ -0,0 +1,54 @@
+package authentication
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/bluemix-go/helpers"
+	"github.com/IBM-Cloud/bluemix-go/trace"
+)
+
+var (
+	//tokenRequestMaxRetries is the number of times a token request is retried
+	//after a transient failure
+	tokenRequestMaxRetries = 3
+	//tokenRequestRetryDelay is the initial backoff, doubled after every retry
+	tokenRequestRetryDelay = 1 * time.Second
+)
+
+//retryTokenRequest calls fn and retries it with exponential backoff as long as
+//the failure looks like a transient outage of the token provider. Credential
+//errors such as an invalid API key or an expired refresh token are returned
+//immediately. The wait between attempts stops as soon as ctx is done.
+func retryTokenRequest(ctx context.Context, logger trace.StructuredLogger, fn func() error) error {
+	backoff := helpers.Backoff{InitialDelay: tokenRequestRetryDelay}
+	return helpers.Retry(ctx, backoff, tokenRequestMaxRetries, func(err error) bool {
+		if !isTransientTokenError(err) {
+			return false
+		}
+		trace.For(logger).Warn("Token request failed, retrying", "error", err)
+		return true
+	}, fn)
+}
+
+//isTransientTokenError reports whether err was caused by the token provider
+//being temporarily unavailable rather than by the supplied credentials
+func isTransientTokenError(err error) bool {
+	switch e := err.(type) {
+	case nil:
+		return false
+	case bmxerror.RequestFailure:
+		switch e.StatusCode() {
+		case 408, 429, 500, 502, 503, 504:
+			return true
+		}
+		return false
+	case bmxerror.Error, *bmxerror.InvalidTokenError:
+		return false
+	case net.Error:
+		return true
+	}
+	return false
+}
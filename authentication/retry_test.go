@@ -0,0 +1,128 @@
+package authentication
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/bluemix-go/rest"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const iamTokenResponse = `{"access_token": "new-access-token", "refresh_token": "new-refresh-token", "token_type": "Bearer"}`
+
+var _ = Describe("Token request retries", func() {
+	var server *ghttp.Server
+	var savedDelay time.Duration
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		savedDelay = tokenRequestRetryDelay
+		tokenRequestRetryDelay = time.Millisecond
+	})
+	AfterEach(func() {
+		server.Close()
+		tokenRequestRetryDelay = savedDelay
+	})
+
+	Context("When IAM is temporarily unavailable", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, "/identity/token"),
+					ghttp.RespondWith(http.StatusServiceUnavailable, `Service Unavailable`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, "/identity/token"),
+					ghttp.RespondWith(http.StatusOK, iamTokenResponse),
+				),
+			)
+		})
+
+		It("should retry and store the tokens", func() {
+			config := &bluemix.Config{}
+			err := newIAMRepository(server.URL(), config).AuthenticateAPIKey("key")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(2))
+			Expect(config.IAMAccessToken).To(Equal("Bearer new-access-token"))
+			Expect(config.IAMRefreshToken).To(Equal("new-refresh-token"))
+		})
+	})
+	Context("When IAM stays unavailable", func() {
+		BeforeEach(func() {
+			server.RouteToHandler(http.MethodPost, "/identity/token",
+				ghttp.RespondWith(http.StatusBadGateway, `Bad Gateway`))
+		})
+
+		It("should give up after the maximum number of retries", func() {
+			err := newIAMRepository(server.URL(), &bluemix.Config{}).AuthenticateAPIKey("key")
+			Expect(err).To(HaveOccurred())
+			Expect(err.(bmxerror.RequestFailure).StatusCode()).To(Equal(http.StatusBadGateway))
+			Expect(server.ReceivedRequests()).To(HaveLen(tokenRequestMaxRetries + 1))
+		})
+	})
+	Context("When the API key is invalid", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, "/identity/token"),
+					ghttp.RespondWith(http.StatusBadRequest, `{"errorCode": "BXNIM0415E", "errorMessage": "Provided API key could not be found"}`),
+				),
+			)
+		})
+
+		It("should not retry", func() {
+			err := newIAMRepository(server.URL(), &bluemix.Config{}).AuthenticateAPIKey("bad-key")
+			Expect(err).To(HaveOccurred())
+			Expect(err.(bmxerror.RequestFailure).Code()).To(Equal("BXNIM0415E"))
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+	Context("When the refresh token expired", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, "/identity/token"),
+					ghttp.RespondWith(http.StatusBadRequest, `{"errorCode": "BXNIM0407E", "errorMessage": "Refresh token expired"}`),
+				),
+			)
+		})
+
+		It("should not retry", func() {
+			_, err := newIAMRepository(server.URL(), &bluemix.Config{IAMRefreshToken: "expired"}).RefreshToken()
+			Expect(err).To(HaveOccurred())
+			Expect(err.(bmxerror.Error).Code()).To(Equal(ErrCodeInvalidToken))
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+	Context("When the context is canceled while waiting", func() {
+		BeforeEach(func() {
+			tokenRequestRetryDelay = time.Minute
+			server.RouteToHandler(http.MethodPost, "/identity/token",
+				ghttp.RespondWith(http.StatusServiceUnavailable, `Service Unavailable`))
+		})
+
+		It("should stop retrying", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+			repo := newIAMRepository(server.URL(), &bluemix.Config{}).WithContext(ctx)
+			start := time.Now()
+			err := repo.AuthenticateAPIKey("key")
+			Expect(err).To(Equal(context.DeadlineExceeded))
+			Expect(time.Since(start)).To(BeNumerically("<", 10*time.Second))
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+})
+
+func newIAMRepository(url string, config *bluemix.Config) *IAMAuthRepository {
+	config.TokenProviderEndpoint = &url
+	repo, err := NewIAMAuthRepository(config, rest.NewClient())
+	Expect(err).NotTo(HaveOccurred())
+	return repo
+}
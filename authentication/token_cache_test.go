@@ -0,0 +1,138 @@
+package authentication
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Token cache", func() {
+	var server *ghttp.Server
+	var cache bluemix.TokenCache
+	tokenResponse := func(access string, expiry time.Time) string {
+		return fmt.Sprintf(`{"access_token": %q, "refresh_token": "refresh-%s", "token_type": "Bearer", "expiration": %d}`, access, access, expiry.Unix())
+	}
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		cache = bluemix.NewMemoryTokenCache()
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should reuse the tokens of the API key until they expire", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/identity/token"),
+				ghttp.VerifyFormKV("grant_type", "urn:ibm:params:oauth:grant-type:apikey"),
+				ghttp.RespondWith(http.StatusOK, tokenResponse("t1", time.Now().Add(time.Hour))),
+			),
+		)
+		first := &bluemix.Config{TokenCache: cache}
+		Expect(newIAMRepository(server.URL(), first).AuthenticateAPIKey("key")).To(Succeed())
+		second := &bluemix.Config{TokenCache: cache}
+		Expect(newIAMRepository(server.URL(), second).AuthenticateAPIKey("key")).To(Succeed())
+		Expect(second.IAMAccessToken).To(Equal("Bearer t1"))
+		Expect(second.IAMRefreshToken).To(Equal("refresh-t1"))
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+	It("should not share the tokens of another API key", func() {
+		server.AppendHandlers(
+			ghttp.RespondWith(http.StatusOK, tokenResponse("t1", time.Now().Add(time.Hour))),
+			ghttp.RespondWith(http.StatusOK, tokenResponse("t2", time.Now().Add(time.Hour))),
+		)
+		Expect(newIAMRepository(server.URL(), &bluemix.Config{TokenCache: cache}).AuthenticateAPIKey("key1")).To(Succeed())
+		config := &bluemix.Config{TokenCache: cache}
+		Expect(newIAMRepository(server.URL(), config).AuthenticateAPIKey("key2")).To(Succeed())
+		Expect(config.IAMAccessToken).To(Equal("Bearer t2"))
+	})
+	It("should refresh the expired tokens", func() {
+		server.AppendHandlers(
+			ghttp.RespondWith(http.StatusOK, tokenResponse("t1", time.Now().Add(30*time.Second))),
+			ghttp.CombineHandlers(
+				ghttp.VerifyFormKV("grant_type", "refresh_token"),
+				ghttp.VerifyFormKV("refresh_token", "refresh-t1"),
+				ghttp.RespondWith(http.StatusOK, tokenResponse("t2", time.Now().Add(time.Hour))),
+			),
+		)
+		Expect(newIAMRepository(server.URL(), &bluemix.Config{TokenCache: cache}).AuthenticateAPIKey("key")).To(Succeed())
+		config := &bluemix.Config{TokenCache: cache}
+		Expect(newIAMRepository(server.URL(), config).AuthenticateAPIKey("key")).To(Succeed())
+		Expect(config.IAMAccessToken).To(Equal("Bearer t2"))
+		Expect(server.ReceivedRequests()).To(HaveLen(2))
+	})
+	It("should exchange the API key again for the expired tokens with the RefreshWithAPIKey strategy", func() {
+		server.AppendHandlers(
+			ghttp.RespondWith(http.StatusOK, tokenResponse("t1", time.Now().Add(30*time.Second))),
+			ghttp.CombineHandlers(
+				ghttp.VerifyFormKV("grant_type", "urn:ibm:params:oauth:grant-type:apikey"),
+				ghttp.RespondWith(http.StatusOK, tokenResponse("t2", time.Now().Add(time.Hour))),
+			),
+		)
+		Expect(newIAMRepository(server.URL(), &bluemix.Config{TokenCache: cache}).AuthenticateAPIKey("key")).To(Succeed())
+		config := &bluemix.Config{TokenCache: cache, BluemixAPIKey: "key", TokenRefreshStrategy: bluemix.RefreshWithAPIKey}
+		Expect(newIAMRepository(server.URL(), config).AuthenticateAPIKey("key")).To(Succeed())
+		Expect(config.IAMAccessToken).To(Equal("Bearer t2"))
+	})
+	It("should refresh the tokens with the API key with the RefreshWithAPIKey strategy", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyFormKV("grant_type", "urn:ibm:params:oauth:grant-type:apikey"),
+				ghttp.VerifyFormKV("apikey", "key"),
+				ghttp.RespondWith(http.StatusOK, tokenResponse("t1", time.Now().Add(time.Hour))),
+			),
+		)
+		config := &bluemix.Config{BluemixAPIKey: "key", IAMRefreshToken: "refresh", TokenRefreshStrategy: bluemix.RefreshWithAPIKey}
+		token, err := newIAMRepository(server.URL(), config).RefreshToken()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token).To(Equal("Bearer t1"))
+	})
+	It("should not reuse the token that was just rejected", func() {
+		server.AppendHandlers(
+			ghttp.RespondWith(http.StatusOK, tokenResponse("t1", time.Now().Add(time.Hour))),
+			ghttp.CombineHandlers(
+				ghttp.VerifyFormKV("grant_type", "urn:ibm:params:oauth:grant-type:apikey"),
+				ghttp.RespondWith(http.StatusOK, tokenResponse("t2", time.Now().Add(time.Hour))),
+			),
+		)
+		config := &bluemix.Config{TokenCache: cache}
+		repo := newIAMRepository(server.URL(), config)
+		Expect(repo.AuthenticateAPIKey("key")).To(Succeed())
+		Expect(repo.AuthenticateAPIKey("key")).To(Succeed())
+		Expect(config.IAMAccessToken).To(Equal("Bearer t2"))
+	})
+	It("should keep the tokens in files", func() {
+		dir, err := ioutil.TempDir("", "tokens")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(dir)
+		fileCache, err := bluemix.NewFileTokenCache(dir)
+		Expect(err).NotTo(HaveOccurred())
+
+		server.AppendHandlers(
+			ghttp.RespondWith(http.StatusOK, tokenResponse("t1", time.Now().Add(time.Hour))),
+		)
+		Expect(newIAMRepository(server.URL(), &bluemix.Config{TokenCache: fileCache}).AuthenticateAPIKey("key")).To(Succeed())
+
+		files, err := ioutil.ReadDir(dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(files).To(HaveLen(1))
+		Expect(files[0].Mode().Perm()).To(Equal(os.FileMode(0600)))
+		Expect(files[0].Name()).NotTo(ContainSubstring("key"))
+
+		reopened, err := bluemix.NewFileTokenCache(dir)
+		Expect(err).NotTo(HaveOccurred())
+		config := &bluemix.Config{TokenCache: reopened}
+		Expect(newIAMRepository(server.URL(), config).AuthenticateAPIKey("key")).To(Succeed())
+		Expect(config.IAMAccessToken).To(Equal("Bearer t1"))
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,67 @@
+package authentication
+
+import (
+	"net/http"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/client"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Token events", func() {
+	var server *ghttp.Server
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/v1/things"),
+				ghttp.RespondWith(http.StatusUnauthorized, `{"message": "expired"}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/identity/token"),
+				ghttp.RespondWith(http.StatusOK, `{"access_token": "new-access-token", "refresh_token": "new-refresh-token", "token_type": "Bearer", "expiration": 1700000000}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/v1/things"),
+				ghttp.VerifyHeaderKV("Authorization", "Bearer new-access-token"),
+				ghttp.RespondWith(http.StatusOK, `{}`),
+			),
+		)
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should report the expired and the refreshed tokens", func() {
+		var expired []bluemix.ServiceName
+		var refreshed []bluemix.TokenEvent
+		endpoint := server.URL()
+		maxRetries := 0
+		config := &bluemix.Config{
+			IAMAccessToken:  "Bearer old-token",
+			IAMRefreshToken: "old-refresh-token",
+			Endpoint:        &endpoint,
+			MaxRetries:      &maxRetries,
+			OnTokenExpired: func(service bluemix.ServiceName) {
+				expired = append(expired, service)
+			},
+			OnTokenRefresh: func(e bluemix.TokenEvent) {
+				refreshed = append(refreshed, e)
+			},
+		}
+		c := client.New(config, bluemix.GlobalTaggingService, newIAMRepository(server.URL(), config))
+		_, err := c.Get("/v1/things", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(expired).To(Equal([]bluemix.ServiceName{bluemix.GlobalTaggingService}))
+		Expect(refreshed).To(HaveLen(1))
+		Expect(refreshed[0].GrantType).To(Equal("refresh_token"))
+		Expect(refreshed[0].AccessToken).To(Equal("Bearer new-access-token"))
+		Expect(refreshed[0].RefreshToken).To(Equal("new-refresh-token"))
+		Expect(refreshed[0].Expiry).To(Equal(time.Unix(1700000000, 0)))
+	})
+})
@@ -0,0 +1,89 @@
+package authentication
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/client"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("IAM token refresher", func() {
+	var server *ghttp.Server
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Context("When the access token expires", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/v1/things"),
+					ghttp.VerifyHeaderKV("Authorization", "Bearer old-token"),
+					ghttp.RespondWith(http.StatusUnauthorized, `{"message": "expired"}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/v1/things"),
+					ghttp.VerifyHeaderKV("Authorization", "Bearer new-token"),
+					ghttp.RespondWith(http.StatusOK, `{}`),
+				),
+			)
+		})
+
+		It("should call the refresher instead of IAM", func() {
+			calls := 0
+			endpoint := server.URL()
+			maxRetries := 0
+			config := &bluemix.Config{
+				IAMAccessToken: "Bearer old-token",
+				IAMTokenRefresher: func(ctx context.Context) (string, error) {
+					calls++
+					return "new-token", nil
+				},
+				Endpoint:   &endpoint,
+				MaxRetries: &maxRetries,
+			}
+			Expect(config.ValidateConfigForService(bluemix.GlobalTaggingService)).To(Succeed())
+			c := client.New(config, bluemix.GlobalTaggingService, newIAMRepository("https://iam.example.com", config))
+			_, err := c.Get("/v1/things", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(calls).To(Equal(1))
+			Expect(config.IAMAccessToken).To(Equal("Bearer new-token"))
+		})
+	})
+	Context("When no access token was given", func() {
+		It("should get the first token from the refresher", func() {
+			config := &bluemix.Config{
+				IAMTokenRefresher: func(ctx context.Context) (string, error) {
+					return "Bearer first-token", nil
+				},
+			}
+			err := PopulateTokens(newIAMRepository(server.URL(), config), config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.IAMAccessToken).To(Equal("Bearer first-token"))
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+		})
+	})
+	Context("When the refresher fails", func() {
+		It("should return its error", func() {
+			config := &bluemix.Config{
+				IAMAccessToken: "Bearer old-token",
+				IAMTokenRefresher: func(ctx context.Context) (string, error) {
+					return "", errors.New("pipeline down")
+				},
+			}
+			_, err := newIAMRepository(server.URL(), config).RefreshToken()
+			Expect(err).To(MatchError("pipeline down"))
+			Expect(config.IAMAccessToken).To(Equal("Bearer old-token"))
+		})
+	})
+})
@@ -1,11 +1,13 @@
 package authentication
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 
 	bluemix "github.com/IBM-Cloud/bluemix-go"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/bluemix-go/client"
 	"github.com/IBM-Cloud/bluemix-go/rest"
 )
 
@@ -27,6 +29,7 @@ type UAARepository struct {
 	config   *bluemix.Config
 	client   *rest.Client
 	endpoint string
+	ctx      context.Context
 }
 
 //NewUAARepository ...
@@ -42,6 +45,7 @@ func NewUAARepository(config *bluemix.Config, client *rest.Client) (*UAAReposito
 			return nil, err
 		}
 	}
+	applyConfigHeaders(config, client)
 	return &UAARepository{
 		config:   config,
 		client:   client,
@@ -49,6 +53,21 @@ func NewUAARepository(config *bluemix.Config, client *rest.Client) (*UAAReposito
 	}, nil
 }
 
+//WithContext returns a copy of the repository whose token requests, and the
+//waits between their retries, are canceled when ctx is done
+func (auth *UAARepository) WithContext(ctx context.Context) client.TokenProvider {
+	c := *auth
+	c.ctx = ctx
+	return &c
+}
+
+func (auth *UAARepository) context() context.Context {
+	if auth.ctx == nil {
+		return context.Background()
+	}
+	return auth.ctx
+}
+
 //AuthenticatePassword ...
 func (auth *UAARepository) AuthenticatePassword(username string, password string) error {
 	return auth.getToken(map[string]string{
@@ -90,9 +109,16 @@ func (auth *UAARepository) GetPasscode() (string, error) {
 }
 
 func (auth *UAARepository) getToken(data map[string]string) error {
+	return retryTokenRequest(auth.context(), auth.config.Logger, func() error {
+		return auth.requestToken(data)
+	})
+}
+
+func (auth *UAARepository) requestToken(data map[string]string) error {
 	request := rest.PostRequest(auth.endpoint+"/oauth/token").
 		Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("cf:"))).
-		Field("scope", "")
+		Field("scope", "").
+		WithContext(auth.context())
 
 	for k, v := range data {
 		request.Field(k, v)
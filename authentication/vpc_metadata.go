@@ -0,0 +1,59 @@
+package authentication
+
+import (
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/rest"
+)
+
+const (
+	vpcMetadataVersion = "2022-03-01"
+	//vpcIdentityTokenLifetime is the lifetime in seconds of the instance identity
+	//token, only used to get the IAM token right away
+	vpcIdentityTokenLifetime = 300
+)
+
+type vpcMetadataToken struct {
+	AccessToken string `json:"access_token"`
+}
+
+//authenticateVPCInstance gets an instance identity token from the VPC metadata
+//service and exchanges it for an IAM token of the trusted profile
+func (auth *IAMAuthRepository) authenticateVPCInstance() error {
+	endpoint := strings.TrimSuffix(auth.config.VPCMetadataEndpoint, "/")
+
+	var identity vpcMetadataToken
+	err := retryTokenRequest(auth.context(), auth.config.Logger, func() error {
+		_, err := auth.client.Do(rest.PutRequest(endpoint+"/instance_identity/v1/token").
+			Query("version", vpcMetadataVersion).
+			Set("Metadata-Flavor", "ibm").
+			Body(map[string]int{"expires_in": vpcIdentityTokenLifetime}).
+			WithContext(auth.context()), &identity, nil)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	body := map[string]interface{}{}
+	if auth.config.IAMTrustedProfileID != "" {
+		body["trusted_profile"] = map[string]string{"id": auth.config.IAMTrustedProfileID}
+	}
+	var iam vpcMetadataToken
+	err = retryTokenRequest(auth.context(), auth.config.Logger, func() error {
+		_, err := auth.client.Do(rest.PostRequest(endpoint+"/instance_identity/v1/iam_token").
+			Query("version", vpcMetadataVersion).
+			Set("Authorization", "Bearer "+identity.AccessToken).
+			Body(body).
+			WithContext(auth.context()), &iam, nil)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	auth.config.IAMAccessToken = "Bearer " + iam.AccessToken
+	auth.config.IAMRefreshToken = ""
+	auth.tokenRefreshed("vpc-instance-identity", 0)
+	return nil
+}
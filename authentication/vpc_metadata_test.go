@@ -0,0 +1,66 @@
+package authentication
+
+import (
+	"net/http"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("VPC instance metadata authentication", func() {
+	var metadata *ghttp.Server
+	BeforeEach(func() {
+		metadata = ghttp.NewServer()
+	})
+	AfterEach(func() {
+		metadata.Close()
+	})
+
+	Context("When the instance has a trusted profile", func() {
+		BeforeEach(func() {
+			metadata.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPut, "/instance_identity/v1/token", "version="+vpcMetadataVersion),
+					ghttp.VerifyHeaderKV("Metadata-Flavor", "ibm"),
+					ghttp.VerifyJSON(`{"expires_in": 300}`),
+					ghttp.RespondWith(http.StatusOK, `{"access_token": "identity-token", "expires_in": 300}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, "/instance_identity/v1/iam_token", "version="+vpcMetadataVersion),
+					ghttp.VerifyHeaderKV("Authorization", "Bearer identity-token"),
+					ghttp.VerifyJSON(`{"trusted_profile": {"id": "Profile-1"}}`),
+					ghttp.RespondWith(http.StatusOK, `{"access_token": "iam-token", "expires_in": 3600}`),
+				),
+			)
+		})
+
+		It("should exchange the instance identity token for an IAM token", func() {
+			config := &bluemix.Config{
+				IAMTrustedProfileID: "Profile-1",
+				VPCMetadataEndpoint: metadata.URL() + "/",
+			}
+			err := PopulateTokens(newIAMRepository("https://iam.example.com", config), config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.IAMAccessToken).To(Equal("Bearer iam-token"))
+			Expect(config.IAMRefreshToken).To(BeEmpty())
+		})
+	})
+	Context("When the metadata service is disabled", func() {
+		BeforeEach(func() {
+			metadata.AppendHandlers(
+				ghttp.RespondWith(http.StatusNotFound, `{"errors": [{"code": "not_found"}]}`),
+			)
+		})
+
+		It("should return an error", func() {
+			config := &bluemix.Config{VPCMetadataEndpoint: metadata.URL()}
+			err := newIAMRepository("https://iam.example.com", config).AuthenticateTrustedProfile()
+			Expect(err).To(HaveOccurred())
+			Expect(metadata.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+})
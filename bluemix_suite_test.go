@@ -0,0 +1,13 @@
+package bluemix_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestBluemix(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Bluemix Suite")
+}
@@ -0,0 +1,44 @@
+package bmxerror
+
+import (
+	"errors"
+	"net/http"
+)
+
+//The categories of the request failures, for errors.Is. For instance
+//errors.Is(err, bmxerror.ErrNotFound) reports whether err is a request
+//failure with the status code 404, or wraps one.
+var (
+	ErrUnauthorized  = errors.New("unauthorized")
+	ErrForbidden     = errors.New("forbidden")
+	ErrNotFound      = errors.New("not found")
+	ErrConflict      = errors.New("conflict")
+	ErrQuotaExceeded = errors.New("quota exceeded")
+	//ErrPreconditionFailed is returned by the conditional updates of a resource
+	//changed since it was read
+	ErrPreconditionFailed = errors.New("precondition failed")
+)
+
+//Category returns the category of the status code, nil when it has none
+func Category(statusCode int) error {
+	switch statusCode {
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbidden
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusConflict:
+		return ErrConflict
+	case http.StatusTooManyRequests:
+		return ErrQuotaExceeded
+	case http.StatusPreconditionFailed:
+		return ErrPreconditionFailed
+	}
+	return nil
+}
+
+//Is reports whether target is the category of the status code of the failure
+func (r requestError) Is(target error) bool {
+	return target != nil && target == Category(r.statusCode)
+}
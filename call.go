@@ -0,0 +1,48 @@
+package bluemix
+
+import (
+	"context"
+	"time"
+)
+
+//APICall describes an API call of a service client, from its first attempt
+//to its last
+type APICall struct {
+	Service ServiceName
+	Method  string
+	//Operation is the path of the URL, e.g. /v2/getCluster
+	Operation string
+	URL       string
+}
+
+//APICallResult is the outcome of an API call
+type APICallResult struct {
+	//StatusCode is 0 when no response was received
+	StatusCode    int
+	TransactionID string
+	//Attempts is the number of times the request was sent, 1 without retries
+	Attempts int
+	Duration time.Duration
+	Err      error
+}
+
+//Tracer starts a span for every API call of the clients, see Config.Tracer.
+//The SDK doesn't depend on a tracing library: an OpenTelemetry tracer is
+//plugged in with an adapter starting a client span named after the operation.
+type Tracer interface {
+	//StartSpan returns the context the call is sent with, carrying the span
+	//so that the instrumented transports propagate it
+	StartSpan(ctx context.Context, call APICall) (context.Context, Span)
+}
+
+//Span is the span of an API call
+type Span interface {
+	End(result APICallResult)
+}
+
+//MetricsRecorder is called once per API call of the clients, see
+//Config.Metrics, for instance to export Prometheus counters and histograms of
+//the calls, their latency, status codes and retries per service and operation
+type MetricsRecorder interface {
+	RecordAPICall(call APICall, result APICallResult)
+}
@@ -0,0 +1,262 @@
+//Package cassette records the HTTP interactions of the SDK clients to a file
+//and replays them, so that the integration tests of code built on the SDK run
+//deterministically without live credentials. The secrets are hidden with
+//trace.Sanitize before the interactions are saved.
+//
+//	rec, err := cassette.New("testdata/clusters.json", cassette.ReplayOrRecord, nil)
+//	...
+//	defer rec.Stop()
+//	rec.Configure(config)
+//	sess, err := session.New(config)
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+	"unicode/utf8"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/bluemix-go/trace"
+)
+
+//Mode ...
+type Mode int
+
+const (
+	//Replay serves the interactions of the cassette and fails the requests not
+	//recorded in it
+	Replay Mode = iota
+	//Record sends the requests and saves the interactions to the cassette on Stop
+	Record
+	//ReplayOrRecord replays the cassette when its file exists and records it
+	//otherwise
+	ReplayOrRecord
+)
+
+//version of the cassette files
+const version = 1
+
+//replayAPIKey is set on the configs of replayed cassettes given no credentials
+const replayAPIKey = "cassette-replay"
+
+//Request is a sanitized recorded request
+type Request struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+//Response is a sanitized recorded response. Binary bodies, such as the cluster
+//config archives, are kept in BinaryBody.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+	BinaryBody []byte      `json:"binary_body,omitempty"`
+}
+
+//Interaction is a recorded request and its response
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+type file struct {
+	Version      int           `json:"version"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+//Recorder is an http.RoundTripper recording or replaying a cassette. It is
+//safe for concurrent use.
+type Recorder struct {
+	path string
+	mode Mode
+	rt   http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []Interaction
+	replayed     []bool
+}
+
+//New returns a recorder of the cassette file at path. When recording, the
+//requests are sent with rt, or http.DefaultTransport if rt is nil.
+func New(path string, mode Mode, rt http.RoundTripper) (*Recorder, error) {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	r := &Recorder{
+		path: path,
+		mode: mode,
+		rt:   rt,
+	}
+	if mode == ReplayOrRecord {
+		r.mode = Record
+		if _, err := os.Stat(path); err == nil {
+			r.mode = Replay
+		}
+	}
+	if r.mode != Replay {
+		return r, nil
+	}
+
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f file
+	if err := json.Unmarshal(raw, &f); err != nil {
+		return nil, fmt.Errorf("Invalid cassette %s: %v", path, err)
+	}
+	if f.Version != version {
+		return nil, fmt.Errorf("Unsupported version %d of cassette %s", f.Version, path)
+	}
+	r.interactions = f.Interactions
+	r.replayed = make([]bool, len(f.Interactions))
+	return r, nil
+}
+
+//Recording reports whether the recorder sends the requests
+func (r *Recorder) Recording() bool {
+	return r.mode == Record
+}
+
+//Configure makes the clients built from c go through the recorder, in place
+//of c.HTTPClient. When replaying, c is given a placeholder API key if it holds
+//no credentials.
+func (r *Recorder) Configure(c *bluemix.Config) {
+	c.Transport = r
+	c.HTTPClient = nil
+	if r.mode != Replay {
+		return
+	}
+	if err, ok := c.ValidateConfigForService(bluemix.ContainerService).(bmxerror.Error); ok && err.Code() == bluemix.ErrInsufficientCredentials {
+		c.BluemixAPIKey = replayAPIKey
+	}
+}
+
+//RoundTrip ...
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorded, err := newRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if r.mode == Replay {
+		return r.replay(req, recorded)
+	}
+
+	resp, err := r.rt.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	i := Interaction{
+		Request: recorded,
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     sanitizeHeader(resp.Header),
+		},
+	}
+	if utf8.Valid(body) {
+		i.Response.Body = trace.Sanitize(string(body))
+	} else {
+		i.Response.BinaryBody = body
+	}
+	r.mu.Lock()
+	r.interactions = append(r.interactions, i)
+	r.mu.Unlock()
+	return resp, nil
+}
+
+//Stop saves the recorded interactions to the cassette file, it does nothing
+//when replaying
+func (r *Recorder) Stop() error {
+	if r.mode != Record {
+		return nil
+	}
+	r.mu.Lock()
+	f := file{Version: version, Interactions: r.interactions}
+	raw, err := json.MarshalIndent(f, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path, raw, 0644)
+}
+
+//replay returns the response of the first interaction not yet replayed with
+//the method and URL of the request
+func (r *Recorder) replay(req *http.Request, recorded Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for n, i := range r.interactions {
+		if r.replayed[n] || i.Request.Method != recorded.Method || i.Request.URL != recorded.URL {
+			continue
+		}
+		r.replayed[n] = true
+		body := i.Response.BinaryBody
+		if body == nil {
+			body = []byte(i.Response.Body)
+		}
+		header := i.Response.Header.Clone()
+		if header == nil {
+			header = http.Header{}
+		}
+		header.Del("Content-Length")
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", i.Response.StatusCode, http.StatusText(i.Response.StatusCode)),
+			StatusCode:    i.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          ioutil.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("No interaction of cassette %s left for %s %s", r.path, recorded.Method, recorded.URL)
+}
+
+func newRequest(req *http.Request) (Request, error) {
+	recorded := Request{
+		Method: req.Method,
+		URL:    trace.Sanitize(req.URL.String()),
+		Header: sanitizeHeader(req.Header),
+	}
+	if req.Body == nil || req.Body == http.NoBody {
+		return recorded, nil
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return Request{}, err
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if utf8.Valid(body) {
+		recorded.Body = trace.Sanitize(string(body))
+	}
+	return recorded, nil
+}
+
+func sanitizeHeader(h http.Header) http.Header {
+	out := h.Clone()
+	for _, name := range trace.SensitiveHeaders {
+		if out.Get(name) != "" {
+			out.Set(name, "[PRIVATE DATA HIDDEN]")
+		}
+	}
+	return out
+}
@@ -0,0 +1,128 @@
+package cassette_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/cassette"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Recorder", func() {
+	var (
+		server *ghttp.Server
+		path   string
+	)
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		dir, err := ioutil.TempDir("", "cassette")
+		Expect(err).NotTo(HaveOccurred())
+		path = filepath.Join(dir, "cassette.json")
+	})
+	AfterEach(func() {
+		server.Close()
+		os.RemoveAll(filepath.Dir(path))
+	})
+
+	get := func(rec *cassette.Recorder, url string) (*http.Response, string, error) {
+		req, _ := http.NewRequest(http.MethodGet, url, nil)
+		req.Header.Set("Authorization", "Bearer secret")
+		resp, err := (&http.Client{Transport: rec}).Do(req)
+		if err != nil {
+			return nil, "", err
+		}
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		return resp, string(body), err
+	}
+
+	record := func() {
+		server.AppendHandlers(
+			ghttp.RespondWith(http.StatusOK, `{"id":"c1","refresh_token":"r1"}`, http.Header{
+				"Content-Type": {"application/json"},
+			}),
+			ghttp.RespondWith(http.StatusNotFound, `{"code":"E0001"}`),
+		)
+		rec, err := cassette.New(path, cassette.Record, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rec.Recording()).To(BeTrue())
+		_, body, err := get(rec, server.URL()+"/v2/getCluster?cluster=c1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(body).To(Equal(`{"id":"c1","refresh_token":"r1"}`))
+		_, _, err = get(rec, server.URL()+"/v2/getCluster?cluster=c2")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rec.Stop()).To(Succeed())
+	}
+
+	It("should save the sanitized interactions", func() {
+		record()
+		raw, err := ioutil.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(raw)).NotTo(ContainSubstring("secret"))
+		Expect(string(raw)).NotTo(ContainSubstring(`"r1"`))
+		Expect(string(raw)).To(ContainSubstring("/v2/getCluster?cluster=c1"))
+	})
+
+	It("should replay the interactions without sending requests", func() {
+		record()
+		url := server.URL()
+		server.Close()
+		rec, err := cassette.New(path, cassette.ReplayOrRecord, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rec.Recording()).To(BeFalse())
+
+		resp, body, err := get(rec, url+"/v2/getCluster?cluster=c2")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+		Expect(body).To(Equal(`{"code":"E0001"}`))
+
+		resp, body, err = get(rec, url+"/v2/getCluster?cluster=c1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(resp.Header.Get("Content-Type")).To(Equal("application/json"))
+		Expect(body).To(ContainSubstring(`"id":"c1"`))
+
+		_, _, err = get(rec, url+"/v2/getCluster?cluster=c1")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("No interaction"))
+	})
+
+	It("should record when the cassette does not exist", func() {
+		rec, err := cassette.New(path, cassette.ReplayOrRecord, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rec.Recording()).To(BeTrue())
+	})
+
+	It("should reject an invalid cassette", func() {
+		Expect(ioutil.WriteFile(path, []byte(`{"version":2}`), 0644)).To(Succeed())
+		_, err := cassette.New(path, cassette.Replay, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	Describe("Configure", func() {
+		It("should give a placeholder API key to replayed configs without credentials", func() {
+			record()
+			rec, err := cassette.New(path, cassette.Replay, nil)
+			Expect(err).NotTo(HaveOccurred())
+			c := &bluemix.Config{Region: "us-south", HTTPClient: &http.Client{}}
+			rec.Configure(c)
+			Expect(c.Transport).To(Equal(rec))
+			Expect(c.HTTPClient).To(BeNil())
+			Expect(c.ValidateConfigForService(bluemix.VpcContainerService)).To(Succeed())
+		})
+		It("should keep the credentials of the config", func() {
+			record()
+			rec, err := cassette.New(path, cassette.Replay, nil)
+			Expect(err).NotTo(HaveOccurred())
+			c := &bluemix.Config{IAMAccessToken: "Bearer token"}
+			rec.Configure(c)
+			Expect(c.BluemixAPIKey).To(BeEmpty())
+			Expect(c.IAMAccessToken).To(Equal("Bearer token"))
+		})
+	})
+})
@@ -0,0 +1,90 @@
+package bluemix
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+)
+
+//CircuitBreaker stops the service clients from sending requests to an endpoint
+//host failing repeatedly. Set on the config of a session, it is shared by all
+//the clients created from the session. The circuit of a host opens after
+//Threshold consecutive failures, the requests to the host then fail at once
+//with ErrCircuitOpenCode until the cool-down period is over. A single request
+//is then let through, the circuit closes again if it succeeds.
+type CircuitBreaker struct {
+	threshold int
+	coolDown  time.Duration
+
+	mu    sync.Mutex
+	hosts map[string]*circuit
+}
+
+type circuit struct {
+	failures  int
+	openUntil time.Time
+}
+
+//NewCircuitBreaker returns a breaker opening after threshold consecutive
+//failures, for coolDown. A threshold of 0 or less disables it.
+func NewCircuitBreaker(threshold int, coolDown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		threshold: threshold,
+		coolDown:  coolDown,
+		hosts:     map[string]*circuit{},
+	}
+}
+
+//Allow returns an error when the circuit of the host is open
+func (b *CircuitBreaker) Allow(host string) error {
+	if b.threshold <= 0 {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c, ok := b.hosts[host]
+	if !ok || c.failures < b.threshold {
+		return nil
+	}
+	now := time.Now()
+	if now.Before(c.openUntil) {
+		return bmxerror.New(ErrCircuitOpenCode,
+			fmt.Sprintf("The requests to %s are suspended until %s after %d consecutive failures", host, c.openUntil.Format(time.RFC3339), c.failures))
+	}
+	//the request let through after the cool-down keeps the circuit open for
+	//the others until its outcome is recorded
+	c.openUntil = now.Add(b.coolDown)
+	return nil
+}
+
+//Record records the outcome of a request sent to the host
+func (b *CircuitBreaker) Record(host string, success bool) {
+	if b.threshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if success {
+		delete(b.hosts, host)
+		return
+	}
+	c, ok := b.hosts[host]
+	if !ok {
+		c = &circuit{}
+		b.hosts[host] = c
+	}
+	c.failures++
+	if c.failures == b.threshold {
+		c.openUntil = time.Now().Add(b.coolDown)
+	}
+}
+
+//Open reports whether the circuit of the host is open
+func (b *CircuitBreaker) Open(host string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c, ok := b.hosts[host]
+	return ok && b.threshold > 0 && c.failures >= b.threshold && time.Now().Before(c.openUntil)
+}
@@ -0,0 +1,53 @@
+package bluemix_test
+
+import (
+	"time"
+
+	. "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CircuitBreaker", func() {
+	fail := func(b *CircuitBreaker, host string, n int) {
+		for i := 0; i < n; i++ {
+			Expect(b.Allow(host)).To(Succeed())
+			b.Record(host, false)
+		}
+	}
+
+	It("should open after the consecutive failures", func() {
+		b := NewCircuitBreaker(3, time.Minute)
+		fail(b, "containers.cloud.ibm.com", 2)
+		Expect(b.Open("containers.cloud.ibm.com")).To(BeFalse())
+		fail(b, "containers.cloud.ibm.com", 1)
+		Expect(b.Open("containers.cloud.ibm.com")).To(BeTrue())
+		err := b.Allow("containers.cloud.ibm.com")
+		Expect(err).To(HaveOccurred())
+		Expect(err.(bmxerror.Error).Code()).To(Equal(ErrCircuitOpenCode))
+		Expect(b.Allow("iam.cloud.ibm.com")).To(Succeed())
+	})
+	It("should reset the failures after a success", func() {
+		b := NewCircuitBreaker(2, time.Minute)
+		fail(b, "containers.cloud.ibm.com", 1)
+		b.Record("containers.cloud.ibm.com", true)
+		fail(b, "containers.cloud.ibm.com", 1)
+		Expect(b.Open("containers.cloud.ibm.com")).To(BeFalse())
+	})
+	It("should let a single request through after the cool-down", func() {
+		b := NewCircuitBreaker(1, 50*time.Millisecond)
+		fail(b, "containers.cloud.ibm.com", 1)
+		time.Sleep(60 * time.Millisecond)
+		Expect(b.Allow("containers.cloud.ibm.com")).To(Succeed())
+		Expect(b.Allow("containers.cloud.ibm.com")).NotTo(Succeed())
+		b.Record("containers.cloud.ibm.com", true)
+		Expect(b.Allow("containers.cloud.ibm.com")).To(Succeed())
+	})
+	It("should be disabled without a threshold", func() {
+		b := NewCircuitBreaker(0, time.Minute)
+		b.Record("containers.cloud.ibm.com", false)
+		Expect(b.Allow("containers.cloud.ibm.com")).To(Succeed())
+	})
+})
@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	gohttp "net/http"
+	"net/url"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/rest"
+)
+
+type callKey struct{}
+
+//apiCall tracks an API call across its attempts, for the tracer and the
+//metrics recorder of the config
+type apiCall struct {
+	info     bluemix.APICall
+	start    time.Time
+	attempts int
+	span     bluemix.Span
+	metrics  bluemix.MetricsRecorder
+}
+
+//startCall returns nil when the config has neither a tracer nor a metrics
+//recorder, the methods of apiCall accept a nil receiver
+func (c *Client) startCall(r *rest.Request) *apiCall {
+	if c.Config.Tracer == nil && c.Config.Metrics == nil {
+		return nil
+	}
+	call := &apiCall{
+		info: bluemix.APICall{
+			Service: c.ServiceName,
+			Method:  r.HTTPMethod(),
+			URL:     r.RawURL(),
+		},
+		start:   time.Now(),
+		metrics: c.Config.Metrics,
+	}
+	if u, err := url.Parse(r.RawURL()); err == nil {
+		call.info.Operation = u.Path
+	}
+	ctx := r.Context()
+	if c.Config.Tracer != nil {
+		ctx, call.span = c.Config.Tracer.StartSpan(ctx, call.info)
+	}
+	r.WithContext(context.WithValue(ctx, callKey{}, call))
+	return call
+}
+
+//countAttempt counts a request sent for the call of the context, if any
+func countAttempt(ctx context.Context) {
+	if call, ok := ctx.Value(callKey{}).(*apiCall); ok {
+		call.attempts++
+	}
+}
+
+func (call *apiCall) end(resp *gohttp.Response, err error) {
+	if call == nil {
+		return
+	}
+	result := bluemix.APICallResult{
+		Attempts: call.attempts,
+		Duration: time.Since(call.start),
+		Err:      err,
+	}
+	if resp != nil {
+		result.StatusCode = resp.StatusCode
+		result.TransactionID = bluemix.TransactionID(resp.Header)
+	}
+	if call.span != nil {
+		call.span.End(result)
+	}
+	if call.metrics != nil {
+		call.metrics.RecordAPICall(call.info, result)
+	}
+}
@@ -0,0 +1,149 @@
+package client_test
+
+import (
+	"context"
+	gohttp "net/http"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	. "github.com/IBM-Cloud/bluemix-go/client"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type spanKey struct{}
+
+type fakeTracer struct {
+	calls   []bluemix.APICall
+	results []bluemix.APICallResult
+}
+
+func (t *fakeTracer) StartSpan(ctx context.Context, call bluemix.APICall) (context.Context, bluemix.Span) {
+	t.calls = append(t.calls, call)
+	return context.WithValue(ctx, spanKey{}, call.Operation), fakeSpan{t}
+}
+
+type fakeSpan struct {
+	t *fakeTracer
+}
+
+func (s fakeSpan) End(result bluemix.APICallResult) {
+	s.t.results = append(s.t.results, result)
+}
+
+var _ = Describe("Tracer", func() {
+	var server *ghttp.Server
+	var tracer *fakeTracer
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		tracer = &fakeTracer{}
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	newClient := func(retries int) *Client {
+		endpoint := server.URL()
+		delay := time.Millisecond
+		return New(&bluemix.Config{
+			Endpoint:       &endpoint,
+			HTTPClient:     gohttp.DefaultClient,
+			MaxRetries:     &retries,
+			RetryDelay:     &delay,
+			IAMAccessToken: "Bearer token",
+			Tracer:         tracer,
+		}, bluemix.VpcContainerService, nil)
+	}
+
+	It("should start a span per call and end it with the result", func() {
+		server.AppendHandlers(
+			ghttp.RespondWith(gohttp.StatusServiceUnavailable, `unavailable`),
+			ghttp.RespondWith(gohttp.StatusOK, `{}`, gohttp.Header{"Transaction-Id": {"tx-1"}}),
+		)
+		_, err := newClient(1).Get("/v2/getCluster?cluster=c1", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tracer.calls).To(HaveLen(1))
+		Expect(tracer.calls[0].Service).To(Equal(bluemix.VpcContainerService))
+		Expect(tracer.calls[0].Method).To(Equal(gohttp.MethodGet))
+		Expect(tracer.calls[0].Operation).To(Equal("/v2/getCluster"))
+		Expect(tracer.results).To(HaveLen(1))
+		Expect(tracer.results[0].Attempts).To(Equal(2))
+		Expect(tracer.results[0].StatusCode).To(Equal(gohttp.StatusOK))
+		Expect(tracer.results[0].TransactionID).To(Equal("tx-1"))
+		Expect(tracer.results[0].Err).NotTo(HaveOccurred())
+	})
+	It("should end the span with the error of a failed call", func() {
+		server.AppendHandlers(ghttp.RespondWith(gohttp.StatusNotFound, `not found`))
+		_, err := newClient(0).Get("/v2/getCluster", nil)
+		Expect(err).To(HaveOccurred())
+		Expect(tracer.results).To(HaveLen(1))
+		Expect(tracer.results[0].StatusCode).To(Equal(gohttp.StatusNotFound))
+		Expect(tracer.results[0].Err).To(Equal(err))
+	})
+	It("should send the request with the context of the span", func() {
+		var operation interface{}
+		c := newClient(0)
+		c.Use(bluemix.Interceptor{
+			Before: func(service bluemix.ServiceName, req *gohttp.Request) error {
+				operation = req.Context().Value(spanKey{})
+				return nil
+			},
+		})
+		server.AppendHandlers(ghttp.RespondWith(gohttp.StatusOK, `{}`))
+		_, err := c.Get("/v2/getCluster", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(operation).To(Equal("/v2/getCluster"))
+	})
+})
+
+type fakeMetrics struct {
+	calls   []bluemix.APICall
+	results []bluemix.APICallResult
+}
+
+func (m *fakeMetrics) RecordAPICall(call bluemix.APICall, result bluemix.APICallResult) {
+	m.calls = append(m.calls, call)
+	m.results = append(m.results, result)
+}
+
+var _ = Describe("MetricsRecorder", func() {
+	var server *ghttp.Server
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should record every call", func() {
+		server.AppendHandlers(
+			ghttp.RespondWith(gohttp.StatusOK, `{}`),
+			ghttp.RespondWith(gohttp.StatusConflict, `conflict`),
+		)
+		metrics := &fakeMetrics{}
+		endpoint := server.URL()
+		retries := 0
+		c := New(&bluemix.Config{
+			Endpoint:       &endpoint,
+			HTTPClient:     gohttp.DefaultClient,
+			MaxRetries:     &retries,
+			IAMAccessToken: "Bearer token",
+			Metrics:        metrics,
+		}, bluemix.VpcContainerService, nil)
+		_, err := c.Get("/v2/getClusters", nil)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.Post("/v2/createCluster", map[string]string{}, nil)
+		Expect(err).To(HaveOccurred())
+
+		Expect(metrics.calls).To(HaveLen(2))
+		Expect(metrics.calls[1].Method).To(Equal(gohttp.MethodPost))
+		Expect(metrics.calls[1].Operation).To(Equal("/v2/createCluster"))
+		Expect(metrics.results[0].StatusCode).To(Equal(gohttp.StatusOK))
+		Expect(metrics.results[0].Attempts).To(Equal(1))
+		Expect(metrics.results[0].Duration).To(BeNumerically(">", 0))
+		Expect(metrics.results[1].StatusCode).To(Equal(gohttp.StatusConflict))
+	})
+})
@@ -0,0 +1,39 @@
+package client
+
+import (
+	gohttp "net/http"
+	"net/url"
+
+	"github.com/IBM-Cloud/bluemix-go/rest"
+)
+
+//allow returns the endpoint host of the request, and an error when the circuit
+//breaker of the config has suspended the requests to the host
+func (c *Client) allow(r *rest.Request) (string, error) {
+	b := c.Config.CircuitBreaker
+	if b == nil {
+		return "", nil
+	}
+	u, err := url.Parse(r.RawURL())
+	if err != nil {
+		return "", nil
+	}
+	return u.Host, b.Allow(u.Host)
+}
+
+//record passes the outcome of the request to the circuit breaker of the config.
+//The network errors, the 429 and the 5XX responses are failures, the requests
+//canceled by their context are not recorded.
+func (c *Client) record(r *rest.Request, host string, resp *gohttp.Response, err error) {
+	b := c.Config.CircuitBreaker
+	if b == nil || host == "" {
+		return
+	}
+	if resp == nil {
+		if err != nil && r.Context().Err() == nil {
+			b.Record(host, false)
+		}
+		return
+	}
+	b.Record(host, resp.StatusCode < 500 && resp.StatusCode != gohttp.StatusTooManyRequests)
+}
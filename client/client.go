@@ -2,11 +2,12 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 	"net"
 	gohttp "net/http"
+	"net/url"
 	"path"
 	"strings"
 	"sync"
@@ -14,8 +15,10 @@ import (
 
 	bluemix "github.com/IBM-Cloud/bluemix-go"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/bluemix-go/http"
 	"github.com/IBM-Cloud/bluemix-go/rest"
+	"github.com/IBM-Cloud/bluemix-go/trace"
 )
 
 //TokenProvider ...
@@ -26,6 +29,19 @@ type TokenProvider interface {
 	AuthenticateAPIKey(string) error
 }
 
+//TrustedProfileTokenProvider is implemented by the token providers able to log
+//in with the trusted profile of the config
+type TrustedProfileTokenProvider interface {
+	AuthenticateTrustedProfile() error
+}
+
+//ContextTokenProvider is a TokenProvider whose token requests can be bound to
+//the context of the request that needed a new token
+type ContextTokenProvider interface {
+	TokenProvider
+	WithContext(ctx context.Context) TokenProvider
+}
+
 /*type PaginatedResourcesHandler interface {
     Resources(rawResponse []byte, curPath string) (resources []interface{}, nextPath string, err error)
 }
@@ -40,9 +56,15 @@ type Client struct {
 	DefaultHeader  gohttp.Header
 	ServiceName    bluemix.ServiceName
 	TokenRefresher TokenProvider
+	//ErrorHandler is optional. It converts the errors returned by the requests,
+	//for instance to decode the error payload of the service
+	ErrorHandler func(error) error
+	//Interceptors are the hooks of this client only, see Use
+	Interceptors []bluemix.Interceptor
 	//HandlePagination HandlePagination
 
 	headerLock sync.Mutex
+	ctx        context.Context
 }
 
 //Config stores any generic service client configurations
@@ -62,8 +84,65 @@ func New(c *bluemix.Config, serviceName bluemix.ServiceName, refresher TokenProv
 	}
 }
 
+//WithContext returns a copy of the client sending its requests with ctx, so
+//that they are canceled when ctx is done. The copy has its own DefaultHeader.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	return &Client{
+		Config:         c.Config,
+		DefaultHeader:  c.DefaultHeader.Clone(),
+		ServiceName:    c.ServiceName,
+		TokenRefresher: c.TokenRefresher,
+		ErrorHandler:   c.ErrorHandler,
+		Interceptors:   c.Interceptors,
+		ctx:            ctx,
+	}
+}
+
+//Logger returns the logger of the config, or the global trace logger when none is set
+func (c *Client) Logger() trace.StructuredLogger {
+	return trace.For(c.Config.Logger)
+}
+
+//Context returns the context of the client, context.Background() when none was set
+func (c *Client) Context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
 //SendRequest ...
 func (c *Client) SendRequest(r *rest.Request, respV interface{}) (*gohttp.Response, error) {
+	if c.ctx != nil && r.Context() == context.Background() {
+		r.WithContext(c.ctx)
+	}
+	if d := r.TimeoutDuration(); d > 0 {
+		ctx, cancel := context.WithTimeout(r.Context(), d)
+		defer cancel()
+		r.WithContext(ctx)
+	}
+	call := c.startCall(r)
+	resp, err := c.sendRequest(r, respV)
+	if err != nil {
+		err = bluemix.NewResponseError(c.ServiceName, resp, err)
+	}
+	if err != nil && c.ErrorHandler != nil {
+		err = c.ErrorHandler(err)
+	}
+	if err != nil {
+		err = c.onError(err)
+	}
+	call.end(resp, err)
+	return resp, err
+}
+
+func (c *Client) sendRequest(r *rest.Request, respV interface{}) (*gohttp.Response, error) {
+	if !r.Retryable() {
+		return c.MakeRequest(r, respV)
+	}
+	if c.Config.RetryPolicy != nil {
+		return c.sendWithRetryPolicy(c.Config.RetryPolicy, r, respV)
+	}
 
 	retries := *c.Config.MaxRetries
 	if retries < 1 {
@@ -76,15 +155,30 @@ func (c *Client) SendRequest(r *rest.Request, respV interface{}) (*gohttp.Respon
 
 // MakeRequest ...
 func (c *Client) MakeRequest(r *rest.Request, respV interface{}) (*gohttp.Response, error) {
-	httpClient := c.Config.HTTPClient
-	if httpClient == nil {
-		httpClient = gohttp.DefaultClient
+	if l := c.Config.RateLimiter; l != nil {
+		if err := l.Wait(r.Context(), c.ServiceName); err != nil {
+			return new(gohttp.Response), err
+		}
+	}
+	if err := c.renewExpiringToken(r); err != nil {
+		return new(gohttp.Response), err
+	}
+	host, err := c.allow(r)
+	if err != nil {
+		return new(gohttp.Response), err
 	}
 	restClient := &rest.Client{
 		DefaultHeader: c.DefaultHeader,
-		HTTPClient:    httpClient,
+		HTTPClient:    c.httpClient(r),
+		AcceptGzip:    c.Config.Compression,
+		GzipMinSize:   c.Config.GzipRequestMinSize,
 	}
+	dl, respV := newDownload(respV)
+	countAttempt(r.Context())
 	resp, err := restClient.Do(r, respV, nil)
+	err = dl.result(err)
+	c.observe(r, resp)
+	c.record(r, host, resp, err)
 	// The response returned by go HTTP client.Do() could be nil if request timeout.
 	// For convenience, we ensure that response returned by this method is always not nil.
 	if resp == nil {
@@ -92,25 +186,43 @@ func (c *Client) MakeRequest(r *rest.Request, respV interface{}) (*gohttp.Respon
 	}
 	if err != nil {
 		if (resp.StatusCode == 401 || resp.StatusCode == 403) && c.TokenRefresher != nil {
-			log.Println("Authentication failed. Trying token refresh")
-			c.headerLock.Lock()
-			defer c.headerLock.Unlock()
+			logger := c.Logger()
+			logger.Info("Authentication failed. Trying token refresh", "status", resp.StatusCode)
+			if c.Config.OnTokenExpired != nil {
+				c.Config.OnTokenExpired(c.ServiceName)
+			}
+			//the refresh runs unlocked so that the token providers can share
+			//one refresh between the requests failing at the same time
+			refresher := c.TokenRefresher
+			if p, ok := refresher.(ContextTokenProvider); ok {
+				refresher = p.WithContext(r.Context())
+			}
 			var err error
+			tp, trustedProfile := refresher.(TrustedProfileTokenProvider)
 			if c.Config.BluemixAPIKey != "" {
-				log.Println("Retrying authentication using API Key")
-				err = c.TokenRefresher.AuthenticateAPIKey(c.Config.BluemixAPIKey)
+				logger.Info("Retrying authentication using API Key")
+				err = refresher.AuthenticateAPIKey(c.Config.BluemixAPIKey)
+			} else if trustedProfile && c.Config.TrustedProfileAuth() {
+				logger.Info("Retrying authentication using the trusted profile")
+				err = tp.AuthenticateTrustedProfile()
 			} else {
-				log.Println("Retrying authentication using Refresh Token")
-				_, err = c.TokenRefresher.RefreshToken()
+				logger.Info("Retrying authentication using Refresh Token")
+				_, err = refresher.RefreshToken()
 			}
 			switch err.(type) {
 			case nil:
+				c.headerLock.Lock()
 				restClient.DefaultHeader = getDefaultAuthHeaders(c.ServiceName, c.Config)
 				for k := range c.DefaultHeader {
 					r.Del(k)
 				}
 				c.DefaultHeader = restClient.DefaultHeader
+				c.headerLock.Unlock()
+				countAttempt(r.Context())
 				resp, err := restClient.Do(r, respV, nil)
+				err = dl.result(err)
+				c.observe(r, resp)
+				c.record(r, host, resp, err)
 				if resp == nil {
 					return new(gohttp.Response), err
 				}
@@ -129,6 +241,48 @@ func (c *Client) MakeRequest(r *rest.Request, respV interface{}) (*gohttp.Respon
 	return resp, err
 }
 
+//observe passes the metadata of the response to the observer of the config
+//and to the metadata of the request context
+func (c *Client) observe(r *rest.Request, resp *gohttp.Response) {
+	m, ok := r.Context().Value(metadataKey{}).(*bluemix.ResponseMetadata)
+	if resp == nil || (!ok && c.Config.ResponseObserver == nil) {
+		return
+	}
+	metadata := bluemix.NewResponseMetadata(c.ServiceName, resp)
+	if ok {
+		*m = metadata
+	}
+	if c.Config.ResponseObserver != nil {
+		c.Config.ResponseObserver(metadata)
+	}
+}
+
+//sendWithRetryPolicy retries the request with the backoff of the policy, or
+//after the delay of the Retry-After header, as long as it fails with a
+//transient error and its method is retryable
+func (c *Client) sendWithRetryPolicy(p *bluemix.RetryPolicy, r *rest.Request, respV interface{}) (*gohttp.Response, error) {
+	if !p.RetryableMethod(r.HTTPMethod()) {
+		return c.MakeRequest(r, respV)
+	}
+	for retry := 0; ; retry++ {
+		resp, err := c.MakeRequest(r, respV)
+		if err == nil || retry >= p.MaxRetries || !isRetryableWithPolicy(p, err) {
+			return resp, err
+		}
+		delay := p.Delay(retry)
+		if after, ok := bluemix.RetryAfter(resp); ok {
+			if after > p.RetryAfterLimit() {
+				return resp, err
+			}
+			delay = after
+		}
+		c.Logger().Warn("Request failed, retrying", "delay", delay, "error", err)
+		if ctxErr := helpers.Sleep(r.Context(), delay); ctxErr != nil {
+			return resp, ctxErr
+		}
+	}
+}
+
 func (c *Client) tryHTTPRequest(retries int, wait time.Duration, r *rest.Request, respV interface{}) (*gohttp.Response, error) {
 
 	resp, err := c.MakeRequest(r, respV)
@@ -140,7 +294,12 @@ func (c *Client) tryHTTPRequest(retries int, wait time.Duration, r *rest.Request
 			return resp, err
 		}
 		if retries--; retries >= 0 {
-			time.Sleep(wait)
+			if ctxErr := helpers.Sleep(r.Context(), wait); ctxErr != nil {
+				if resp == nil {
+					return new(gohttp.Response), ctxErr
+				}
+				return resp, ctxErr
+			}
 			return c.tryHTTPRequest(
 				retries, wait, r, respV)
 		}
@@ -226,12 +385,34 @@ func (c *Client) DeleteWithBody(path string, data interface{}, extraHeader ...in
 	return c.SendRequest(r, nil)
 }
 
+//Timeout can be passed along the extra headers of the requests, such as
+//c.Get(path, &v, header, Timeout(5*time.Minute)), to set the deadline of the
+//call, retries included, instead of Config.HTTPTimeout
+type Timeout time.Duration
+
+//IfMatch can be passed along the extra headers of the update requests, with
+//the ETag of the resource read, for the services supporting conditional
+//updates to reject the update with bmxerror.ErrPreconditionFailed when the
+//resource was changed meanwhile
+type IfMatch string
+
+//NoRetry can be passed along the extra headers of the requests which must be
+//sent once, such as the ones creating a resource, to disable the retries of
+//the config
+type NoRetry struct{}
+
 func addToRequestHeader(h interface{}, r *rest.Request) {
 	switch v := h.(type) {
 	case map[string]string:
 		for key, value := range v {
 			r.Set(key, value)
 		}
+	case Timeout:
+		r.Timeout(time.Duration(v))
+	case IfMatch:
+		r.Set("If-Match", string(v))
+	case NoRetry:
+		r.NoRetry()
 	}
 }
 
@@ -307,56 +488,65 @@ const (
 
 func getDefaultAuthHeaders(serviceName bluemix.ServiceName, c *bluemix.Config) gohttp.Header {
 	h := gohttp.Header{}
+	for k, v := range c.DefaultHeaders {
+		k = gohttp.CanonicalHeaderKey(k)
+		h[k] = append(h[k], v...)
+	}
 	h.Set(originalUserAgentHeader, c.UserAgent)
 	switch serviceName {
 	case bluemix.MccpService, bluemix.AccountService:
-		h.Set(userAgentHeader, http.UserAgent())
+		h.Set(userAgentHeader, http.ConfigUserAgent(c))
 		h.Set(authorizationHeader, c.UAAAccessToken)
 	case bluemix.ContainerService:
-		h.Set(userAgentHeader, http.UserAgent())
+		h.Set(userAgentHeader, http.ConfigUserAgent(c))
 		h.Set(authorizationHeader, c.IAMAccessToken)
 		h.Set(iamRefreshTokenHeader, c.IAMRefreshToken)
 		h.Set(uaaAccessTokenHeader, c.UAAAccessToken)
 	case bluemix.VpcContainerService:
-		h.Set(userAgentHeader, http.UserAgent())
+		h.Set(userAgentHeader, http.ConfigUserAgent(c))
 		h.Set(authorizationHeader, c.IAMAccessToken)
 		h.Set(iamRefreshTokenHeader, c.IAMRefreshToken)
 	case bluemix.SchematicsService:
-		h.Set(userAgentHeader, http.UserAgent())
+		h.Set(userAgentHeader, http.ConfigUserAgent(c))
 		h.Set(authorizationHeader, c.IAMAccessToken)
 		h.Set(iamRefreshTokenHeader, c.IAMRefreshToken)
 	case bluemix.ContainerRegistryService:
-		h.Set(userAgentHeader, http.UserAgent())
+		h.Set(userAgentHeader, http.ConfigUserAgent(c))
 		h.Set(authorizationHeader, c.IAMAccessToken)
 		h.Set(crRefreshTokenHeader, c.IAMRefreshToken)
 	case bluemix.IAMPAPService, bluemix.AccountServicev1, bluemix.ResourceCatalogrService, bluemix.ResourceControllerService, bluemix.ResourceControllerServicev2, bluemix.ResourceManagementService, bluemix.ResourceManagementServicev2, bluemix.IAMService, bluemix.IAMUUMService, bluemix.IAMUUMServicev2, bluemix.IAMPAPServicev2, bluemix.CseService:
 		h.Set(authorizationHeader, c.IAMAccessToken)
-		h.Set(userAgentHeader, http.UserAgent())
+		h.Set(userAgentHeader, http.ConfigUserAgent(c))
 	case bluemix.UserManagement:
-		h.Set(userAgentHeader, http.UserAgent())
+		h.Set(userAgentHeader, http.ConfigUserAgent(c))
 		h.Set(authorizationHeader, c.IAMAccessToken)
 	case bluemix.CisService:
-		h.Set(userAgentHeader, http.UserAgent())
+		h.Set(userAgentHeader, http.ConfigUserAgent(c))
 		h.Set(userAccessTokenHeader, c.IAMAccessToken)
 	case bluemix.GlobalSearchService, bluemix.GlobalTaggingService:
-		h.Set(userAgentHeader, http.UserAgent())
+		h.Set(userAgentHeader, http.ConfigUserAgent(c))
 		h.Set(authorizationHeader, c.IAMAccessToken)
 		h.Set(iamRefreshTokenHeader, c.IAMRefreshToken)
 	case bluemix.ICDService:
-		h.Set(userAgentHeader, http.UserAgent())
+		h.Set(userAgentHeader, http.ConfigUserAgent(c))
 		h.Set(authorizationHeader, c.IAMAccessToken)
 	case bluemix.CertificateManager:
-		h.Set(userAgentHeader, http.UserAgent())
+		h.Set(userAgentHeader, http.ConfigUserAgent(c))
 		h.Set(authorizationHeader, c.IAMAccessToken)
 	case bluemix.HPCService:
-		h.Set(userAgentHeader, http.UserAgent())
+		h.Set(userAgentHeader, http.ConfigUserAgent(c))
 		h.Set(authorizationHeader, c.IAMAccessToken)
 	case bluemix.FunctionsService:
-		h.Set(userAgentHeader, http.UserAgent())
+		h.Set(userAgentHeader, http.ConfigUserAgent(c))
+		h.Set(authorizationHeader, c.IAMAccessToken)
+	case bluemix.COSConfigService, bluemix.CodeEngineService, bluemix.EventNotificationsService, bluemix.AppConfigurationService:
+		h.Set(userAgentHeader, http.ConfigUserAgent(c))
 		h.Set(authorizationHeader, c.IAMAccessToken)
+	case bluemix.ActivityTrackerService:
+		h.Set(userAgentHeader, http.ConfigUserAgent(c))
 
 	default:
-		log.Println("Unknown service - No auth headers set")
+		trace.For(c.Logger).Warn("Unknown service - No auth headers set", "service", serviceName)
 	}
 	return h
 }
@@ -384,6 +574,20 @@ func isTimeout(err error) bool {
 	return false
 }
 
+func isRetryableWithPolicy(p *bluemix.RetryPolicy, err error) bool {
+	if bmErr, ok := err.(bmxerror.RequestFailure); ok {
+		return p.RetryableStatus(bmErr.StatusCode())
+	}
+	if urlErr, ok := err.(*url.Error); ok {
+		err = urlErr.Err
+	}
+	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+		return true
+	}
+	_, ok := err.(*net.OpError)
+	return ok
+}
+
 func isRetryable(err error) bool {
 	return isTimeout(err)
 }
@@ -0,0 +1,532 @@
+package client_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	gohttp "net/http"
+	"strings"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	. "github.com/IBM-Cloud/bluemix-go/client"
+	"github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/rest"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Client", func() {
+	var server *ghttp.Server
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	newClient := func(headers gohttp.Header) *Client {
+		endpoint := server.URL()
+		maxRetries := 0
+		return New(&bluemix.Config{
+			Endpoint:       &endpoint,
+			HTTPClient:     gohttp.DefaultClient,
+			MaxRetries:     &maxRetries,
+			IAMAccessToken: "Bearer token",
+			DefaultHeaders: headers,
+		}, bluemix.VpcContainerService, nil)
+	}
+
+	Describe("DefaultHeaders", func() {
+		It("should send the default headers on every request", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(gohttp.MethodGet, "/v2/getClusters"),
+					ghttp.VerifyHeaderKV("X-Correlation-Id", "abc"),
+					ghttp.VerifyHeaderKV("Authorization", "Bearer token"),
+					ghttp.RespondWith(gohttp.StatusOK, `{}`),
+				),
+			)
+			_, err := newClient(gohttp.Header{"X-Correlation-Id": {"abc"}}).Get("/v2/getClusters", nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+		It("should not duplicate the headers set by the SDK", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(gohttp.MethodGet, "/v2/getClusters"),
+					func(w gohttp.ResponseWriter, r *gohttp.Request) {
+						Expect(r.Header.Values("User-Agent")).To(HaveLen(1))
+						Expect(r.Header.Get("User-Agent")).To(HavePrefix(strings.TrimSpace(http.UserAgent())))
+						Expect(r.Header.Values("Authorization")).To(Equal([]string{"Bearer token"}))
+						Expect(r.Header.Values("X-Request-Id")).To(ConsistOf("r1", "r2"))
+					},
+					ghttp.RespondWith(gohttp.StatusOK, `{}`),
+				),
+			)
+			headers := gohttp.Header{
+				"user-agent":    {"custom"},
+				"authorization": {"Bearer other"},
+				"x-request-id":  {"r1"},
+				"X-Request-Id":  {"r2"},
+			}
+			_, err := newClient(headers).Get("/v2/getClusters", nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+		It("should append the UserAgentSuffix to the User-Agent", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyHeaderKV("User-Agent", strings.TrimSpace(http.UserAgent())+" terraform-provider-ibm/1.50.0"),
+					ghttp.RespondWith(gohttp.StatusOK, `{}`),
+				),
+			)
+			endpoint := server.URL()
+			maxRetries := 0
+			c := New(&bluemix.Config{
+				Endpoint:        &endpoint,
+				HTTPClient:      gohttp.DefaultClient,
+				MaxRetries:      &maxRetries,
+				IAMAccessToken:  "Bearer token",
+				UserAgentSuffix: "terraform-provider-ibm/1.50.0",
+			}, bluemix.VpcContainerService, nil)
+			_, err := c.Get("/v2/getClusters", nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+	Describe("WithContext", func() {
+		It("should not share the default headers", func() {
+			c := newClient(nil)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			copied := c.WithContext(ctx)
+			Expect(copied.Context()).To(Equal(ctx))
+			Expect(c.Context()).To(Equal(context.Background()))
+
+			copied.DefaultHeader.Set("Authorization", "Bearer other")
+			Expect(c.DefaultHeader.Get("Authorization")).To(Equal("Bearer token"))
+		})
+	})
+	Describe("RetryPolicy", func() {
+		newRetryClient := func(p *bluemix.RetryPolicy) *Client {
+			c := newClient(nil)
+			c.Config.RetryPolicy = p
+			return c
+		}
+		policy := func() *bluemix.RetryPolicy {
+			return &bluemix.RetryPolicy{MaxRetries: 2, InitialDelay: time.Millisecond}
+		}
+
+		It("should retry until the request succeeds", func() {
+			server.AppendHandlers(
+				ghttp.RespondWith(gohttp.StatusServiceUnavailable, `{}`),
+				ghttp.RespondWith(gohttp.StatusOK, `{}`),
+			)
+			_, err := newRetryClient(policy()).Get("/v1/resources", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(2))
+		})
+		It("should stop after MaxRetries", func() {
+			server.AppendHandlers(
+				ghttp.RespondWith(gohttp.StatusBadGateway, `{}`),
+				ghttp.RespondWith(gohttp.StatusBadGateway, `{}`),
+				ghttp.RespondWith(gohttp.StatusBadGateway, `{}`),
+			)
+			_, err := newRetryClient(policy()).Get("/v1/resources", nil)
+			Expect(err).To(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(3))
+		})
+		It("should not retry a status that is not retryable", func() {
+			server.AppendHandlers(
+				ghttp.RespondWith(gohttp.StatusBadRequest, `{}`),
+			)
+			_, err := newRetryClient(policy()).Get("/v1/resources", nil)
+			Expect(err).To(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+		It("should not retry POST by default", func() {
+			server.AppendHandlers(
+				ghttp.RespondWith(gohttp.StatusServiceUnavailable, `{}`),
+			)
+			_, err := newRetryClient(policy()).Post("/v1/resources", map[string]string{}, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+		It("should retry the methods of the policy", func() {
+			server.AppendHandlers(
+				ghttp.RespondWith(gohttp.StatusServiceUnavailable, `{}`),
+				ghttp.RespondWith(gohttp.StatusOK, `{}`),
+			)
+			p := policy()
+			p.RetryableMethods = []string{gohttp.MethodPost}
+			_, err := newRetryClient(p).Post("/v1/resources", map[string]string{}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(2))
+		})
+		It("should wait for the delay of the Retry-After header", func() {
+			server.AppendHandlers(
+				ghttp.RespondWith(gohttp.StatusTooManyRequests, `{}`, gohttp.Header{"Retry-After": {"0"}}),
+				ghttp.RespondWith(gohttp.StatusOK, `{}`),
+			)
+			p := &bluemix.RetryPolicy{MaxRetries: 2, InitialDelay: time.Hour}
+			_, err := newRetryClient(p).Get("/v1/resources", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(2))
+		})
+		It("should not wait longer than MaxRetryAfter", func() {
+			server.AppendHandlers(
+				ghttp.RespondWith(gohttp.StatusServiceUnavailable, `{}`, gohttp.Header{"Retry-After": {"120"}}),
+			)
+			p := policy()
+			p.MaxRetryAfter = time.Second
+			_, err := newRetryClient(p).Get("/v1/resources", nil)
+			Expect(err).To(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+		It("should stop waiting when the context is canceled", func() {
+			server.AppendHandlers(
+				ghttp.RespondWith(gohttp.StatusServiceUnavailable, `{}`),
+			)
+			ctx, cancel := context.WithCancel(context.Background())
+			p := &bluemix.RetryPolicy{MaxRetries: 2, InitialDelay: time.Hour}
+			time.AfterFunc(50*time.Millisecond, cancel)
+			start := time.Now()
+			_, err := newRetryClient(p).WithContext(ctx).Get("/v1/resources", nil)
+			Expect(err).To(Equal(context.Canceled))
+			Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+	Describe("NoRetry", func() {
+		It("should not retry with the RetryPolicy", func() {
+			server.AppendHandlers(
+				ghttp.RespondWith(gohttp.StatusServiceUnavailable, `{}`),
+			)
+			c := newClient(nil)
+			c.Config.RetryPolicy = &bluemix.RetryPolicy{MaxRetries: 2, InitialDelay: time.Millisecond, RetryableMethods: []string{gohttp.MethodPost}}
+			_, err := c.Post("/v1/resources", nil, nil, NoRetry{})
+			Expect(err).To(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+		It("should not retry with MaxRetries", func() {
+			server.AppendHandlers(
+				ghttp.RespondWith(gohttp.StatusServiceUnavailable, `{}`),
+			)
+			c := newClient(nil)
+			maxRetries, delay := 2, time.Millisecond
+			c.Config.MaxRetries, c.Config.RetryDelay = &maxRetries, &delay
+			_, err := c.SendRequest(rest.GetRequest(server.URL()+"/v1/resources").NoRetry(), nil)
+			Expect(err).To(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+	Describe("RateLimiter", func() {
+		It("should wait for the limiter before each request", func() {
+			server.AppendHandlers(
+				ghttp.RespondWith(gohttp.StatusOK, `{}`),
+			)
+			c := newClient(nil)
+			c.Config.RateLimiter = bluemix.NewRateLimiter(0.1, 1)
+			_, err := c.Get("/v1/resources", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+			_, err = c.WithContext(ctx).Get("/v1/resources", nil)
+			Expect(err).To(Equal(context.DeadlineExceeded))
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+	Describe("Timeout", func() {
+		slow := func(w gohttp.ResponseWriter, r *gohttp.Request) {
+			time.Sleep(200 * time.Millisecond)
+		}
+
+		It("should cancel the call after its timeout", func() {
+			server.AppendHandlers(ghttp.CombineHandlers(slow, ghttp.RespondWith(gohttp.StatusOK, `{}`)))
+			start := time.Now()
+			_, err := newClient(nil).Get("/v2/getCluster", nil, Timeout(50*time.Millisecond))
+			Expect(err).To(HaveOccurred())
+			Expect(time.Since(start)).To(BeNumerically("<", 200*time.Millisecond))
+		})
+		It("should replace the timeout of the HTTP client", func() {
+			server.AppendHandlers(ghttp.CombineHandlers(slow, ghttp.RespondWith(gohttp.StatusOK, `{}`)))
+			c := newClient(nil)
+			c.Config.HTTPClient = &gohttp.Client{Timeout: 50 * time.Millisecond}
+			_, err := c.Get("/v2/getClusterConfig", nil, map[string]string{"X-Region": "us-south"}, Timeout(time.Second))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(server.ReceivedRequests()[0].Header.Get("X-Region")).To(Equal("us-south"))
+		})
+	})
+	Describe("Download", func() {
+		It("should stream the response body to the writer", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(gohttp.MethodGet, "/v2/getClusterConfig"),
+					ghttp.RespondWith(gohttp.StatusOK, "archive", gohttp.Header{"Content-Type": {"application/zip"}}),
+				),
+			)
+			var out bytes.Buffer
+			_, err := newClient(nil).Download("/v2/getClusterConfig", &out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.String()).To(Equal("archive"))
+		})
+		It("should not retry once a part of the body was written", func() {
+			server.AppendHandlers(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+				w.Header().Set("Content-Length", "100")
+				w.Write([]byte("part"))
+				w.(gohttp.Flusher).Flush()
+				time.Sleep(300 * time.Millisecond)
+			})
+			c := newClient(nil)
+			maxRetries, delay := 1, time.Millisecond
+			c.Config.MaxRetries, c.Config.RetryDelay = &maxRetries, &delay
+			c.Config.HTTPClient = &gohttp.Client{Timeout: 100 * time.Millisecond}
+			var out bytes.Buffer
+			_, err := c.Download("/v2/getClusterConfig", &out)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("interrupted after 4 bytes"))
+			Expect(out.String()).To(Equal("part"))
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+	Describe("Stream", func() {
+		It("should send the content with its length", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(gohttp.MethodPut, "/v1/workspaces/ws/template_data/t/template_repo_upload"),
+					ghttp.VerifyHeaderKV("Content-Type", "application/x-tar"),
+					ghttp.VerifyBody([]byte("archive")),
+					func(w gohttp.ResponseWriter, r *gohttp.Request) {
+						Expect(r.ContentLength).To(BeEquivalentTo(7))
+						Expect(r.TransferEncoding).To(BeEmpty())
+					},
+					ghttp.RespondWith(gohttp.StatusOK, `{}`),
+				),
+			)
+			content := struct{ io.Reader }{strings.NewReader("archive and more")}
+			_, err := newClient(nil).Put("/v1/workspaces/ws/template_data/t/template_repo_upload", rest.NewStream(content, 7, "application/x-tar"), nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		retryingClient := func() *Client {
+			server.AppendHandlers(
+				ghttp.RespondWith(gohttp.StatusServiceUnavailable, `{}`),
+				ghttp.CombineHandlers(
+					ghttp.VerifyBody([]byte("archive")),
+					ghttp.RespondWith(gohttp.StatusOK, `{}`),
+				),
+			)
+			c := newClient(nil)
+			maxRetries, delay := 1, time.Millisecond
+			c.Config.MaxRetries, c.Config.RetryDelay = &maxRetries, &delay
+			return c
+		}
+		It("should rewind seekable content to retry", func() {
+			content := strings.NewReader("archive")
+			_, err := retryingClient().Put("/v1/upload", rest.NewStream(content, 7, ""), nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(2))
+		})
+		It("should not retry content read once", func() {
+			content := struct{ io.Reader }{strings.NewReader("archive")}
+			_, err := retryingClient().Put("/v1/upload", rest.NewStream(content, 7, ""), nil)
+			Expect(err).To(Equal(rest.ErrStreamConsumed))
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+	Describe("IfMatch", func() {
+		It("should send the ETag of the resource read", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyHeaderKV("If-Match", `"v1"`),
+					ghttp.RespondWith(gohttp.StatusOK, `{}`, gohttp.Header{"Etag": {`"v2"`}}),
+				),
+			)
+			var responses []bluemix.ResponseMetadata
+			c := newClient(nil)
+			c.Config.ResponseObserver = func(m bluemix.ResponseMetadata) {
+				responses = append(responses, m)
+			}
+			_, err := c.Put("/v2/nlb-dns/updateSecret", map[string]string{}, nil, IfMatch(`"v1"`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(responses).To(HaveLen(1))
+			Expect(responses[0].ETag).To(Equal(`"v2"`))
+		})
+		It("should fail with ErrPreconditionFailed when the resource changed", func() {
+			server.AppendHandlers(ghttp.RespondWith(gohttp.StatusPreconditionFailed, `{}`))
+			_, err := newClient(nil).Put("/v2/nlb-dns/updateSecret", map[string]string{}, nil, IfMatch(`"v1"`))
+			Expect(errors.Is(err, bmxerror.ErrPreconditionFailed)).To(BeTrue())
+		})
+	})
+	Describe("Compression", func() {
+		gzipped := func(s string) string {
+			var b bytes.Buffer
+			w := gzip.NewWriter(&b)
+			w.Write([]byte(s))
+			w.Close()
+			return b.String()
+		}
+
+		It("should ask for and decompress gzip responses", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyHeaderKV("Accept-Encoding", "gzip"),
+					ghttp.RespondWith(gohttp.StatusOK, gzipped(`{"id":"c1"}`), gohttp.Header{"Content-Encoding": {"gzip"}}),
+				),
+			)
+			c := newClient(nil)
+			c.Config.Compression = true
+			var cluster struct {
+				ID string `json:"id"`
+			}
+			_, err := c.Get("/v2/getCluster", &cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cluster.ID).To(Equal("c1"))
+		})
+		It("should compress the large request bodies", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyHeaderKV("Content-Encoding", "gzip"),
+					func(w gohttp.ResponseWriter, r *gohttp.Request) {
+						gz, err := gzip.NewReader(r.Body)
+						Expect(err).NotTo(HaveOccurred())
+						body, err := ioutil.ReadAll(gz)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(body)).To(ContainSubstring(strings.Repeat("v", 100)))
+					},
+					ghttp.RespondWith(gohttp.StatusOK, `{}`),
+				),
+				ghttp.CombineHandlers(
+					func(w gohttp.ResponseWriter, r *gohttp.Request) {
+						Expect(r.Header.Get("Content-Encoding")).To(BeEmpty())
+					},
+					ghttp.RespondWith(gohttp.StatusOK, `{}`),
+				),
+			)
+			c := newClient(nil)
+			c.Config.GzipRequestMinSize = 100
+			_, err := c.Post("/v1/workspaces", map[string]string{"variables": strings.Repeat("v", 100)}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = c.Post("/v1/workspaces", map[string]string{"name": "w1"}, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+	Describe("CircuitBreaker", func() {
+		It("should stop sending requests to a failing host", func() {
+			server.AppendHandlers(
+				ghttp.RespondWith(gohttp.StatusBadGateway, `{}`),
+				ghttp.RespondWith(gohttp.StatusBadGateway, `{}`),
+			)
+			c := newClient(nil)
+			c.Config.CircuitBreaker = bluemix.NewCircuitBreaker(2, time.Minute)
+			for i := 0; i < 2; i++ {
+				_, err := c.Get("/v2/getClusters", nil)
+				Expect(err).To(HaveOccurred())
+			}
+			_, err := c.Get("/v2/getClusters", nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(bluemix.ErrCircuitOpenCode))
+			Expect(server.ReceivedRequests()).To(HaveLen(2))
+		})
+		It("should not count the client errors", func() {
+			server.AppendHandlers(
+				ghttp.RespondWith(gohttp.StatusNotFound, `{}`),
+				ghttp.RespondWith(gohttp.StatusNotFound, `{}`),
+			)
+			c := newClient(nil)
+			c.Config.CircuitBreaker = bluemix.NewCircuitBreaker(1, time.Minute)
+			c.Get("/v2/getCluster", nil)
+			c.Get("/v2/getCluster", nil)
+			Expect(server.ReceivedRequests()).To(HaveLen(2))
+		})
+	})
+	Describe("ResponseObserver", func() {
+		It("should receive the metadata of the successful responses", func() {
+			server.AppendHandlers(
+				ghttp.RespondWith(gohttp.StatusOK, `{}`, gohttp.Header{
+					"X-Ratelimit-Remaining": {"41"},
+					"X-Request-Id":          {"req-1"},
+				}),
+			)
+			var responses []bluemix.ResponseMetadata
+			c := newClient(nil)
+			c.Config.ResponseObserver = func(m bluemix.ResponseMetadata) {
+				responses = append(responses, m)
+			}
+			_, err := c.Get("/v2/getClusters", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(responses).To(HaveLen(1))
+			Expect(responses[0].Service).To(Equal(bluemix.VpcContainerService))
+			Expect(responses[0].Method).To(Equal(gohttp.MethodGet))
+			Expect(responses[0].URL).To(Equal(server.URL() + "/v2/getClusters"))
+			Expect(responses[0].StatusCode).To(Equal(gohttp.StatusOK))
+			Expect(responses[0].Header.Get("X-RateLimit-Remaining")).To(Equal("41"))
+			Expect(responses[0].TransactionID).To(Equal("req-1"))
+		})
+	})
+	Describe("WithResponseMetadata", func() {
+		It("should store the metadata of the response of the call", func() {
+			server.AppendHandlers(
+				ghttp.RespondWith(gohttp.StatusOK, `{}`, gohttp.Header{
+					"Deprecation": {"true"},
+					"Link":        {`<https://containers.cloud.ibm.com/v2/getClusters?start=2>; rel="next"`},
+				}),
+			)
+			var m bluemix.ResponseMetadata
+			c := newClient(nil).WithContext(WithResponseMetadata(context.Background(), &m))
+			_, err := c.Get("/v2/getClusters", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(m.StatusCode).To(Equal(gohttp.StatusOK))
+			Expect(m.Header.Get("Deprecation")).To(Equal("true"))
+			Expect(m.Links).To(Equal(map[string]string{"next": "https://containers.cloud.ibm.com/v2/getClusters?start=2"}))
+		})
+		It("should store the metadata of the failed calls", func() {
+			server.AppendHandlers(ghttp.RespondWith(gohttp.StatusNotFound, `{}`))
+			var m bluemix.ResponseMetadata
+			c := newClient(nil).WithContext(WithResponseMetadata(context.Background(), &m))
+			_, err := c.Get("/v2/getCluster", nil)
+			Expect(err).To(HaveOccurred())
+			Expect(m.StatusCode).To(Equal(gohttp.StatusNotFound))
+		})
+	})
+
+	Describe("ResponseError", func() {
+		It("should carry the transaction ID, status code and body of the failure", func() {
+			server.AppendHandlers(
+				ghttp.RespondWith(gohttp.StatusBadRequest, `{"code":"E0001"}`, gohttp.Header{
+					"Transaction-Id": {"tx-1"},
+				}),
+			)
+			_, err := newClient(nil).Get("/v2/getCluster", nil)
+			Expect(err).To(HaveOccurred())
+			respErr, ok := err.(*bluemix.ResponseError)
+			Expect(ok).To(BeTrue())
+			Expect(respErr.TransactionID()).To(Equal("tx-1"))
+			Expect(respErr.StatusCode()).To(Equal(gohttp.StatusBadRequest))
+			Expect(respErr.Body).To(Equal(`{"code":"E0001"}`))
+			Expect(respErr.Metadata.URL).To(Equal(server.URL() + "/v2/getCluster"))
+			Expect(err.Error()).To(ContainSubstring("transaction ID: tx-1"))
+		})
+
+		It("should match the category of the status code", func() {
+			server.AppendHandlers(ghttp.RespondWith(gohttp.StatusNotFound, `{}`))
+			_, err := newClient(nil).Get("/v2/getCluster", nil)
+			Expect(errors.Is(err, bmxerror.ErrNotFound)).To(BeTrue())
+			Expect(errors.Is(err, bmxerror.ErrConflict)).To(BeFalse())
+		})
+		It("should not wrap the errors without a response", func() {
+			c := newClient(nil)
+			server.Close()
+			_, err := c.Get("/v2/getCluster", nil)
+			Expect(err).To(HaveOccurred())
+			_, ok := err.(*bluemix.ResponseError)
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	gohttp "net/http"
+
+	"github.com/IBM-Cloud/bluemix-go/rest"
+)
+
+//Download streams the body of the response to w instead of keeping it in
+//memory, such as to save a large archive to a file
+func (c *Client) Download(path string, w io.Writer, extraHeader ...interface{}) (*gohttp.Response, error) {
+	r := rest.GetRequest(c.URL(path))
+	for _, t := range extraHeader {
+		addToRequestHeader(t, r)
+	}
+	return c.SendRequest(r, w)
+}
+
+//download counts the bytes of a response body written to w, so that a
+//request failing once a part of the body is written is not retried, which
+//would write that part again
+type download struct {
+	w io.Writer
+	n int64
+}
+
+func newDownload(respV interface{}) (*download, interface{}) {
+	if w, ok := respV.(io.Writer); ok {
+		d := &download{w: w}
+		return d, d
+	}
+	return nil, respV
+}
+
+func (d *download) Write(p []byte) (int, error) {
+	n, err := d.w.Write(p)
+	d.n += int64(n)
+	return n, err
+}
+
+//ReadFrom copies r with the io.ReaderFrom of w when it has one, such as a
+//bufio.Writer passing the body straight to its underlying writer
+func (d *download) ReadFrom(r io.Reader) (int64, error) {
+	n, err := io.Copy(d.w, r)
+	d.n += n
+	return n, err
+}
+
+//result returns a non retryable error for the downloads interrupted after
+//writing a part of the body
+func (d *download) result(err error) error {
+	if d == nil || err == nil || d.n == 0 {
+		return err
+	}
+	return fmt.Errorf("Download interrupted after %d bytes: %v", d.n, err)
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	gohttp "net/http"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/rest"
+)
+
+//Use appends the interceptor to the chain of the client, after the
+//interceptors of the config. It must not be called while requests are sent.
+func (c *Client) Use(i bluemix.Interceptor) {
+	c.Interceptors = append(c.Interceptors, i)
+}
+
+//interceptors returns the chain of the client, the interceptors of the config
+//followed by the ones of the client
+func (c *Client) interceptors() []bluemix.Interceptor {
+	if len(c.Interceptors) == 0 {
+		return c.Config.Interceptors
+	}
+	chain := make([]bluemix.Interceptor, 0, len(c.Config.Interceptors)+len(c.Interceptors))
+	chain = append(chain, c.Config.Interceptors...)
+	return append(chain, c.Interceptors...)
+}
+
+//httpClient returns the HTTP client of the config, wrapped to run the Before
+//and After hooks of the chain and the request signer when there are some. The
+//timeout of the client is lifted for the requests having their own.
+func (c *Client) httpClient(r *rest.Request) *gohttp.Client {
+	httpClient := c.Config.HTTPClient
+	if httpClient == nil {
+		httpClient = gohttp.DefaultClient
+	}
+	if r.TimeoutDuration() > 0 && httpClient.Timeout > 0 {
+		untimed := *httpClient
+		untimed.Timeout = 0
+		httpClient = &untimed
+	}
+	chain := c.interceptors()
+	if len(chain) == 0 && c.Config.RequestSigner == nil {
+		return httpClient
+	}
+	next := httpClient.Transport
+	if next == nil {
+		next = gohttp.DefaultTransport
+	}
+	wrapped := *httpClient
+	wrapped.Transport = &interceptTransport{service: c.ServiceName, chain: chain, signer: c.Config.RequestSigner, next: next}
+	return &wrapped
+}
+
+//onError runs the OnError hooks of the chain
+func (c *Client) onError(err error) error {
+	chain := c.interceptors()
+	for i := len(chain) - 1; i >= 0; i-- {
+		if chain[i].OnError != nil {
+			err = chain[i].OnError(c.ServiceName, err)
+		}
+	}
+	return err
+}
+
+type interceptTransport struct {
+	service bluemix.ServiceName
+	chain   []bluemix.Interceptor
+	signer  bluemix.RequestSigner
+	next    gohttp.RoundTripper
+}
+
+func (t *interceptTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	req = req.Clone(req.Context())
+	for _, i := range t.chain {
+		if i.Before != nil {
+			if err := i.Before(t.service, req); err != nil {
+				if req.Body != nil {
+					req.Body.Close()
+				}
+				return nil, err
+			}
+		}
+	}
+	if t.signer != nil {
+		if err := t.sign(req); err != nil {
+			return nil, err
+		}
+	}
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(t.chain) - 1; i >= 0; i-- {
+		if t.chain[i].After != nil {
+			t.chain[i].After(t.service, req, resp)
+		}
+	}
+	return resp, nil
+}
+
+//sign reads the body of the request for the signer and replaces it with a copy
+func (t *interceptTransport) sign(req *gohttp.Request) error {
+	var body []byte
+	if req.Body != nil && req.Body != gohttp.NoBody {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+	return t.signer.Sign(t.service, req, body)
+}
@@ -0,0 +1,152 @@
+package client_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	gohttp "net/http"
+	"strings"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	. "github.com/IBM-Cloud/bluemix-go/client"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Interceptors", func() {
+	var server *ghttp.Server
+	var calls []string
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		calls = nil
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	newClient := func(interceptors ...bluemix.Interceptor) *Client {
+		endpoint := server.URL()
+		maxRetries := 0
+		return New(&bluemix.Config{
+			Endpoint:       &endpoint,
+			HTTPClient:     gohttp.DefaultClient,
+			MaxRetries:     &maxRetries,
+			IAMAccessToken: "Bearer token",
+			Interceptors:   interceptors,
+		}, bluemix.VpcContainerService, nil)
+	}
+	record := func(name string) bluemix.Interceptor {
+		return bluemix.Interceptor{
+			Before: func(service bluemix.ServiceName, req *gohttp.Request) error {
+				calls = append(calls, "before "+name)
+				req.Header.Add("X-Chain", name)
+				return nil
+			},
+			After: func(service bluemix.ServiceName, req *gohttp.Request, resp *gohttp.Response) {
+				calls = append(calls, "after "+name)
+			},
+			OnError: func(service bluemix.ServiceName, err error) error {
+				calls = append(calls, "error "+name)
+				return err
+			},
+		}
+	}
+
+	It("should run the chain of the config then the client", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(gohttp.MethodGet, "/v2/getClusters"),
+				func(w gohttp.ResponseWriter, r *gohttp.Request) {
+					Expect(r.Header.Values("X-Chain")).To(Equal([]string{"config", "client"}))
+				},
+				ghttp.RespondWith(gohttp.StatusOK, `{}`),
+			),
+		)
+		c := newClient(record("config"))
+		c.Use(record("client"))
+		_, err := c.Get("/v2/getClusters", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(calls).To(Equal([]string{"before config", "before client", "after client", "after config"}))
+	})
+	It("should let After rewrite the response before it is decoded", func() {
+		server.AppendHandlers(ghttp.RespondWith(gohttp.StatusOK, `{"name": "original"}`))
+		c := newClient(bluemix.Interceptor{
+			After: func(service bluemix.ServiceName, req *gohttp.Request, resp *gohttp.Response) {
+				resp.Body.Close()
+				resp.Body = ioutil.NopCloser(strings.NewReader(`{"name": "rewritten"}`))
+			},
+		})
+		var v struct {
+			Name string `json:"name"`
+		}
+		_, err := c.Get("/v2/getClusters", &v)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(v.Name).To(Equal("rewritten"))
+	})
+	It("should not send the request when Before fails", func() {
+		c := newClient(record("config"), bluemix.Interceptor{
+			Before: func(service bluemix.ServiceName, req *gohttp.Request) error {
+				return errors.New("denied")
+			},
+		})
+		_, err := c.Get("/v2/getClusters", nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("denied"))
+		Expect(server.ReceivedRequests()).To(BeEmpty())
+		Expect(calls).To(Equal([]string{"before config", "error config"}))
+	})
+	It("should let OnError replace the error", func() {
+		server.AppendHandlers(ghttp.RespondWith(gohttp.StatusNotFound, `not found`))
+		replaced := errors.New("replaced")
+		c := newClient(bluemix.Interceptor{
+			OnError: func(service bluemix.ServiceName, err error) error {
+				Expect(service).To(Equal(bluemix.VpcContainerService))
+				return replaced
+			},
+		})
+		_, err := c.Get("/v2/getClusters", nil)
+		Expect(err).To(Equal(replaced))
+	})
+
+	Describe("RequestSigner", func() {
+		signature := func(body []byte) string {
+			mac := hmac.New(sha256.New, []byte("secret"))
+			mac.Write(body)
+			return hex.EncodeToString(mac.Sum(nil))
+		}
+
+		It("should sign the request with its body once the chain has run", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyHeaderKV("X-Signature", signature([]byte(`{"name":"c1"}`))+" config"),
+					ghttp.VerifyJSON(`{"name":"c1"}`),
+					ghttp.RespondWith(gohttp.StatusOK, `{}`),
+				),
+			)
+			c := newClient(record("config"))
+			c.Config.RequestSigner = bluemix.RequestSignerFunc(func(service bluemix.ServiceName, req *gohttp.Request, body []byte) error {
+				Expect(service).To(Equal(bluemix.VpcContainerService))
+				req.Header.Set("X-Signature", signature(body)+" "+req.Header.Get("X-Chain"))
+				return nil
+			})
+			_, err := c.Post("/v2/createCluster", map[string]string{"name": "c1"}, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+		It("should not send the request when the signer fails", func() {
+			c := newClient()
+			c.Config.RequestSigner = bluemix.RequestSignerFunc(func(service bluemix.ServiceName, req *gohttp.Request, body []byte) error {
+				Expect(body).To(BeNil())
+				return errors.New("no signing key")
+			})
+			_, err := c.Get("/v2/getClusters", nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("no signing key"))
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,24 @@
+package client
+
+import (
+	"context"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+)
+
+type metadataKey struct{}
+
+//WithResponseMetadata returns a context making the calls sent with it store the
+//metadata of their last response in m, such as the rate limit headers and the
+//pagination links, failed calls included. The services take the context with
+//their WithContext method:
+//
+//	var m bluemix.ResponseMetadata
+//	ctx := client.WithResponseMetadata(context.Background(), &m)
+//	clusters, err := api.WithContext(ctx).Clusters().List(target)
+//	next := m.Links["next"]
+//
+//m is not synchronized, the context must not be shared by concurrent calls.
+func WithResponseMetadata(ctx context.Context, m *bluemix.ResponseMetadata) context.Context {
+	return context.WithValue(ctx, metadataKey{}, m)
+}
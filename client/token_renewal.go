@@ -0,0 +1,60 @@
+package client
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/rest"
+)
+
+//tokenRenewalMargin is the validity left to the access token under which it
+//is renewed before sending a request, the one required to reuse cached tokens
+const tokenRenewalMargin = time.Minute
+
+//renewExpiringToken exchanges the API key for new tokens before sending the
+//request when the access token nears its expiry, with the RefreshWithAPIKey
+//strategy
+func (c *Client) renewExpiringToken(r *rest.Request) error {
+	if c.Config.TokenRefreshStrategy != bluemix.RefreshWithAPIKey || c.Config.BluemixAPIKey == "" || c.TokenRefresher == nil {
+		return nil
+	}
+	expiry, ok := tokenExpiry(c.Config.IAMAccessToken)
+	if !ok || time.Until(expiry) > tokenRenewalMargin {
+		return nil
+	}
+	refresher := c.TokenRefresher
+	if p, ok := refresher.(ContextTokenProvider); ok {
+		refresher = p.WithContext(r.Context())
+	}
+	c.Logger().Info("Renewing the IAM token using API Key", "expiry", expiry)
+	if err := refresher.AuthenticateAPIKey(c.Config.BluemixAPIKey); err != nil {
+		return err
+	}
+	c.headerLock.Lock()
+	c.DefaultHeader = getDefaultAuthHeaders(c.ServiceName, c.Config)
+	c.headerLock.Unlock()
+	return nil
+}
+
+//tokenExpiry returns the expiry of a JWT access token, false when it is not
+//a JWT or has no expiry
+func tokenExpiry(token string) (time.Time, bool) {
+	parts := strings.Split(strings.TrimPrefix(token, "Bearer "), ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, false
+	}
+	var claims struct {
+		Expiry int64 `json:"exp"`
+	}
+	if json.Unmarshal(payload, &claims) != nil || claims.Expiry == 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(claims.Expiry, 0), true
+}
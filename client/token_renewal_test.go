@@ -0,0 +1,97 @@
+package client_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	gohttp "net/http"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	. "github.com/IBM-Cloud/bluemix-go/client"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type fakeAPIKeyProvider struct {
+	config *bluemix.Config
+	calls  int
+}
+
+func (p *fakeAPIKeyProvider) RefreshToken() (string, error) {
+	return "", fmt.Errorf("the refresh token must not be used")
+}
+
+func (p *fakeAPIKeyProvider) GetPasscode() (string, error) {
+	return "", nil
+}
+
+func (p *fakeAPIKeyProvider) AuthenticatePassword(string, string) error {
+	return nil
+}
+
+func (p *fakeAPIKeyProvider) AuthenticateAPIKey(string) error {
+	p.calls++
+	p.config.IAMAccessToken = jwt(time.Now().Add(time.Hour))
+	return nil
+}
+
+func jwt(expiry time.Time) string {
+	claims := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"exp":%d}`, expiry.Unix())))
+	return "Bearer eyJhbGciOiJub25lIn0." + claims + ".sig"
+}
+
+var _ = Describe("TokenRefreshStrategy", func() {
+	var (
+		server   *ghttp.Server
+		config   *bluemix.Config
+		provider *fakeAPIKeyProvider
+	)
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		endpoint := server.URL()
+		maxRetries := 0
+		config = &bluemix.Config{
+			Endpoint:             &endpoint,
+			HTTPClient:           gohttp.DefaultClient,
+			MaxRetries:           &maxRetries,
+			BluemixAPIKey:        "key",
+			TokenRefreshStrategy: bluemix.RefreshWithAPIKey,
+		}
+		provider = &fakeAPIKeyProvider{config: config}
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should exchange the API key again before the token expires", func() {
+		config.IAMAccessToken = jwt(time.Now().Add(30 * time.Second))
+		c := New(config, bluemix.VpcContainerService, provider)
+		server.AppendHandlers(ghttp.RespondWith(gohttp.StatusOK, `{}`))
+		_, err := c.Get("/v2/getCluster", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(provider.calls).To(Equal(1))
+		Expect(server.ReceivedRequests()[0].Header.Get("Authorization")).To(Equal(config.IAMAccessToken))
+	})
+	It("should keep the token far from its expiry", func() {
+		config.IAMAccessToken = jwt(time.Now().Add(30 * time.Minute))
+		token := config.IAMAccessToken
+		c := New(config, bluemix.VpcContainerService, provider)
+		server.AppendHandlers(ghttp.RespondWith(gohttp.StatusOK, `{}`))
+		_, err := c.Get("/v2/getCluster", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(provider.calls).To(BeZero())
+		Expect(server.ReceivedRequests()[0].Header.Get("Authorization")).To(Equal(token))
+	})
+	It("should not renew the token with the default strategy", func() {
+		config.TokenRefreshStrategy = ""
+		config.IAMAccessToken = jwt(time.Now().Add(30 * time.Second))
+		c := New(config, bluemix.VpcContainerService, provider)
+		server.AppendHandlers(ghttp.RespondWith(gohttp.StatusOK, `{}`))
+		_, err := c.Get("/v2/getCluster", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(provider.calls).To(BeZero())
+	})
+})
@@ -1,11 +1,15 @@
 package bluemix
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"net/http"
 	"time"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/endpoints"
+	"github.com/IBM-Cloud/bluemix-go/trace"
 )
 
 //ServiceName ..
@@ -66,6 +70,29 @@ const (
 	HPCService ServiceName = ServiceName("hpcs")
 	//FunctionsService ...
 	FunctionsService ServiceName = ServiceName("functions")
+	//COSConfigService ...
+	COSConfigService ServiceName = ServiceName("cos-config")
+	//CodeEngineService ...
+	CodeEngineService ServiceName = ServiceName("codeengine")
+	//EventNotificationsService ...
+	EventNotificationsService ServiceName = ServiceName("event-notifications")
+	//AppConfigurationService ...
+	AppConfigurationService ServiceName = ServiceName("app-configuration")
+	//ActivityTrackerService ...
+	ActivityTrackerService ServiceName = ServiceName("activity-tracker")
+)
+
+//TokenRefreshStrategy selects how the IAM tokens obtained with an API key are
+//renewed
+type TokenRefreshStrategy string
+
+const (
+	//RefreshWithRefreshToken renews the tokens with the IAM refresh token, the
+	//API key being exchanged again only when a request is rejected
+	RefreshWithRefreshToken TokenRefreshStrategy = "refresh_token"
+	//RefreshWithAPIKey exchanges the API key again, when the access token nears
+	//its expiry, and never uses the refresh token
+	RefreshWithAPIKey TokenRefreshStrategy = "apikey"
 )
 
 //Config ...
@@ -75,9 +102,37 @@ type Config struct {
 	IBMIDPassword string
 
 	BluemixAPIKey string
+	//APIKeyFile is optional, a JSON file holding the API key in its apikey field,
+	//as written by ibmcloud iam api-key-create --file. session.New reads it when
+	//BluemixAPIKey is not set.
+	APIKeyFile string
+	//UseCLILogin makes session.New use the tokens, the region and the resource
+	//group of the ibmcloud CLI login when no credentials are given
+	UseCLILogin bool
 
 	IAMAccessToken  string
 	IAMRefreshToken string
+	//IAMTokenRefresher is optional. For the applications minting IAM tokens
+	//themselves, it is called for a new access token when IAMAccessToken is
+	//missing or expired, instead of using IAMRefreshToken. The token may be
+	//given with or without its "Bearer " prefix.
+	IAMTokenRefresher func(ctx context.Context) (string, error)
+	//TokenRefreshStrategy is optional, RefreshWithRefreshToken by default
+	TokenRefreshStrategy TokenRefreshStrategy
+
+	//IAMTrustedProfileID is optional. When set, and no API key is given, the
+	//clients log in with the trusted profile using the compute resource token
+	//read from CRTokenFile, so workloads need no long lived API key
+	IAMTrustedProfileID string
+	//CRTokenFile defaults to the service account token projected in IKS pods,
+	///var/run/secrets/tokens/vault-token or /var/run/secrets/tokens/sa-token
+	CRTokenFile string
+	//VPCMetadataEndpoint is optional. When set, such as to http://169.254.169.254,
+	//and no API key is given, the clients obtain their IAM token from the VPC
+	//instance metadata service, for IAMTrustedProfileID or else the trusted
+	//profile linked to the virtual server instance.
+	VPCMetadataEndpoint string
+
 	UAAAccessToken  string
 	UAARefreshToken string
 
@@ -93,16 +148,128 @@ type Config struct {
 	MaxRetries            *int
 	RetryDelay            *time.Duration
 
+	//ServiceEndpoints is optional, the endpoints of some services, such as
+	//VpcContainerService pointed at a staging environment. The EndpointLocator
+	//created by session.New returns them before the default endpoints.
+	ServiceEndpoints map[ServiceName]string
+	//RetryPolicy is optional. When set it replaces MaxRetries and RetryDelay with
+	//exponential backoff and a configurable list of retryable status codes
+	RetryPolicy *RetryPolicy
+
+	//RateLimiter is optional. When set the clients wait for it before sending
+	//each request, the copies of the config share the same limiter
+	RateLimiter *RateLimiter
+
+	//CircuitBreaker is optional. When set the clients stop sending requests to
+	//the endpoint hosts failing repeatedly, the copies of the config share it
+	CircuitBreaker *CircuitBreaker
+
+	//TokenCache is optional. When set the IAM tokens obtained with BluemixAPIKey
+	//are stored in it and reused until they expire
+	TokenCache TokenCache
+
+	//OnTokenRefresh is optional. It is called every time the SDK obtains new IAM
+	//tokens, for instance to log, meter or securely persist them
+	OnTokenRefresh func(TokenEvent)
+	//OnTokenExpired is optional. It is called when a request of a client of the
+	//service fails with 401 or 403, before the client refreshes its token
+	OnTokenExpired func(service ServiceName)
+
+	//ResponseObserver is optional. It is called with the metadata of every
+	//response received by the clients, successful or not, for instance to
+	//record the rate limit headers or the transaction IDs
+	ResponseObserver func(ResponseMetadata)
+
+	//Interceptors is optional, the chain of hooks run by every client built
+	//from the config, before the interceptors added with Client.Use
+	Interceptors []Interceptor
+	//RequestSigner is optional. It signs every request of the clients built
+	//from the config just before it is sent
+	RequestSigner RequestSigner
+
+	//Tracer is optional. When set a span is started for every API call of the
+	//clients built from the config
+	Tracer Tracer
+	//Metrics is optional. When set it records every API call of the clients
+	//built from the config
+	Metrics MetricsRecorder
+
 	HTTPTimeout time.Duration
 
+	//Compression is optional. When set the clients ask for gzip compressed
+	//responses, which they decompress transparently
+	Compression bool
+	//GzipRequestMinSize is optional, the size in bytes from which the request
+	//bodies are sent gzip compressed. Only set it on the config of the clients
+	//of services accepting compressed requests, such as Schematics.
+	GzipRequestMinSize int
+
+	//EnvPrefixes restricts the prefixes of the environment variables session.New
+	//reads the settings from, by order of precedence. Defaults to IC_, IBMCLOUD_,
+	//BM_ and BLUEMIX_, in that order.
+	EnvPrefixes []string
+
 	Debug bool
 
+	//Logger is optional. When set the SDK logs of the clients built from the
+	//config go to it instead of the global trace.Logger, e.g. a *slog.Logger
+	Logger trace.StructuredLogger
+
+	//Diagnostics is optional. When set the last requests and responses are kept in memory.
+	//Only the HTTP clients built by the SDK record into it: when HTTPClient is set, wrap
+	//its Transport with http.NewDiagnosticsTransport to record its traffic.
+	Diagnostics *trace.Recorder
+
 	HTTPClient *http.Client
 
-	SSLDisable    bool
+	//Transport is optional. When set the HTTP clients built by the SDK send their
+	//requests through it, still wrapped for the trace logging and Diagnostics,
+	//instead of their own transport. The proxy and TLS settings of the config
+	//are then left to the transport.
+	Transport http.RoundTripper
+
+	//MaxIdleConns, MaxIdleConnsPerHost and IdleConnTimeout tune the reuse of the
+	//connections of the HTTP clients built by the SDK, as in http.Transport.
+	//They default to no limit, 2 connections per host and no timeout.
+	MaxIdleConns        int
+	MaxIdleConnsPerHost int
+	IdleConnTimeout     time.Duration
+
+	//ProxyURL is optional. When set the HTTP clients built by the SDK send their
+	//requests through this proxy, the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+	//environment variables are then ignored.
+	ProxyURL string
+	//NoProxy lists the hosts reached without the proxy, in the NO_PROXY format
+	NoProxy string
+	//ProxyUsername and ProxyPassword are optional credentials for the proxy
+	ProxyUsername string
+	ProxyPassword string
+
+	//DefaultHeaders is optional. The headers are sent on every request of the clients
+	//built from the config, the headers set by the SDK itself take precedence
+	DefaultHeaders http.Header
+
+	SSLDisable bool
+	//Visibility selects the service endpoints: "public" (default), "private" to
+	//reach the services over the private network only, as from a VPC without
+	//public egress, or "public-and-private" for the private endpoints where the
+	//region offers them and the public ones elsewhere
 	Visibility    string
 	EndpointsFile string
 	UserAgent     string
+	//UserAgentSuffix is optional. It is appended to the User-Agent of the SDK
+	//on every request, for the tools built on the SDK to identify themselves,
+	//such as "terraform-provider-ibm/1.50.0"
+	UserAgentSuffix string
+
+	//TLSRootCAs is optional, the certificate authorities trusted instead of the
+	//system ones, such as the CA of a TLS intercepting proxy. See
+	//http.AppendCertsFromFile to add a bundle to the system pool.
+	TLSRootCAs *x509.CertPool
+	//TLSCertificates are optional client certificates, for mutual TLS
+	TLSCertificates []tls.Certificate
+	//TLSMinVersion is optional, such as tls.VersionTLS12
+	TLSMinVersion uint16
 }
 
 //Copy allows the configuration to be overriden or added
@@ -110,6 +277,9 @@ type Config struct {
 func (c *Config) Copy(mccpgs ...*Config) *Config {
 	out := new(Config)
 	*out = *c
+	if c.DefaultHeaders != nil {
+		out.DefaultHeaders = c.DefaultHeaders.Clone()
+	}
 	if len(mccpgs) == 0 {
 		return out
 	}
@@ -121,9 +291,15 @@ func (c *Config) Copy(mccpgs ...*Config) *Config {
 	return out
 }
 
+//TrustedProfileAuth reports whether the clients log in with an IAM trusted
+//profile when no API key is given
+func (c *Config) TrustedProfileAuth() bool {
+	return c.IAMTrustedProfileID != "" || c.VPCMetadataEndpoint != ""
+}
+
 //ValidateConfigForService ...
 func (c *Config) ValidateConfigForService(svc ServiceName) error {
-	if (c.IBMID == "" || c.IBMIDPassword == "") && c.BluemixAPIKey == "" && (c.IAMAccessToken == "" || c.IAMRefreshToken == "") {
+	if (c.IBMID == "" || c.IBMIDPassword == "") && c.BluemixAPIKey == "" && !c.TrustedProfileAuth() && c.IAMTokenRefresher == nil && c.IAMAccessToken == "" {
 		return bmxerror.New(ErrInsufficientCredentials, "Please check the documentation on how to configure the IBM Cloud credentials")
 	}
 
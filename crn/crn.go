@@ -3,6 +3,7 @@ package crn
 import (
 	"encoding/json"
 	"errors"
+	"regexp"
 	"strings"
 )
 
@@ -17,8 +18,39 @@ const (
 var (
 	ErrMalformedCRN   = errors.New("malformed CRN")
 	ErrMalformedScope = errors.New("malformed scope in CRN")
+	ErrGlobalCRN      = errors.New("CRN does not identify a regional resource")
+	//ErrUnknownDatacenter is returned by RegionName for classic datacenters
+	//which can not be mapped to a region
+	ErrUnknownDatacenter = errors.New("CRN location is a datacenter of an unknown region")
 )
 
+const regionGlobal = "global"
+
+var (
+	zoneSuffix = regexp.MustCompile(`-[0-9]+$`)
+	datacenter = regexp.MustCompile(`^([a-z]{3})[0-9]{2}$`)
+)
+
+//datacenterRegions maps the metro of classic datacenters, e.g. "dal" for
+//"dal10", to the region serving them
+var datacenterRegions = map[string]string{
+	"dal": "us-south",
+	"hou": "us-south",
+	"sjc": "us-south",
+	"wdc": "us-east",
+	"tor": "ca-tor",
+	"sao": "br-sao",
+	"lon": "eu-gb",
+	"ams": "eu-de",
+	"fra": "eu-de",
+	"mil": "eu-de",
+	"par": "eu-de",
+	"mad": "eu-es",
+	"tok": "jp-tok",
+	"osa": "jp-osa",
+	"syd": "au-syd",
+}
+
 const (
 	ServiceBluemix = "bluemix"
 	ServiceIAM     = "iam"
@@ -139,3 +171,21 @@ func (c CRN) ScopeSegment() string {
 	}
 	return c.ScopeType + scopeSeparator + c.Scope
 }
+
+// RegionName returns the region the resource lives in. Zonal locations such as
+// "us-south-1" and classic datacenters such as "dal10" are reduced to their
+// region, and ErrGlobalCRN is returned for resources which are not bound to a
+// region.
+func (c CRN) RegionName() (string, error) {
+	if c.Region == "" || c.Region == regionGlobal {
+		return "", ErrGlobalCRN
+	}
+	if m := datacenter.FindStringSubmatch(c.Region); m != nil {
+		region, ok := datacenterRegions[m[1]]
+		if !ok {
+			return "", ErrUnknownDatacenter
+		}
+		return region, nil
+	}
+	return zoneSuffix.ReplaceAllString(c.Region, ""), nil
+}
@@ -0,0 +1,13 @@
+package crn_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestCrn(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Crn Suite")
+}
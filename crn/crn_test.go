@@ -0,0 +1,40 @@
+package crn_test
+
+import (
+	. "github.com/IBM-Cloud/bluemix-go/crn"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CRN", func() {
+	Describe("RegionName", func() {
+		table.DescribeTable("resolves the region of the location",
+			func(location, region string, expectedErr error) {
+				c, err := Parse("crn:v1:bluemix:public:containers-kubernetes:" + location + ":a/account::cluster:c1")
+				Expect(err).NotTo(HaveOccurred())
+				name, err := c.RegionName()
+				if expectedErr != nil {
+					Expect(err).To(Equal(expectedErr))
+					return
+				}
+				Expect(err).NotTo(HaveOccurred())
+				Expect(name).To(Equal(region))
+			},
+			table.Entry("region", "us-south", "us-south", nil),
+			table.Entry("zone", "eu-de-2", "eu-de", nil),
+			table.Entry("classic datacenter", "dal10", "us-south", nil),
+			table.Entry("classic datacenter of another metro", "fra02", "eu-de", nil),
+			table.Entry("unknown datacenter", "xyz01", "", ErrUnknownDatacenter),
+			table.Entry("global", "global", "", ErrGlobalCRN),
+			table.Entry("empty", "", "", ErrGlobalCRN),
+		)
+	})
+	Describe("Parse", func() {
+		It("should return an error for a malformed CRN", func() {
+			_, err := Parse("crn:v1:bluemix")
+			Expect(err).To(Equal(ErrMalformedCRN))
+		})
+	})
+})
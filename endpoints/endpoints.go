@@ -35,6 +35,11 @@ type EndpointLocator interface {
 	HpcsEndpoint() (string, error)
 	FunctionsEndpoint() (string, error)
 	SatelliteEndpoint() (string, error)
+	COSConfigEndpoint() (string, error)
+	CodeEngineEndpoint() (string, error)
+	EventNotificationsEndpoint() (string, error)
+	AppConfigurationEndpoint() (string, error)
+	ActivityTrackerEndpoint() (string, error)
 }
 
 const (
@@ -621,6 +626,91 @@ func (e *endpointLocator) SatelliteEndpoint() (string, error) {
 	return contructEndpoint("api.link.satellite", fmt.Sprintf("%s", cloudEndpoint)), nil
 }
 
+func (e *endpointLocator) COSConfigEndpoint() (string, error) {
+	endpoint := helpers.EnvFallBack([]string{"IBMCLOUD_COS_CONFIG_ENDPOINT"}, "")
+	if endpoint != "" {
+		return endpoint, nil
+	}
+	if e.endpointsFile != nil && e.visibility != "public-and-private" {
+		url := fileFallBack(e.endpointsFile, e.visibility, "IBMCLOUD_COS_CONFIG_ENDPOINT", e.region, "")
+		if url != "" {
+			return url, nil
+		}
+	}
+	if e.visibility == "private" || e.visibility == "public-and-private" {
+		return contructEndpoint("config.private.cloud-object-storage", cloudEndpoint), nil
+	}
+	return contructEndpoint("config.cloud-object-storage", cloudEndpoint), nil
+}
+
+func (e *endpointLocator) CodeEngineEndpoint() (string, error) {
+	endpoint := helpers.EnvFallBack([]string{"IBMCLOUD_CODE_ENGINE_API_ENDPOINT"}, "")
+	if endpoint != "" {
+		return endpoint, nil
+	}
+	if e.endpointsFile != nil && e.visibility != "public-and-private" {
+		url := fileFallBack(e.endpointsFile, e.visibility, "IBMCLOUD_CODE_ENGINE_API_ENDPOINT", e.region, "")
+		if url != "" {
+			return url, nil
+		}
+	}
+	if e.visibility == "private" || e.visibility == "public-and-private" {
+		return contructEndpoint(fmt.Sprintf("api.private.%s.codeengine", e.region), cloudEndpoint), nil
+	}
+	return contructEndpoint(fmt.Sprintf("api.%s.codeengine", e.region), cloudEndpoint), nil
+}
+
+func (e *endpointLocator) EventNotificationsEndpoint() (string, error) {
+	endpoint := helpers.EnvFallBack([]string{"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT"}, "")
+	if endpoint != "" {
+		return endpoint, nil
+	}
+	if e.endpointsFile != nil && e.visibility != "public-and-private" {
+		url := fileFallBack(e.endpointsFile, e.visibility, "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", e.region, "")
+		if url != "" {
+			return url, nil
+		}
+	}
+	if e.visibility == "private" || e.visibility == "public-and-private" {
+		return contructEndpoint(fmt.Sprintf("private.%s.event-notifications", e.region), cloudEndpoint), nil
+	}
+	return contructEndpoint(fmt.Sprintf("%s.event-notifications", e.region), cloudEndpoint), nil
+}
+
+func (e *endpointLocator) AppConfigurationEndpoint() (string, error) {
+	endpoint := helpers.EnvFallBack([]string{"IBMCLOUD_APP_CONFIGURATION_API_ENDPOINT"}, "")
+	if endpoint != "" {
+		return endpoint, nil
+	}
+	if e.endpointsFile != nil && e.visibility != "public-and-private" {
+		url := fileFallBack(e.endpointsFile, e.visibility, "IBMCLOUD_APP_CONFIGURATION_API_ENDPOINT", e.region, "")
+		if url != "" {
+			return url, nil
+		}
+	}
+	if e.visibility == "private" || e.visibility == "public-and-private" {
+		return contructEndpoint(fmt.Sprintf("%s.private.apprapp", e.region), cloudEndpoint), nil
+	}
+	return contructEndpoint(fmt.Sprintf("%s.apprapp", e.region), cloudEndpoint), nil
+}
+
+func (e *endpointLocator) ActivityTrackerEndpoint() (string, error) {
+	endpoint := helpers.EnvFallBack([]string{"IBMCLOUD_ACTIVITY_TRACKER_API_ENDPOINT"}, "")
+	if endpoint != "" {
+		return endpoint, nil
+	}
+	if e.endpointsFile != nil && e.visibility != "public-and-private" {
+		url := fileFallBack(e.endpointsFile, e.visibility, "IBMCLOUD_ACTIVITY_TRACKER_API_ENDPOINT", e.region, "")
+		if url != "" {
+			return url, nil
+		}
+	}
+	if e.visibility == "private" || e.visibility == "public-and-private" {
+		return contructEndpoint(fmt.Sprintf("api.private.%s.logging", e.region), cloudEndpoint), nil
+	}
+	return contructEndpoint(fmt.Sprintf("api.%s.logging", e.region), cloudEndpoint), nil
+}
+
 func fileFallBack(fileMap map[string]interface{}, visibility, key, region, defaultValue string) string {
 	if val, ok := fileMap[key]; ok {
 		if v, ok := val.(map[string]interface{})[visibility]; ok {
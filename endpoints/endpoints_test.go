@@ -15,6 +15,10 @@ var _ = Describe("EndPoints", func() {
 			Expect(locator.UAAEndpoint()).To(Equal("https://iam.cloud.ibm.com/cloudfoundry/login/us-south"))
 			Expect(locator.ICDEndpoint()).To(Equal("https://api.us-south.databases.cloud.ibm.com"))
 			Expect(locator.MCCPAPIEndpoint()).To(Equal("https://mccp.us-south.cf.cloud.ibm.com"))
+			Expect(locator.CodeEngineEndpoint()).To(Equal("https://api.us-south.codeengine.cloud.ibm.com"))
+			Expect(locator.EventNotificationsEndpoint()).To(Equal("https://us-south.event-notifications.cloud.ibm.com"))
+			Expect(locator.AppConfigurationEndpoint()).To(Equal("https://us-south.apprapp.cloud.ibm.com"))
+			Expect(locator.ActivityTrackerEndpoint()).To(Equal("https://api.us-south.logging.cloud.ibm.com"))
 			Expect(locator.ContainerRegistryEndpoint()).To(Equal("https://us.icr.io"))
 		})
 	})
@@ -86,6 +90,7 @@ var _ = Describe("EndPoints", func() {
 			Expect(locator.ResourceControllerEndpoint()).To(Equal("https://resource-controller.cloud.ibm.com"))
 			Expect(locator.ResourceCatalogEndpoint()).To(Equal("https://globalcatalog.cloud.ibm.com"))
 			Expect(locator.CseEndpoint()).To(Equal("https://api.serviceendpoint.cloud.ibm.com"))
+			Expect(locator.COSConfigEndpoint()).To(Equal("https://config.cloud-object-storage.cloud.ibm.com"))
 		})
 	})
 
@@ -102,6 +107,21 @@ var _ = Describe("EndPoints", func() {
 		})
 	})
 
+	Context("When service endpoints are overridden", func() {
+		locator := WithOverrides(newEndpointLocator("us-south", "public", ""), map[string]string{
+			"containerv2":       "https://containers.test.cloud.ibm.com/global",
+			"resource-catalog ": "https://globalcatalog.test.cloud.ibm.com",
+		})
+
+		It("should return the overrides", func() {
+			Expect(locator.ContainerEndpoint()).To(Equal("https://containers.test.cloud.ibm.com/global"))
+			Expect(locator.ResourceCatalogEndpoint()).To(Equal("https://globalcatalog.test.cloud.ibm.com"))
+		})
+		It("should return the default endpoints of the other services", func() {
+			Expect(locator.ICDEndpoint()).To(Equal("https://api.us-south.databases.cloud.ibm.com"))
+		})
+	})
+
 })
 
 func newEndpointLocator(region, visibility, endpointsFile string) EndpointLocator {
@@ -0,0 +1,224 @@
+package endpoints
+
+import "strings"
+
+//overrideLocator returns the endpoints given for the services before asking
+//the locator it wraps
+type overrideLocator struct {
+	EndpointLocator
+	overrides map[string]string
+}
+
+//WithOverrides returns a locator returning the endpoints of overrides, keyed
+//by service name such as "containerv2" or "iam", and the endpoints of locator
+//for the other services. The services sharing an endpoint share the override,
+//"container" and "containerv2" for instance. The "iam" override also applies
+//to the IAM token requests.
+func WithOverrides(locator EndpointLocator, overrides map[string]string) EndpointLocator {
+	if len(overrides) == 0 {
+		return locator
+	}
+	trimmed := make(map[string]string, len(overrides))
+	for service, url := range overrides {
+		trimmed[strings.TrimSpace(service)] = url
+	}
+	return &overrideLocator{EndpointLocator: locator, overrides: trimmed}
+}
+
+func (o *overrideLocator) lookup(services ...string) (string, bool) {
+	for _, service := range services {
+		if url := o.overrides[service]; url != "" {
+			return url, true
+		}
+	}
+	return "", false
+}
+
+func (o *overrideLocator) AccountManagementEndpoint() (string, error) {
+	if url, ok := o.lookup("account", "accountv1"); ok {
+		return url, nil
+	}
+	return o.EndpointLocator.AccountManagementEndpoint()
+}
+
+func (o *overrideLocator) CertificateManagerEndpoint() (string, error) {
+	if url, ok := o.lookup("certificate-manager"); ok {
+		return url, nil
+	}
+	return o.EndpointLocator.CertificateManagerEndpoint()
+}
+
+func (o *overrideLocator) CFAPIEndpoint() (string, error) {
+	if url, ok := o.lookup("cf"); ok {
+		return url, nil
+	}
+	return o.EndpointLocator.CFAPIEndpoint()
+}
+
+func (o *overrideLocator) ContainerEndpoint() (string, error) {
+	if url, ok := o.lookup("container", "containerv2"); ok {
+		return url, nil
+	}
+	return o.EndpointLocator.ContainerEndpoint()
+}
+
+func (o *overrideLocator) ContainerRegistryEndpoint() (string, error) {
+	if url, ok := o.lookup("container-registry"); ok {
+		return url, nil
+	}
+	return o.EndpointLocator.ContainerRegistryEndpoint()
+}
+
+func (o *overrideLocator) CisEndpoint() (string, error) {
+	if url, ok := o.lookup("cis"); ok {
+		return url, nil
+	}
+	return o.EndpointLocator.CisEndpoint()
+}
+
+func (o *overrideLocator) GlobalSearchEndpoint() (string, error) {
+	if url, ok := o.lookup("global-search"); ok {
+		return url, nil
+	}
+	return o.EndpointLocator.GlobalSearchEndpoint()
+}
+
+func (o *overrideLocator) GlobalTaggingEndpoint() (string, error) {
+	if url, ok := o.lookup("global-tagging"); ok {
+		return url, nil
+	}
+	return o.EndpointLocator.GlobalTaggingEndpoint()
+}
+
+func (o *overrideLocator) IAMEndpoint() (string, error) {
+	if url, ok := o.lookup("iam"); ok {
+		return url, nil
+	}
+	return o.EndpointLocator.IAMEndpoint()
+}
+
+func (o *overrideLocator) IAMPAPEndpoint() (string, error) {
+	if url, ok := o.lookup("iampap"); ok {
+		return url, nil
+	}
+	return o.EndpointLocator.IAMPAPEndpoint()
+}
+
+func (o *overrideLocator) ICDEndpoint() (string, error) {
+	if url, ok := o.lookup("icd"); ok {
+		return url, nil
+	}
+	return o.EndpointLocator.ICDEndpoint()
+}
+
+func (o *overrideLocator) MCCPAPIEndpoint() (string, error) {
+	if url, ok := o.lookup("mccp"); ok {
+		return url, nil
+	}
+	return o.EndpointLocator.MCCPAPIEndpoint()
+}
+
+func (o *overrideLocator) ResourceManagementEndpoint() (string, error) {
+	if url, ok := o.lookup("resource-management", "resource-managementv2"); ok {
+		return url, nil
+	}
+	return o.EndpointLocator.ResourceManagementEndpoint()
+}
+
+func (o *overrideLocator) ResourceControllerEndpoint() (string, error) {
+	if url, ok := o.lookup("resource-controller", "resource-controllerv2"); ok {
+		return url, nil
+	}
+	return o.EndpointLocator.ResourceControllerEndpoint()
+}
+
+func (o *overrideLocator) ResourceCatalogEndpoint() (string, error) {
+	if url, ok := o.lookup("resource-catalog"); ok {
+		return url, nil
+	}
+	return o.EndpointLocator.ResourceCatalogEndpoint()
+}
+
+func (o *overrideLocator) UAAEndpoint() (string, error) {
+	if url, ok := o.lookup("uaa"); ok {
+		return url, nil
+	}
+	return o.EndpointLocator.UAAEndpoint()
+}
+
+func (o *overrideLocator) CseEndpoint() (string, error) {
+	if url, ok := o.lookup("cse"); ok {
+		return url, nil
+	}
+	return o.EndpointLocator.CseEndpoint()
+}
+
+func (o *overrideLocator) SchematicsEndpoint() (string, error) {
+	if url, ok := o.lookup("schematics"); ok {
+		return url, nil
+	}
+	return o.EndpointLocator.SchematicsEndpoint()
+}
+
+func (o *overrideLocator) UserManagementEndpoint() (string, error) {
+	if url, ok := o.lookup("user-management"); ok {
+		return url, nil
+	}
+	return o.EndpointLocator.UserManagementEndpoint()
+}
+
+func (o *overrideLocator) HpcsEndpoint() (string, error) {
+	if url, ok := o.lookup("hpcs"); ok {
+		return url, nil
+	}
+	return o.EndpointLocator.HpcsEndpoint()
+}
+
+func (o *overrideLocator) FunctionsEndpoint() (string, error) {
+	if url, ok := o.lookup("functions"); ok {
+		return url, nil
+	}
+	return o.EndpointLocator.FunctionsEndpoint()
+}
+
+func (o *overrideLocator) SatelliteEndpoint() (string, error) {
+	if url, ok := o.lookup("satellite"); ok {
+		return url, nil
+	}
+	return o.EndpointLocator.SatelliteEndpoint()
+}
+
+func (o *overrideLocator) COSConfigEndpoint() (string, error) {
+	if url, ok := o.lookup("cos-config"); ok {
+		return url, nil
+	}
+	return o.EndpointLocator.COSConfigEndpoint()
+}
+
+func (o *overrideLocator) CodeEngineEndpoint() (string, error) {
+	if url, ok := o.lookup("codeengine"); ok {
+		return url, nil
+	}
+	return o.EndpointLocator.CodeEngineEndpoint()
+}
+
+func (o *overrideLocator) EventNotificationsEndpoint() (string, error) {
+	if url, ok := o.lookup("event-notifications"); ok {
+		return url, nil
+	}
+	return o.EndpointLocator.EventNotificationsEndpoint()
+}
+
+func (o *overrideLocator) AppConfigurationEndpoint() (string, error) {
+	if url, ok := o.lookup("app-configuration"); ok {
+		return url, nil
+	}
+	return o.EndpointLocator.AppConfigurationEndpoint()
+}
+
+func (o *overrideLocator) ActivityTrackerEndpoint() (string, error) {
+	if url, ok := o.lookup("activity-tracker"); ok {
+		return url, nil
+	}
+	return o.EndpointLocator.ActivityTrackerEndpoint()
+}
@@ -9,4 +9,7 @@ const (
 
 	//ErrInsufficientCredentials ..
 	ErrInsufficientCredentials = "InsufficientCredentials"
+
+	//ErrCircuitOpenCode ..
+	ErrCircuitOpenCode = "CircuitOpen"
 )
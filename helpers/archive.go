@@ -0,0 +1,163 @@
+package helpers
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	//MaxArchiveFileSize is the largest single file that will be extracted from an archive
+	MaxArchiveFileSize int64 = 100 << 20
+	//MaxArchiveTotalSize is the largest total uncompressed size that will be extracted from an archive
+	MaxArchiveTotalSize int64 = 500 << 20
+)
+
+var (
+	zipMagic  = []byte("PK\x03\x04")
+	gzipMagic = []byte{0x1f, 0x8b}
+)
+
+//ExtractArchive extracts the zip or tar.gz archive at src into dest. The
+//archive format is detected from the content rather than the file name.
+func ExtractArchive(src, dest string) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	header, err := bufio.NewReader(f).Peek(len(zipMagic))
+	f.Close()
+	if err != nil && err != io.EOF {
+		return err
+	}
+	switch {
+	case bytes.HasPrefix(header, zipMagic):
+		return Unzip(src, dest)
+	case bytes.HasPrefix(header, gzipMagic):
+		return Untar(src, dest)
+	}
+	return fmt.Errorf("%s is not a zip or tar.gz archive", src)
+}
+
+//Untar extracts the gzip compressed tar archive src to dest
+func Untar(src, dest string) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	err = os.MkdirAll(dest, 0755)
+	if err != nil {
+		return err
+	}
+
+	var total int64
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if hdr.Typeflag == tar.TypeXGlobalHeader {
+			continue
+		}
+		path, err := archiveEntryPath(dest, hdr.Name)
+		if err != nil {
+			return err
+		}
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(path, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg, tar.TypeRegA:
+			if err := checkArchiveEntrySize(hdr.Name, hdr.Size, total); err != nil {
+				return err
+			}
+			if err := writeArchiveEntry(path, os.FileMode(hdr.Mode).Perm(), tr, &total); err != nil {
+				return err
+			}
+		case tar.TypeSymlink, tar.TypeLink, tar.TypeChar, tar.TypeBlock, tar.TypeFifo:
+			// links and special files are never needed for config bundles
+			return fmt.Errorf("unsupported archive entry %q", hdr.Name)
+		default:
+			// other metadata entries, e.g. GNU volume headers, carry no file content
+		}
+	}
+}
+
+//archiveEntryPath joins name to dest and rejects absolute entries and entries
+//escaping dest
+func archiveEntryPath(dest, name string) (string, error) {
+	if filepath.IsAbs(name) || strings.HasPrefix(name, "/") || strings.HasPrefix(name, `\`) {
+		return "", fmt.Errorf("illegal path %q in archive", name)
+	}
+	dest = filepath.Clean(dest)
+	path := filepath.Join(dest, name)
+	if path != dest && !strings.HasPrefix(path, dest+string(os.PathSeparator)) {
+		return "", fmt.Errorf("illegal path %q in archive", name)
+	}
+	return path, nil
+}
+
+//checkArchiveEntrySize rejects entries whose declared size exceeds the limits
+//before anything is written, total is the size extracted so far
+func checkArchiveEntrySize(name string, size int64, total int64) error {
+	if size > MaxArchiveFileSize {
+		return fmt.Errorf("archive entry %q exceeds the maximum size of %d bytes", name, MaxArchiveFileSize)
+	}
+	if total+size > MaxArchiveTotalSize {
+		return fmt.Errorf("archive exceeds the maximum extracted size of %d bytes", MaxArchiveTotalSize)
+	}
+	return nil
+}
+
+//writeArchiveEntry copies r to path and adds the written size to total. The
+//limits are enforced on the bytes actually read, regardless of the size
+//declared in the archive header.
+func writeArchiveEntry(path string, mode os.FileMode, r io.Reader, total *int64) error {
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+	if mode == 0 {
+		mode = 0644
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	limit := MaxArchiveFileSize
+	if remaining := MaxArchiveTotalSize - *total; remaining < limit {
+		limit = remaining
+	}
+	n, err := io.Copy(f, io.LimitReader(r, limit+1))
+	*total += n
+	if err != nil {
+		return err
+	}
+	if n > MaxArchiveFileSize {
+		return fmt.Errorf("archive entry %q exceeds the maximum size of %d bytes", filepath.Base(path), MaxArchiveFileSize)
+	}
+	if *total > MaxArchiveTotalSize {
+		return fmt.Errorf("archive exceeds the maximum extracted size of %d bytes", MaxArchiveTotalSize)
+	}
+	return nil
+}
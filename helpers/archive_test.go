@@ -0,0 +1,180 @@
+package helpers_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/IBM-Cloud/bluemix-go/helpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type archiveEntry struct {
+	name     string
+	body     string
+	typeflag byte
+	//legacy writes a regular file with the pre-POSIX type flag
+	legacy bool
+}
+
+var _ = Describe("ExtractArchive", func() {
+	var dir, dest string
+	var savedFileSize, savedTotalSize int64
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "archive")
+		Expect(err).NotTo(HaveOccurred())
+		dest = filepath.Join(dir, "out")
+		savedFileSize, savedTotalSize = MaxArchiveFileSize, MaxArchiveTotalSize
+	})
+	AfterEach(func() {
+		MaxArchiveFileSize, MaxArchiveTotalSize = savedFileSize, savedTotalSize
+		os.RemoveAll(dir)
+	})
+
+	Context("When the archive is a zip", func() {
+		It("should extract the files", func() {
+			src := writeZip(dir, archiveEntry{name: "config/kube-config.yml", body: "apiVersion: v1"})
+			Expect(ExtractArchive(src, dest)).To(Succeed())
+			Expect(ioutil.ReadFile(filepath.Join(dest, "config", "kube-config.yml"))).To(Equal([]byte("apiVersion: v1")))
+		})
+		It("should reject entries escaping the destination", func() {
+			src := writeZip(dir, archiveEntry{name: "../escaped", body: "x"})
+			Expect(ExtractArchive(src, dest)).To(MatchError(ContainSubstring("illegal path")))
+			Expect(filepath.Join(dir, "escaped")).NotTo(BeAnExistingFile())
+		})
+		It("should reject absolute entries", func() {
+			src := writeZip(dir, archiveEntry{name: "/tmp/absolute", body: "x"})
+			Expect(ExtractArchive(src, dest)).To(MatchError(ContainSubstring("illegal path")))
+		})
+		It("should reject entries larger than the file limit", func() {
+			MaxArchiveFileSize = 4
+			src := writeZip(dir, archiveEntry{name: "big", body: "0123456789"})
+			Expect(ExtractArchive(src, dest)).To(MatchError(ContainSubstring("maximum size")))
+		})
+		It("should reject archives larger than the total limit", func() {
+			MaxArchiveTotalSize = 15
+			src := writeZip(dir, archiveEntry{name: "a", body: "0123456789"}, archiveEntry{name: "b", body: "0123456789"})
+			Expect(ExtractArchive(src, dest)).To(MatchError(ContainSubstring("maximum extracted size")))
+		})
+		It("should not trust a header under-declaring the content", func() {
+			MaxArchiveFileSize = 4
+			body := []byte("0123456789")
+			var buf bytes.Buffer
+			w := zip.NewWriter(&buf)
+			f, err := w.CreateRaw(&zip.FileHeader{
+				Name:               "lying",
+				Method:             zip.Store,
+				CRC32:              crc32.ChecksumIEEE(body),
+				CompressedSize64:   uint64(len(body)),
+				UncompressedSize64: 2,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			f.Write(body)
+			Expect(w.Close()).To(Succeed())
+			src := filepath.Join(dir, "lying.zip")
+			Expect(ioutil.WriteFile(src, buf.Bytes(), 0600)).To(Succeed())
+
+			Expect(ExtractArchive(src, dest)).NotTo(Succeed())
+			info, err := os.Stat(filepath.Join(dest, "lying"))
+			if err == nil {
+				Expect(info.Size()).To(BeNumerically("<=", MaxArchiveFileSize))
+			}
+		})
+	})
+	Context("When the archive is a tar.gz", func() {
+		It("should extract the files", func() {
+			src := writeTarGz(dir,
+				archiveEntry{name: "config/", typeflag: tar.TypeDir},
+				archiveEntry{name: "config/admin-key.pem", body: "key"},
+			)
+			Expect(ExtractArchive(src, dest)).To(Succeed())
+			Expect(ioutil.ReadFile(filepath.Join(dest, "config", "admin-key.pem"))).To(Equal([]byte("key")))
+		})
+		It("should accept legacy regular files and PAX global headers", func() {
+			src := writeTarGz(dir,
+				archiveEntry{name: "pax_global_header", typeflag: tar.TypeXGlobalHeader},
+				archiveEntry{name: "legacy", body: "old", legacy: true},
+			)
+			Expect(ExtractArchive(src, dest)).To(Succeed())
+			Expect(ioutil.ReadFile(filepath.Join(dest, "legacy"))).To(Equal([]byte("old")))
+		})
+		It("should reject links", func() {
+			src := writeTarGz(dir, archiveEntry{name: "link", body: "/etc/passwd", typeflag: tar.TypeSymlink})
+			Expect(ExtractArchive(src, dest)).To(MatchError(ContainSubstring("unsupported archive entry")))
+		})
+		It("should reject entries escaping the destination", func() {
+			src := writeTarGz(dir, archiveEntry{name: "../../escaped", body: "x"})
+			Expect(ExtractArchive(src, dest)).To(MatchError(ContainSubstring("illegal path")))
+		})
+		It("should reject absolute entries", func() {
+			src := writeTarGz(dir, archiveEntry{name: "/etc/absolute", body: "x"})
+			Expect(ExtractArchive(src, dest)).To(MatchError(ContainSubstring("illegal path")))
+		})
+		It("should reject archives larger than the total limit", func() {
+			MaxArchiveTotalSize = 15
+			src := writeTarGz(dir, archiveEntry{name: "a", body: "0123456789"}, archiveEntry{name: "b", body: "0123456789"})
+			Expect(ExtractArchive(src, dest)).To(MatchError(ContainSubstring("maximum extracted size")))
+		})
+	})
+	Context("When the file is not an archive", func() {
+		It("should return an error", func() {
+			src := filepath.Join(dir, "plain")
+			Expect(ioutil.WriteFile(src, []byte("plain text"), 0600)).To(Succeed())
+			Expect(ExtractArchive(src, dest)).To(MatchError(ContainSubstring("not a zip or tar.gz archive")))
+		})
+	})
+})
+
+func writeZip(dir string, entries ...archiveEntry) string {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, e := range entries {
+		f, err := w.CreateHeader(&zip.FileHeader{Name: e.name, Method: zip.Deflate})
+		Expect(err).NotTo(HaveOccurred())
+		f.Write([]byte(e.body))
+	}
+	Expect(w.Close()).To(Succeed())
+	path := filepath.Join(dir, "bundle.zip")
+	Expect(ioutil.WriteFile(path, buf.Bytes(), 0600)).To(Succeed())
+	return path
+}
+
+func writeTarGz(dir string, entries ...archiveEntry) string {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	w := tar.NewWriter(gz)
+	for _, e := range entries {
+		if e.typeflag == 0 {
+			e.typeflag = tar.TypeReg
+		}
+		hdr := &tar.Header{Name: e.name, Mode: 0600, Typeflag: e.typeflag}
+		switch e.typeflag {
+		case tar.TypeReg:
+			hdr.Size = int64(len(e.body))
+		case tar.TypeSymlink:
+			hdr.Linkname = e.body
+		case tar.TypeXGlobalHeader:
+			hdr = &tar.Header{Typeflag: tar.TypeXGlobalHeader, PAXRecords: map[string]string{"comment": "generated"}}
+		}
+		if e.legacy {
+			hdr.Typeflag = tar.TypeRegA
+		}
+		Expect(w.WriteHeader(hdr)).To(Succeed())
+		if hdr.Size > 0 {
+			w.Write([]byte(e.body))
+		}
+	}
+	Expect(w.Close()).To(Succeed())
+	Expect(gz.Close()).To(Succeed())
+	path := filepath.Join(dir, "bundle.tar.gz")
+	Expect(ioutil.WriteFile(path, buf.Bytes(), 0600)).To(Succeed())
+	return path
+}
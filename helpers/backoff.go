@@ -0,0 +1,92 @@
+package helpers
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// Backoff computes the delays of an exponential backoff. The delay before the
+// retry n, starting at 0, is InitialDelay * Multiplier^n capped at MaxDelay,
+// then randomized by +/- Jitter.
+type Backoff struct {
+	// InitialDelay defaults to 1 second
+	InitialDelay time.Duration
+	// MaxDelay defaults to 30 seconds
+	MaxDelay time.Duration
+	// Multiplier defaults to 2
+	Multiplier float64
+	// Jitter is the fraction of the delay randomly added or removed, between 0 and 1
+	Jitter float64
+}
+
+// Delay returns the time to wait before the given retry
+func (b Backoff) Delay(retry int) time.Duration {
+	initial := b.InitialDelay
+	if initial <= 0 {
+		initial = time.Second
+	}
+	max := b.MaxDelay
+	if max <= 0 {
+		max = 30 * time.Second
+	}
+	multiplier := b.Multiplier
+	if multiplier < 1 {
+		multiplier = 2
+	}
+	delay := float64(initial) * math.Pow(multiplier, float64(retry))
+	if delay > float64(max) {
+		delay = float64(max)
+	}
+	if b.Jitter > 0 {
+		jitter := math.Min(b.Jitter, 1)
+		delay += delay * jitter * (2*rand.Float64() - 1)
+	}
+	return time.Duration(delay)
+}
+
+// Sleep waits for d, it returns the context error if ctx is done first
+func Sleep(ctx context.Context, d time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// Retry calls fn, then calls it again after the delays of b as long as it fails
+// with an error retryable accepts, at most maxRetries times. A nil retryable
+// retries every error, it is only called when a retry is left. Retry returns
+// the last error of fn, or the context error if ctx is done while waiting.
+func Retry(ctx context.Context, b Backoff, maxRetries int, retryable func(error) bool, fn func() error) error {
+	for retry := 0; ; retry++ {
+		err := fn()
+		if err == nil || retry >= maxRetries || (retryable != nil && !retryable(err)) {
+			return err
+		}
+		if err := Sleep(ctx, b.Delay(retry)); err != nil {
+			return err
+		}
+	}
+}
+
+// PollUntil calls fn after the delays of b until it reports done or fails. It
+// returns the error of fn, or the context error if ctx is done while waiting.
+func PollUntil(ctx context.Context, b Backoff, fn func() (done bool, err error)) error {
+	for retry := 0; ; retry++ {
+		done, err := fn()
+		if err != nil || done {
+			return err
+		}
+		if err := Sleep(ctx, b.Delay(retry)); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package helpers_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/IBM-Cloud/bluemix-go/helpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Backoff", func() {
+	Describe("Delay", func() {
+		It("should grow with the multiplier up to the max delay", func() {
+			b := Backoff{InitialDelay: 100 * time.Millisecond, MaxDelay: time.Second, Multiplier: 3}
+			Expect(b.Delay(0)).To(Equal(100 * time.Millisecond))
+			Expect(b.Delay(1)).To(Equal(300 * time.Millisecond))
+			Expect(b.Delay(2)).To(Equal(900 * time.Millisecond))
+			Expect(b.Delay(3)).To(Equal(time.Second))
+		})
+		It("should use the defaults", func() {
+			b := Backoff{}
+			Expect(b.Delay(0)).To(Equal(time.Second))
+			Expect(b.Delay(1)).To(Equal(2 * time.Second))
+			Expect(b.Delay(10)).To(Equal(30 * time.Second))
+		})
+		It("should stay within the jitter", func() {
+			b := Backoff{InitialDelay: time.Second, Jitter: 0.5}
+			for i := 0; i < 100; i++ {
+				Expect(b.Delay(0)).To(BeNumerically(">=", 500*time.Millisecond))
+				Expect(b.Delay(0)).To(BeNumerically("<=", 1500*time.Millisecond))
+			}
+		})
+	})
+
+	Describe("Retry", func() {
+		fast := Backoff{InitialDelay: time.Millisecond}
+		failure := errors.New("unavailable")
+
+		It("should retry until fn succeeds", func() {
+			calls := 0
+			err := Retry(context.Background(), fast, 5, nil, func() error {
+				if calls++; calls < 3 {
+					return failure
+				}
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(calls).To(Equal(3))
+		})
+		It("should return the last error after the max retries", func() {
+			calls := 0
+			err := Retry(context.Background(), fast, 2, nil, func() error {
+				calls++
+				return failure
+			})
+			Expect(err).To(Equal(failure))
+			Expect(calls).To(Equal(3))
+		})
+		It("should not retry the errors retryable rejects", func() {
+			calls := 0
+			err := Retry(context.Background(), fast, 5, func(err error) bool { return false }, func() error {
+				calls++
+				return failure
+			})
+			Expect(err).To(Equal(failure))
+			Expect(calls).To(Equal(1))
+		})
+		It("should stop waiting when the context is done", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+			err := Retry(ctx, Backoff{InitialDelay: time.Minute}, 5, nil, func() error {
+				return failure
+			})
+			Expect(err).To(Equal(context.DeadlineExceeded))
+		})
+	})
+
+	Describe("PollUntil", func() {
+		It("should poll until done", func() {
+			polls := 0
+			err := PollUntil(context.Background(), Backoff{InitialDelay: time.Millisecond}, func() (bool, error) {
+				polls++
+				return polls == 3, nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(polls).To(Equal(3))
+		})
+		It("should stop at the first error", func() {
+			failure := errors.New("failed")
+			err := PollUntil(context.Background(), Backoff{InitialDelay: time.Millisecond}, func() (bool, error) {
+				return false, failure
+			})
+			Expect(err).To(Equal(failure))
+		})
+		It("should stop waiting when the context is done", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			err := PollUntil(ctx, Backoff{}, func() (bool, error) {
+				return false, nil
+			})
+			Expect(err).To(Equal(context.Canceled))
+		})
+	})
+})
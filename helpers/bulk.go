@@ -0,0 +1,61 @@
+package helpers
+
+import (
+	"context"
+	"sync"
+)
+
+// BulkResult is the outcome of a bulk operation for a single item, such as a
+// resource or a worker, identified by ID.
+type BulkResult struct {
+	ID  string
+	Err error
+}
+
+// BulkResults are the outcomes of a bulk operation, one per item.
+type BulkResults []BulkResult
+
+// Failed returns the results of the items the operation failed for.
+func (r BulkResults) Failed() BulkResults {
+	failed := BulkResults{}
+	for _, res := range r {
+		if res.Err != nil {
+			failed = append(failed, res)
+		}
+	}
+	return failed
+}
+
+// RunBulk calls op for the items 0 to n-1, at most concurrency of them at once,
+// and returns the error of every item. Once ctx is done no further item is
+// started: the items not started get the context error, which is returned too.
+func RunBulk(ctx context.Context, n, concurrency int, op func(i int) error) ([]error, error) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	errs := make([]error, n)
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+		// both cases may be ready, a slot taken after ctx is done is given back
+		if err := ctx.Err(); err != nil {
+			<-sem
+			errs[i] = err
+			continue
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = op(i)
+		}(i)
+	}
+	wg.Wait()
+	return errs, ctx.Err()
+}
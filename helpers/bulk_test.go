@@ -0,0 +1,80 @@
+package helpers_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	. "github.com/IBM-Cloud/bluemix-go/helpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bulk", func() {
+	Describe("RunBulk", func() {
+		It("should run every item with bounded concurrency", func() {
+			var running, peak int32
+			failure := errors.New("failed")
+			errs, err := RunBulk(context.Background(), 20, 3, func(i int) error {
+				n := atomic.AddInt32(&running, 1)
+				defer atomic.AddInt32(&running, -1)
+				for {
+					p := atomic.LoadInt32(&peak)
+					if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+						break
+					}
+				}
+				time.Sleep(time.Millisecond)
+				if i == 7 {
+					return failure
+				}
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(errs).To(HaveLen(20))
+			for i, e := range errs {
+				if i == 7 {
+					Expect(e).To(Equal(failure))
+				} else {
+					Expect(e).NotTo(HaveOccurred())
+				}
+			}
+			Expect(atomic.LoadInt32(&peak)).To(BeNumerically("<=", 3))
+		})
+		It("should report the context error for the items not started", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			errs, err := RunBulk(ctx, 4, 1, func(i int) error {
+				cancel()
+				return nil
+			})
+			Expect(err).To(Equal(context.Canceled))
+			Expect(errs[0]).NotTo(HaveOccurred())
+			Expect(errs[1:]).To(Equal([]error{context.Canceled, context.Canceled, context.Canceled}))
+		})
+		It("should start no item once the context is done", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			var calls int32
+			errs, err := RunBulk(ctx, 50, 2, func(i int) error {
+				atomic.AddInt32(&calls, 1)
+				return nil
+			})
+			Expect(err).To(Equal(context.Canceled))
+			Expect(calls).To(BeZero())
+			for _, e := range errs {
+				Expect(e).To(Equal(context.Canceled))
+			}
+		})
+	})
+
+	Describe("BulkResults", func() {
+		It("should return the failed results", func() {
+			failure := errors.New("failed")
+			results := BulkResults{{ID: "a"}, {ID: "b", Err: failure}, {ID: "c"}}
+			Expect(results.Failed()).To(Equal(BulkResults{{ID: "b", Err: failure}}))
+			Expect(BulkResults{{ID: "a"}}.Failed()).To(BeEmpty())
+		})
+	})
+})
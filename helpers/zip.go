@@ -2,10 +2,7 @@ package helpers
 
 import (
 	"archive/zip"
-	"io"
 	"os"
-	"path"
-	"path/filepath"
 )
 
 //Unzip src to dest
@@ -21,8 +18,9 @@ func Unzip(src, dest string) error {
 		return err
 	}
 
+	var total int64
 	for _, f := range r.File {
-		err := extractFileInZipArchive(dest, f)
+		err := extractFileInZipArchive(dest, f, &total)
 		if err != nil {
 			return err
 		}
@@ -31,27 +29,23 @@ func Unzip(src, dest string) error {
 	return nil
 }
 
-func extractFileInZipArchive(dest string, f *zip.File) error {
-	rc, err := f.Open()
+func extractFileInZipArchive(dest string, f *zip.File, total *int64) error {
+	path, err := archiveEntryPath(dest, f.Name)
 	if err != nil {
 		return err
 	}
-	defer rc.Close()
-
-	path := filepath.Join(path.Clean(dest), path.Clean(f.Name))
 
 	if f.FileInfo().IsDir() {
-		return os.MkdirAll(path, f.Mode())
+		return os.MkdirAll(path, 0755)
 	}
-	err = os.MkdirAll(filepath.Dir(path), f.Mode())
-	if err != nil {
+	if err := checkArchiveEntrySize(f.Name, int64(f.UncompressedSize64), *total); err != nil {
 		return err
 	}
-	zf, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, f.Mode())
+
+	rc, err := f.Open()
 	if err != nil {
 		return err
 	}
-	defer zf.Close()
-	_, err = io.Copy(zf, rc)
-	return err
+	defer rc.Close()
+	return writeArchiveEntry(path, f.Mode().Perm(), rc, total)
 }
@@ -0,0 +1,77 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httputil"
+	"strings"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/trace"
+)
+
+// DiagnosticsTransport is a thin wrapper around Transport which keeps a
+// sanitized copy of every request and response in a trace.Recorder.
+type DiagnosticsTransport struct {
+	rt       http.RoundTripper
+	recorder *trace.Recorder
+}
+
+// NewDiagnosticsTransport returns a DiagnosticsTransport wrapping around the
+// passed RoundTripper. If the passed RoundTripper is nil, HTTP
+// DefaultTransport is used.
+func NewDiagnosticsTransport(rt http.RoundTripper, recorder *trace.Recorder) *DiagnosticsTransport {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	return &DiagnosticsTransport{
+		rt:       rt,
+		recorder: recorder,
+	}
+}
+
+//RoundTrip ...
+func (d *DiagnosticsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	e := trace.Exchange{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Start:  time.Now(),
+	}
+	dumpBody := isTextContent(req.Header.Get("Content-Type"))
+	if dump, err := httputil.DumpRequestOut(req, dumpBody); err == nil {
+		e.Request = trace.Sanitize(string(dump))
+	}
+
+	resp, err := d.rt.RoundTrip(req)
+	e.Duration = time.Since(e.Start)
+	if err != nil {
+		e.Error = err.Error()
+		d.recorder.Record(e)
+		return resp, err
+	}
+
+	e.StatusCode = resp.StatusCode
+	e.TransactionID = bluemix.TransactionID(resp.Header)
+	dumpBody = isTextContent(resp.Header.Get("Content-Type"))
+	if dump, err := httputil.DumpResponse(resp, dumpBody); err == nil {
+		e.Response = trace.Sanitize(string(dump))
+	}
+	d.recorder.Record(e)
+	return resp, nil
+}
+
+//isTextContent reports whether a body of the given content type is readable
+//text worth keeping. Archives, e.g. the zip and tar.gz cluster config
+//bundles, and other binary or multipart bodies are left out of the dumps.
+func isTextContent(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+	contentType = strings.ToLower(contentType)
+	for _, t := range []string{"json", "text/", "xml", "x-www-form-urlencoded", "yaml"} {
+		if strings.Contains(contentType, t) {
+			return true
+		}
+	}
+	return false
+}
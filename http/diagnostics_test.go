@@ -0,0 +1,92 @@
+package http_test
+
+import (
+	"net/http"
+	"strings"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	. "github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/trace"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DiagnosticsTransport", func() {
+	var server *ghttp.Server
+	var recorder *trace.Recorder
+	var client *http.Client
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		recorder = trace.NewRecorder(10)
+		client = NewHTTPClient(&bluemix.Config{Diagnostics: recorder})
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Context("When a JSON request succeeds", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, "/v2/clusters"),
+					ghttp.RespondWith(http.StatusCreated, `{"id": "c1"}`, http.Header{
+						"Content-Type":   []string{"application/json"},
+						"Transaction-Id": []string{"tx-123"},
+					}),
+				),
+			)
+		})
+
+		It("should record a sanitized exchange", func() {
+			req, _ := http.NewRequest(http.MethodPost, server.URL()+"/v2/clusters", strings.NewReader(`{"name": "c1", "apikey": "secret-key"}`))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer secret-token")
+			resp, err := client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+			resp.Body.Close()
+
+			exchanges := recorder.Exchanges()
+			Expect(exchanges).To(HaveLen(1))
+			e := exchanges[0]
+			Expect(e.Method).To(Equal(http.MethodPost))
+			Expect(e.StatusCode).To(Equal(http.StatusCreated))
+			Expect(e.TransactionID).To(Equal("tx-123"))
+			Expect(e.Request).NotTo(ContainSubstring("secret-token"))
+			Expect(e.Request).NotTo(ContainSubstring("secret-key"))
+			Expect(e.Request).To(ContainSubstring(`"name": "c1"`))
+			Expect(e.Response).To(ContainSubstring(`{"id": "c1"}`))
+		})
+	})
+	Context("When the response is an archive", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/v2/applyRBACAndGetKubeconfig"),
+					ghttp.RespondWith(http.StatusOK, "\x1f\x8bBINARYCONTENT", http.Header{
+						"Content-Type": []string{"application/gzip"},
+					}),
+				),
+			)
+		})
+
+		It("should not record the body", func() {
+			resp, err := client.Get(server.URL() + "/v2/applyRBACAndGetKubeconfig")
+			Expect(err).NotTo(HaveOccurred())
+			resp.Body.Close()
+			Expect(recorder.Exchanges()[0].Response).NotTo(ContainSubstring("BINARYCONTENT"))
+		})
+	})
+	Context("When the request fails", func() {
+		It("should record the error", func() {
+			url := server.URL()
+			server.Close()
+			_, err := client.Get(url + "/v2/getClusters")
+			Expect(err).To(HaveOccurred())
+			Expect(recorder.Exchanges()).To(HaveLen(1))
+			Expect(recorder.Exchanges()[0].Error).NotTo(BeEmpty())
+		})
+	})
+})
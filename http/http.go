@@ -2,13 +2,18 @@ package http
 
 import (
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"net/http"
+	"net/url"
 	"runtime"
+	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/bluemix-go"
+	"golang.org/x/net/http/httpproxy"
 )
 
 //NewHTTPClient ...
@@ -20,13 +25,26 @@ func NewHTTPClient(config *bluemix.Config) *http.Client {
 }
 
 func makeTransport(config *bluemix.Config) http.RoundTripper {
+	rt := config.Transport
+	if rt == nil {
+		rt = makeDefaultTransport(config)
+	}
+	if config.Diagnostics != nil {
+		rt = NewDiagnosticsTransport(rt, config.Diagnostics)
+	}
+	return NewStructuredTraceLoggingTransport(rt, config.Logger)
+}
+
+func makeDefaultTransport(config *bluemix.Config) http.RoundTripper {
 	proxyFunc := http.ProxyFromEnvironment
-	if config.HTTPClient != nil && config.HTTPClient.Transport != nil {
+	if config.ProxyURL != "" {
+		proxyFunc = configProxy(config)
+	} else if config.HTTPClient != nil && config.HTTPClient.Transport != nil {
 		if t, ok := config.HTTPClient.Transport.(*http.Transport); ok {
 			proxyFunc = t.Proxy
 		}
 	}
-	return NewTraceLoggingTransport(&http.Transport{
+	return &http.Transport{
 		Proxy: proxyFunc,
 		Dial: (&net.Dialer{
 			Timeout:   50 * time.Second,
@@ -34,13 +52,68 @@ func makeTransport(config *bluemix.Config) http.RoundTripper {
 		}).Dial,
 		TLSHandshakeTimeout: 20 * time.Second,
 		DisableCompression:  true,
+		MaxIdleConns:        config.MaxIdleConns,
+		MaxIdleConnsPerHost: config.MaxIdleConnsPerHost,
+		IdleConnTimeout:     config.IdleConnTimeout,
 		TLSClientConfig: &tls.Config{
 			InsecureSkipVerify: config.SSLDisable,
+			RootCAs:            config.TLSRootCAs,
+			Certificates:       config.TLSCertificates,
+			MinVersion:         config.TLSMinVersion,
 		},
-	})
+	}
+}
+
+//configProxy returns the proxy function of the proxy settings of the config.
+//An invalid proxy URL fails every request rather than being ignored.
+func configProxy(config *bluemix.Config) func(*http.Request) (*url.URL, error) {
+	proxyURL := config.ProxyURL
+	if config.ProxyUsername != "" {
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			return func(*http.Request) (*url.URL, error) {
+				return nil, fmt.Errorf("Invalid proxy URL: %v", err)
+			}
+		}
+		u.User = url.UserPassword(config.ProxyUsername, config.ProxyPassword)
+		proxyURL = u.String()
+	}
+	proxy := (&httpproxy.Config{
+		HTTPProxy:  proxyURL,
+		HTTPSProxy: proxyURL,
+		NoProxy:    config.NoProxy,
+	}).ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxy(req.URL)
+	}
+}
+
+//AppendCertsFromFile returns the system certificate pool with the certificates
+//of the PEM file added, to be set as Config.TLSRootCAs
+func AppendCertsFromFile(file string) (*x509.CertPool, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	pem, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("No certificate found in %s", file)
+	}
+	return pool, nil
 }
 
 //UserAgent ...
 func UserAgent() string {
 	return fmt.Sprintf("Bluemix-go SDK %s / %s ", bluemix.Version, runtime.GOOS)
 }
+
+//ConfigUserAgent returns UserAgent followed by the UserAgentSuffix of the config
+func ConfigUserAgent(c *bluemix.Config) string {
+	if c.UserAgentSuffix == "" {
+		return UserAgent()
+	}
+	return strings.TrimSpace(UserAgent()) + " " + c.UserAgentSuffix
+}
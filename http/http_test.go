@@ -0,0 +1,178 @@
+package http_test
+
+import (
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"os"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	. "github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/trace"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+var _ = Describe("NewHTTPClient", func() {
+	var server *ghttp.Server
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/v1/things"),
+				ghttp.VerifyHeaderKV("X-Instrumented", "true"),
+				ghttp.RespondWith(http.StatusOK, `{}`),
+			),
+		)
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Context("When a transport is configured", func() {
+		It("should send the requests through it", func() {
+			calls := 0
+			recorder := trace.NewRecorder(10)
+			client := NewHTTPClient(&bluemix.Config{
+				Diagnostics: recorder,
+				Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					calls++
+					req.Header.Set("X-Instrumented", "true")
+					return http.DefaultTransport.RoundTrip(req)
+				}),
+			})
+			resp, err := client.Get(server.URL() + "/v1/things")
+			Expect(err).NotTo(HaveOccurred())
+			resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(calls).To(Equal(1))
+			Expect(recorder.Exchanges()).To(HaveLen(1))
+		})
+	})
+
+	Context("When a proxy is configured", func() {
+		var proxy *ghttp.Server
+		BeforeEach(func() {
+			proxy = ghttp.NewServer()
+			proxy.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/v1/things"),
+					ghttp.VerifyHeaderKV("Proxy-Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("user:secret"))),
+					func(w http.ResponseWriter, req *http.Request) {
+						Expect(req.Host).To(Equal("api.example.com"))
+					},
+					ghttp.RespondWith(http.StatusOK, `{}`),
+				),
+			)
+		})
+		AfterEach(func() {
+			proxy.Close()
+		})
+
+		It("should send the requests through the proxy", func() {
+			client := NewHTTPClient(&bluemix.Config{
+				ProxyURL:      proxy.URL(),
+				ProxyUsername: "user",
+				ProxyPassword: "secret",
+			})
+			resp, err := client.Get("http://api.example.com/v1/things")
+			Expect(err).NotTo(HaveOccurred())
+			resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(proxy.ReceivedRequests()).To(HaveLen(1))
+		})
+		It("should reach the hosts of NoProxy directly", func() {
+			client := NewHTTPClient(&bluemix.Config{
+				ProxyURL: proxy.URL(),
+				NoProxy:  "example.invalid",
+			})
+			_, err := client.Get("http://api.example.invalid/v1/things")
+			Expect(err).To(HaveOccurred())
+			Expect(proxy.ReceivedRequests()).To(BeEmpty())
+		})
+	})
+
+	Context("When the server certificate is signed by a private CA", func() {
+		var tlsServer *ghttp.Server
+		var caFile string
+		BeforeEach(func() {
+			tlsServer = ghttp.NewTLSServer()
+			tlsServer.RouteToHandler(http.MethodGet, "/v1/things", ghttp.RespondWith(http.StatusOK, `{}`))
+			f, err := ioutil.TempFile("", "ca")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pem.Encode(f, &pem.Block{Type: "CERTIFICATE", Bytes: tlsServer.HTTPTestServer.Certificate().Raw})).To(Succeed())
+			Expect(f.Close()).To(Succeed())
+			caFile = f.Name()
+		})
+		AfterEach(func() {
+			tlsServer.Close()
+			os.Remove(caFile)
+		})
+
+		It("should fail without the CA", func() {
+			_, err := NewHTTPClient(&bluemix.Config{}).Get(tlsServer.URL() + "/v1/things")
+			Expect(err).To(HaveOccurred())
+		})
+		It("should trust the configured CA", func() {
+			pool, err := AppendCertsFromFile(caFile)
+			Expect(err).NotTo(HaveOccurred())
+			client := NewHTTPClient(&bluemix.Config{TLSRootCAs: pool, TLSMinVersion: tls.VersionTLS12})
+			resp, err := client.Get(tlsServer.URL() + "/v1/things")
+			Expect(err).NotTo(HaveOccurred())
+			resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		})
+		It("should reject a file without certificates", func() {
+			Expect(ioutil.WriteFile(caFile, []byte("not a certificate"), 0600)).To(Succeed())
+			_, err := AppendCertsFromFile(caFile)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("When a logger is configured", func() {
+		It("should log the dumps to it", func() {
+			logger := &debugLogger{}
+			client := NewHTTPClient(&bluemix.Config{Logger: logger})
+			req, err := http.NewRequest(http.MethodGet, server.URL()+"/v1/things", nil)
+			Expect(err).NotTo(HaveOccurred())
+			req.Header.Set("X-Instrumented", "true")
+			req.Header.Set("Authorization", "Bearer secret")
+			resp, err := client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+			resp.Body.Close()
+			Expect(logger.messages).To(Equal([]string{"REQUEST", "RESPONSE"}))
+			Expect(logger.dumps[0]).NotTo(ContainSubstring("secret"))
+		})
+	})
+})
+
+type debugLogger struct {
+	messages []string
+	dumps    []string
+}
+
+func (l *debugLogger) Debug(msg string, kv ...interface{}) {
+	l.messages = append(l.messages, msg)
+	for i := 0; i+1 < len(kv); i += 2 {
+		if kv[i] == "dump" {
+			l.dumps = append(l.dumps, kv[i+1].(string))
+		}
+	}
+}
+
+func (l *debugLogger) Info(msg string, kv ...interface{}) {}
+
+func (l *debugLogger) Warn(msg string, kv ...interface{}) {}
+
+func (l *debugLogger) Error(msg string, kv ...interface{}) {}
@@ -0,0 +1,24 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Connection pool", func() {
+	It("should configure the pool of the default transport", func() {
+		t := makeDefaultTransport(&bluemix.Config{
+			MaxIdleConns:        200,
+			MaxIdleConnsPerHost: 50,
+			IdleConnTimeout:     90 * time.Second,
+		}).(*http.Transport)
+		Expect(t.MaxIdleConns).To(Equal(200))
+		Expect(t.MaxIdleConnsPerHost).To(Equal(50))
+		Expect(t.IdleConnTimeout).To(Equal(90 * time.Second))
+	})
+})
@@ -14,7 +14,8 @@ import (
 // environment variable. Sensitive user data will be replaced by text
 // "[PRIVATE DATA HIDDEN]".
 type TraceLoggingTransport struct {
-	rt http.RoundTripper
+	rt     http.RoundTripper
+	logger trace.StructuredLogger
 }
 
 // NewTraceLoggingTransport returns a TraceLoggingTransport wrapping around
@@ -31,6 +32,14 @@ func NewTraceLoggingTransport(rt http.RoundTripper) *TraceLoggingTransport {
 	}
 }
 
+// NewStructuredTraceLoggingTransport returns a TraceLoggingTransport logging
+// the dumps at debug level to logger instead of the global trace logger.
+func NewStructuredTraceLoggingTransport(rt http.RoundTripper, logger trace.StructuredLogger) *TraceLoggingTransport {
+	t := NewTraceLoggingTransport(rt)
+	t.logger = logger
+	return t
+}
+
 //RoundTrip ...
 func (r *TraceLoggingTransport) RoundTrip(req *http.Request) (resp *http.Response, err error) {
 	start := time.Now()
@@ -44,11 +53,17 @@ func (r *TraceLoggingTransport) RoundTrip(req *http.Request) (resp *http.Respons
 }
 
 func (r *TraceLoggingTransport) dumpRequest(req *http.Request, start time.Time) {
-	shouldDisplayBody := !strings.Contains(req.Header.Get("Content-Type"), "multipart/form-data")
+	multipart := strings.Contains(req.Header.Get("Content-Type"), "multipart/form-data")
+	compressed := req.Header.Get("Content-Encoding") != ""
+	shouldDisplayBody := !multipart && !compressed
 
 	dumpedRequest, err := httputil.DumpRequest(req, shouldDisplayBody)
 	if err != nil {
-		trace.Logger.Printf("[ERROR] An error occurred while dumping request: %v", err)
+		trace.For(r.logger).Error("An error occurred while dumping request", "error", err)
+		return
+	}
+	if r.logger != nil {
+		r.logger.Debug("REQUEST", "time", start.Format(time.RFC3339), "dump", trace.Sanitize(string(dumpedRequest)), "bodyHidden", !shouldDisplayBody)
 		return
 	}
 
@@ -57,18 +72,25 @@ func (r *TraceLoggingTransport) dumpRequest(req *http.Request, start time.Time)
 		start.Format(time.RFC3339),
 		trace.Sanitize(string(dumpedRequest)))
 
-	if !shouldDisplayBody {
+	if multipart {
 		trace.Logger.Println("[DEBUG] [MULTIPART/FORM-DATA CONTENT HIDDEN]")
+	} else if compressed {
+		trace.Logger.Println("[DEBUG] [COMPRESSED CONTENT HIDDEN]")
 	}
 }
 
 func (r *TraceLoggingTransport) dumpResponse(res *http.Response, start time.Time) {
 	end := time.Now()
 
-	shouldDisplayBody := !strings.Contains(res.Header.Get("Content-Type"), "application/zip")
+	shouldDisplayBody := !strings.Contains(res.Header.Get("Content-Type"), "application/zip") &&
+		res.Header.Get("Content-Encoding") == ""
 	dumpedResponse, err := httputil.DumpResponse(res, shouldDisplayBody)
 	if err != nil {
-		trace.Logger.Printf("[ERROR] An error occurred while dumping response: %v", err)
+		trace.For(r.logger).Error("An error occurred while dumping response", "error", err)
+		return
+	}
+	if r.logger != nil {
+		r.logger.Debug("RESPONSE", "time", end.Format(time.RFC3339), "elapsedMs", end.Sub(start).Milliseconds(), "dump", trace.Sanitize(string(dumpedResponse)))
 		return
 	}
 
@@ -0,0 +1,40 @@
+package bluemix
+
+import (
+	"net/http"
+)
+
+//Interceptor hooks into the requests sent by the service clients, for instance
+//to add headers, audit the requests or rewrite the responses. Every hook is
+//optional. In a chain the Before hooks run in order, the After and OnError
+//hooks in reverse order, so that the first interceptor sees the request first
+//and the response last.
+type Interceptor struct {
+	//Before is called before every attempt of a request, it may modify the
+	//request. An error fails the request without sending it.
+	Before func(service ServiceName, req *http.Request) error
+	//After is called with the response of every attempt whatever its status,
+	//before the response is decoded. It may modify the response.
+	After func(service ServiceName, req *http.Request, resp *http.Response)
+	//OnError is called with the error a request fails with, once the retries
+	//are over, and returns the error to return in its place
+	OnError func(service ServiceName, err error) error
+}
+
+//RequestSigner signs the requests of the service clients, for the gateways in
+//front of IBM Cloud requiring HMAC or custom signatures
+type RequestSigner interface {
+	//Sign is called before every attempt of a request, once its headers are
+	//set and the Before hooks of the interceptors have run. body is the
+	//request body, nil when there is none, the body of req stays readable. An
+	//error fails the request without sending it.
+	Sign(service ServiceName, req *http.Request, body []byte) error
+}
+
+//RequestSignerFunc is a function used as a RequestSigner
+type RequestSignerFunc func(service ServiceName, req *http.Request, body []byte) error
+
+//Sign ...
+func (f RequestSignerFunc) Sign(service ServiceName, req *http.Request, body []byte) error {
+	return f(service, req, body)
+}
@@ -0,0 +1,99 @@
+package bluemix
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+//RateLimiter limits the rate of the requests sent by the service clients. Set
+//on the config of a session, it is shared by all the clients created from the
+//session: the services without a limit of their own share one token bucket,
+//each service given its own limit with SetServiceLimit gets a separate bucket.
+type RateLimiter struct {
+	mu       sync.Mutex
+	limit    tokenBucket
+	services map[ServiceName]*tokenBucket
+}
+
+//NewRateLimiter returns a limiter allowing rps requests per second on average
+//with bursts of up to burst requests. A rps of 0 or less means no limit.
+func NewRateLimiter(rps float64, burst int) *RateLimiter {
+	return &RateLimiter{
+		limit:    newTokenBucket(rps, burst),
+		services: map[ServiceName]*tokenBucket{},
+	}
+}
+
+//SetServiceLimit gives the service its own limit instead of the shared one
+func (l *RateLimiter) SetServiceLimit(service ServiceName, rps float64, burst int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	b := newTokenBucket(rps, burst)
+	l.services[service] = &b
+}
+
+//Wait blocks until a request of the service can be sent. It returns the
+//context error if ctx is done first.
+func (l *RateLimiter) Wait(ctx context.Context, service ServiceName) error {
+	l.mu.Lock()
+	b, ok := l.services[service]
+	if !ok {
+		b = &l.limit
+	}
+	delay := b.reserve(time.Now())
+	l.mu.Unlock()
+	if delay <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(delay)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		l.mu.Lock()
+		b.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+type tokenBucket struct {
+	rps    float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rps float64, burst int) tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return tokenBucket{
+		rps:    rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+	}
+}
+
+//reserve takes a token from the bucket and returns how long to wait before it
+//is available, the token may be borrowed from the future
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	if b.rps <= 0 {
+		return 0
+	}
+	if !b.last.IsZero() {
+		b.tokens += now.Sub(b.last).Seconds() * b.rps
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rps * float64(time.Second))
+}
@@ -0,0 +1,52 @@
+package bluemix_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/IBM-Cloud/bluemix-go"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RateLimiter", func() {
+	wait := func(l *RateLimiter, service ServiceName) time.Duration {
+		start := time.Now()
+		Expect(l.Wait(context.Background(), service)).To(Succeed())
+		return time.Since(start)
+	}
+
+	It("should let the burst through and then wait", func() {
+		l := NewRateLimiter(10, 2)
+		Expect(wait(l, VpcContainerService)).To(BeNumerically("<", 20*time.Millisecond))
+		Expect(wait(l, VpcContainerService)).To(BeNumerically("<", 20*time.Millisecond))
+		Expect(wait(l, VpcContainerService)).To(BeNumerically(">=", 80*time.Millisecond))
+	})
+	It("should share the limit between the services", func() {
+		l := NewRateLimiter(10, 1)
+		Expect(wait(l, VpcContainerService)).To(BeNumerically("<", 20*time.Millisecond))
+		Expect(wait(l, IAMService)).To(BeNumerically(">=", 80*time.Millisecond))
+	})
+	It("should apply the limit of the service", func() {
+		l := NewRateLimiter(1, 1)
+		l.SetServiceLimit(VpcContainerService, 100, 5)
+		Expect(wait(l, IAMService)).To(BeNumerically("<", 20*time.Millisecond))
+		for i := 0; i < 5; i++ {
+			Expect(wait(l, VpcContainerService)).To(BeNumerically("<", 20*time.Millisecond))
+		}
+	})
+	It("should not limit without a rate", func() {
+		l := NewRateLimiter(0, 0)
+		for i := 0; i < 100; i++ {
+			Expect(wait(l, IAMService)).To(BeNumerically("<", 20*time.Millisecond))
+		}
+	})
+	It("should stop waiting when the context is canceled", func() {
+		l := NewRateLimiter(0.1, 1)
+		Expect(wait(l, IAMService)).To(BeNumerically("<", 20*time.Millisecond))
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		Expect(l.Wait(ctx, IAMService)).To(Equal(context.DeadlineExceeded))
+	})
+})
@@ -0,0 +1,143 @@
+package bluemix
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+)
+
+//TransactionIDHeaders are the response headers carrying the transaction ID of
+//a request, by order of precedence
+var TransactionIDHeaders = []string{"Transaction-Id", "X-Request-Id", "X-Correlation-Id"}
+
+//ResponseMetadata describes a response received by a service client
+type ResponseMetadata struct {
+	Service       ServiceName
+	Method        string
+	URL           string
+	StatusCode    int
+	Header        http.Header
+	TransactionID string
+	//ETag is the version of the resource returned by the services supporting
+	//conditional updates, to send back with client.IfMatch
+	ETag string
+	//Links are the URLs of the Link header by relation, such as the "next" page
+	//of the paginated lists
+	Links map[string]string
+}
+
+//NewResponseMetadata ...
+func NewResponseMetadata(service ServiceName, resp *http.Response) ResponseMetadata {
+	m := ResponseMetadata{
+		Service:    service,
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+	}
+	if resp.Request != nil {
+		m.Method = resp.Request.Method
+		m.URL = resp.Request.URL.String()
+	}
+	m.TransactionID = TransactionID(resp.Header)
+	m.ETag = resp.Header.Get("ETag")
+	m.Links = parseLinks(resp.Header)
+	return m
+}
+
+//parseLinks parses the Link headers, <https://host/v2/clusters?start=2>; rel="next"
+func parseLinks(h http.Header) map[string]string {
+	var links map[string]string
+	for _, value := range h["Link"] {
+		for _, link := range strings.Split(value, ",") {
+			parts := strings.Split(link, ";")
+			target := strings.TrimSpace(parts[0])
+			if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+			for _, param := range parts[1:] {
+				kv := strings.SplitN(strings.TrimSpace(param), "=", 2)
+				if len(kv) != 2 || !strings.EqualFold(kv[0], "rel") {
+					continue
+				}
+				if links == nil {
+					links = map[string]string{}
+				}
+				for _, rel := range strings.Fields(strings.Trim(kv[1], `"`)) {
+					links[rel] = target[1 : len(target)-1]
+				}
+			}
+		}
+	}
+	return links
+}
+
+//TransactionID returns the transaction ID found in the headers, if any
+func TransactionID(h http.Header) string {
+	for _, name := range TransactionIDHeaders {
+		if id := h.Get(name); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+//ResponseError is the error returned by the service clients for the requests
+//failing with a response from the service. It is a bmxerror.RequestFailure
+//carrying the metadata of the response, such as the transaction ID IBM support
+//asks for.
+type ResponseError struct {
+	Err      bmxerror.RequestFailure
+	Metadata ResponseMetadata
+	//Body is the raw body of the response, when the service returned one
+	Body string
+}
+
+//NewResponseError returns err unchanged when it is not a request failure
+//received with resp
+func NewResponseError(service ServiceName, resp *http.Response, err error) error {
+	rf, ok := err.(bmxerror.RequestFailure)
+	if !ok || resp == nil || resp.StatusCode == 0 {
+		return err
+	}
+	e := &ResponseError{
+		Err:      rf,
+		Metadata: NewResponseMetadata(service, resp),
+	}
+	if rf.Code() == "ServerErrorResponse" {
+		e.Body = rf.Description()
+	}
+	return e
+}
+
+//TransactionID ...
+func (e *ResponseError) TransactionID() string {
+	return e.Metadata.TransactionID
+}
+
+//Code ...
+func (e *ResponseError) Code() string {
+	return e.Err.Code()
+}
+
+//Description ...
+func (e *ResponseError) Description() string {
+	return e.Err.Description()
+}
+
+//StatusCode ...
+func (e *ResponseError) StatusCode() int {
+	return e.Err.StatusCode()
+}
+
+//Unwrap returns the request failure
+func (e *ResponseError) Unwrap() error {
+	return e.Err
+}
+
+func (e *ResponseError) Error() string {
+	if e.Metadata.TransactionID == "" {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%s (transaction ID: %s)", e.Err.Error(), e.Metadata.TransactionID)
+}
@@ -0,0 +1,39 @@
+package bluemix_test
+
+import (
+	"net/http"
+
+	. "github.com/IBM-Cloud/bluemix-go"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TransactionID", func() {
+	It("should prefer the Transaction-Id header", func() {
+		Expect(TransactionID(http.Header{"Transaction-Id": {"t1"}, "X-Request-Id": {"r1"}})).To(Equal("t1"))
+		Expect(TransactionID(http.Header{"X-Correlation-Id": {"c1"}})).To(Equal("c1"))
+		Expect(TransactionID(http.Header{})).To(BeEmpty())
+	})
+})
+
+var _ = Describe("ResponseMetadata", func() {
+	It("should parse the links by relation", func() {
+		m := NewResponseMetadata(VpcContainerService, &http.Response{
+			StatusCode: http.StatusOK,
+			Header: http.Header{"Link": {
+				`<https://host/v1/keys?start=3>; rel="next", <https://host/v1/keys>; rel="first"`,
+				`<https://host/v1/keys?start=1>; rel=prev`,
+			}},
+		})
+		Expect(m.Links).To(Equal(map[string]string{
+			"next":  "https://host/v1/keys?start=3",
+			"first": "https://host/v1/keys",
+			"prev":  "https://host/v1/keys?start=1",
+		}))
+	})
+	It("should have no links without a Link header", func() {
+		m := NewResponseMetadata(VpcContainerService, &http.Response{Header: http.Header{}})
+		Expect(m.Links).To(BeNil())
+	})
+})
@@ -69,6 +69,13 @@ type Client struct {
 	HTTPClient *http.Client
 	// Defaualt header for all outgoing HTTP requests.
 	DefaultHeader http.Header
+	// AcceptGzip asks for gzip encoded responses. The gzip encoded responses
+	// are decompressed transparently in any case.
+	AcceptGzip bool
+	// GzipMinSize, when positive, is the size in bytes from which the request
+	// bodies are sent gzip encoded. Only set it for the services accepting
+	// compressed requests.
+	GzipMinSize int
 }
 
 // NewClient creates a new REST client.
@@ -103,6 +110,9 @@ func (c *Client) Do(r *Request, respV interface{}, errV interface{}) (*http.Resp
 		return resp, err
 	}
 	defer resp.Body.Close()
+	if err := gunzipResponse(resp); err != nil {
+		return resp, fmt.Errorf("Error reading response: %v", err)
+	}
 
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
 		raw, err := ioutil.ReadAll(resp.Body)
@@ -176,17 +186,36 @@ func (c *Client) makeRequest(r *Request) (*http.Request, error) {
 	if req.Header.Get("Accept-Language") == "" {
 		req.Header.Set("Accept-Language", "en")
 	}
+	if c.AcceptGzip && req.Header.Get("Accept-Encoding") == "" {
+		req.Header.Set("Accept-Encoding", "gzip")
+	}
+	if err := gzipRequestBody(req, c.GzipMinSize); err != nil {
+		return nil, err
+	}
 
 	return req, nil
 }
 
+//applyDefaultHeader adds the default headers the request has no value for.
+//The empty values of the request, such as the ones of an unset target header,
+//are replaced by the default ones.
 func (c *Client) applyDefaultHeader(req *http.Request) {
 	for k, vs := range c.DefaultHeader {
-		if req.Header.Get(k) != "" {
+		if hasHeaderValue(req.Header, k) {
 			continue
 		}
+		req.Header.Del(k)
 		for _, v := range vs {
 			req.Header.Add(k, v)
 		}
 	}
 }
+
+func hasHeaderValue(h http.Header, key string) bool {
+	for _, v := range h[http.CanonicalHeaderKey(key)] {
+		if v != "" {
+			return true
+		}
+	}
+	return false
+}
@@ -2,6 +2,7 @@ package rest
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -10,6 +11,7 @@ import (
 	"net/textproto"
 	"net/url"
 	"strings"
+	"time"
 )
 
 const (
@@ -42,6 +44,10 @@ type Request struct {
 
 	// custom request body
 	body interface{}
+
+	ctx     context.Context
+	timeout time.Duration
+	noRetry bool
 }
 
 // NewRequest creates a new REST request with the given rawUrl.
@@ -61,6 +67,17 @@ func (r *Request) Method(method string) *Request {
 	return r
 }
 
+// HTTPMethod returns the HTTP method of the request.
+func (r *Request) HTTPMethod() string {
+	return r.method
+}
+
+// RawURL returns the URL of the request, without the query parameters added
+// with Query.
+func (r *Request) RawURL() string {
+	return r.rawUrl
+}
+
 // GetRequest creates a REST request with GET method and the given rawUrl.
 func GetRequest(rawUrl string) *Request {
 	return NewRequest(rawUrl).Method("GET")
@@ -140,12 +157,53 @@ func (r *Request) File(name string, file File) *Request {
 }
 
 // Body sets the request body. Accepted types are string, []byte, io.Reader,
-// or structs to be JSON encodeded.
+// *Stream, or structs to be JSON encodeded.
 func (r *Request) Body(body interface{}) *Request {
 	r.body = body
 	return r
 }
 
+// WithContext sets the context of the request. The HTTP request is canceled
+// when the context is done.
+func (r *Request) WithContext(ctx context.Context) *Request {
+	r.ctx = ctx
+	return r
+}
+
+// Context returns the context of the request, context.Background() when none
+// was set.
+func (r *Request) Context() context.Context {
+	if r.ctx == nil {
+		return context.Background()
+	}
+	return r.ctx
+}
+
+// Timeout sets the deadline of the request, including its retries. It
+// replaces the timeout of the HTTP client sending the request.
+func (r *Request) Timeout(d time.Duration) *Request {
+	r.timeout = d
+	return r
+}
+
+// TimeoutDuration returns the timeout set with Timeout, 0 when none was set.
+func (r *Request) TimeoutDuration() time.Duration {
+	return r.timeout
+}
+
+// NoRetry makes the request sent once, whatever the retries of the client, for
+// the requests that are not safe to send again such as the ones creating a
+// resource.
+func (r *Request) NoRetry() *Request {
+	r.noRetry = true
+	return r
+}
+
+// Retryable returns false when NoRetry was called.
+func (r *Request) Retryable() bool {
+	return !r.noRetry
+}
+
 // Build builds a HTTP request according to the settings in the REST request.
 func (r *Request) Build() (*http.Request, error) {
 	url, err := r.buildURL()
@@ -162,6 +220,15 @@ func (r *Request) Build() (*http.Request, error) {
 	if err != nil {
 		return req, err
 	}
+	if s, ok := r.body.(*Stream); ok && body != nil {
+		req.ContentLength = s.Length
+		if s.Length == 0 {
+			req.Body = http.NoBody
+		}
+	}
+	if r.ctx != nil {
+		req = req.WithContext(r.ctx)
+	}
 
 	for k, vs := range r.header {
 		for _, v := range vs {
@@ -273,6 +340,12 @@ func (r *Request) buildCustomBody() (io.Reader, error) {
 		return strings.NewReader(b.(string)), nil
 	case []byte:
 		return bytes.NewReader(b.([]byte)), nil
+	case *Stream:
+		s := b.(*Stream)
+		if r.header.Get(contentType) == "" {
+			r.header.Set(contentType, s.contentType())
+		}
+		return s.reader()
 	case io.Reader:
 		return b.(io.Reader), nil
 	default:
@@ -0,0 +1,69 @@
+package rest
+
+import (
+	"io"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+)
+
+const (
+	//ErrCodeStreamConsumed ...
+	ErrCodeStreamConsumed = "StreamConsumed"
+)
+
+//ErrStreamConsumed is returned when a request with a Stream body that cannot
+//be rewound is sent again, such as to retry it
+var ErrStreamConsumed = bmxerror.New(ErrCodeStreamConsumed, "the request body stream was already sent")
+
+// Stream is a request body read from Content while the request is sent,
+// instead of being marshaled in memory, such as an archive to upload. It is
+// sent with Length as its Content-Length. When Content is an io.Seeker, such
+// as an *os.File, it is rewound to send the request again on retries.
+type Stream struct {
+	// Stream content
+	Content io.Reader
+	// Length of the content in bytes
+	Length int64
+	// Mime type, defaults to "application/octet-stream"
+	Type string
+
+	sent   bool
+	offset int64
+}
+
+// NewStream returns a body streaming the length bytes of content
+func NewStream(content io.Reader, length int64, contentType string) *Stream {
+	return &Stream{
+		Content: content,
+		Length:  length,
+		Type:    contentType,
+	}
+}
+
+// reader returns the content to send, rewound to where it started when the
+// stream is sent again
+func (s *Stream) reader() (io.Reader, error) {
+	seeker, ok := s.Content.(io.Seeker)
+	if !s.sent {
+		s.sent = true
+		if ok {
+			offset, err := seeker.Seek(0, io.SeekCurrent)
+			if err != nil {
+				return nil, err
+			}
+			s.offset = offset
+		}
+	} else if !ok {
+		return nil, ErrStreamConsumed
+	} else if _, err := seeker.Seek(s.offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return io.LimitReader(s.Content, s.Length), nil
+}
+
+func (s *Stream) contentType() string {
+	if s.Type != "" {
+		return s.Type
+	}
+	return "application/octet-stream"
+}
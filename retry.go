@@ -0,0 +1,120 @@
+package bluemix
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/bluemix-go/helpers"
+)
+
+//DefaultRetryableStatusCodes are the status codes retried when the retry policy lists none
+var DefaultRetryableStatusCodes = []int{408, 429, 500, 502, 503, 504, 520, 599}
+
+//DefaultRetryableMethods are the idempotent methods retried when the retry policy lists none
+var DefaultRetryableMethods = []string{http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete, http.MethodTrace}
+
+//RetryPolicy controls how the service clients retry the requests failing with a
+//transient error. The delay before the retry n, starting at 0, is
+//InitialDelay * Multiplier^n capped at MaxDelay, then randomized by +/- Jitter.
+type RetryPolicy struct {
+	//MaxRetries is the number of retries after the first attempt
+	MaxRetries int
+	//InitialDelay defaults to 1 second
+	InitialDelay time.Duration
+	//MaxDelay defaults to 30 seconds
+	MaxDelay time.Duration
+	//Multiplier defaults to 2
+	Multiplier float64
+	//Jitter is the fraction of the delay randomly added or removed, between 0 and 1
+	Jitter float64
+	//RetryableStatusCodes defaults to DefaultRetryableStatusCodes
+	RetryableStatusCodes []int
+	//RetryableMethods defaults to DefaultRetryableMethods, add POST or PATCH
+	//only when the service handles their replay safely
+	RetryableMethods []string
+	//MaxRetryAfter defaults to 1 minute. The 429 and 503 responses carrying a
+	//Retry-After header are retried after the delay the service asks for, the
+	//error is returned instead when it is longer than MaxRetryAfter.
+	MaxRetryAfter time.Duration
+}
+
+//Delay returns the time to wait before the given retry
+func (p *RetryPolicy) Delay(retry int) time.Duration {
+	return p.Backoff().Delay(retry)
+}
+
+//Backoff returns the backoff of the policy, for the helpers of the helpers package
+func (p *RetryPolicy) Backoff() helpers.Backoff {
+	return helpers.Backoff{
+		InitialDelay: p.InitialDelay,
+		MaxDelay:     p.MaxDelay,
+		Multiplier:   p.Multiplier,
+		Jitter:       p.Jitter,
+	}
+}
+
+//RetryAfterLimit returns the longest Retry-After delay waited for
+func (p *RetryPolicy) RetryAfterLimit() time.Duration {
+	if p.MaxRetryAfter <= 0 {
+		return time.Minute
+	}
+	return p.MaxRetryAfter
+}
+
+//RetryAfter returns the delay asked by the Retry-After header of a 429 or 503
+//response, given in seconds or as an HTTP date. It returns false for the other
+//responses and when the header is missing or invalid.
+func RetryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil || (resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable) {
+		return 0, false
+	}
+	value := strings.TrimSpace(resp.Header.Get("Retry-After"))
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	delay := time.Until(date)
+	if delay < 0 {
+		delay = 0
+	}
+	return delay, true
+}
+
+//RetryableStatus reports whether a response with the status code should be retried
+func (p *RetryPolicy) RetryableStatus(code int) bool {
+	codes := p.RetryableStatusCodes
+	if len(codes) == 0 {
+		codes = DefaultRetryableStatusCodes
+	}
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+//RetryableMethod reports whether a request with the HTTP method should be retried
+func (p *RetryPolicy) RetryableMethod(method string) bool {
+	methods := p.RetryableMethods
+	if len(methods) == 0 {
+		methods = DefaultRetryableMethods
+	}
+	for _, m := range methods {
+		if strings.EqualFold(m, method) {
+			return true
+		}
+	}
+	return false
+}
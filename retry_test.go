@@ -0,0 +1,109 @@
+package bluemix_test
+
+import (
+	"net/http"
+	"time"
+
+	. "github.com/IBM-Cloud/bluemix-go"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RetryPolicy", func() {
+	Describe("Delay", func() {
+		It("should grow with the multiplier", func() {
+			p := &RetryPolicy{InitialDelay: 100 * time.Millisecond, MaxDelay: time.Minute, Multiplier: 3}
+			Expect(p.Delay(0)).To(Equal(100 * time.Millisecond))
+			Expect(p.Delay(1)).To(Equal(300 * time.Millisecond))
+			Expect(p.Delay(2)).To(Equal(900 * time.Millisecond))
+		})
+		It("should be capped at the max delay", func() {
+			p := &RetryPolicy{InitialDelay: time.Second, MaxDelay: 5 * time.Second}
+			Expect(p.Delay(2)).To(Equal(4 * time.Second))
+			Expect(p.Delay(3)).To(Equal(5 * time.Second))
+			Expect(p.Delay(30)).To(Equal(5 * time.Second))
+		})
+		It("should use the defaults", func() {
+			p := &RetryPolicy{}
+			Expect(p.Delay(0)).To(Equal(time.Second))
+			Expect(p.Delay(1)).To(Equal(2 * time.Second))
+			Expect(p.Delay(10)).To(Equal(30 * time.Second))
+		})
+		It("should stay within the jitter", func() {
+			p := &RetryPolicy{InitialDelay: time.Second, Jitter: 0.5}
+			seen := map[time.Duration]bool{}
+			for i := 0; i < 100; i++ {
+				d := p.Delay(0)
+				Expect(d).To(BeNumerically(">=", 500*time.Millisecond))
+				Expect(d).To(BeNumerically("<=", 1500*time.Millisecond))
+				seen[d] = true
+			}
+			Expect(len(seen)).To(BeNumerically(">", 1))
+		})
+		It("should cap the jitter at 1", func() {
+			p := &RetryPolicy{InitialDelay: time.Second, Jitter: 5}
+			for i := 0; i < 100; i++ {
+				Expect(p.Delay(0)).To(BeNumerically(">=", 0))
+				Expect(p.Delay(0)).To(BeNumerically("<=", 2*time.Second))
+			}
+		})
+	})
+	Describe("RetryableStatus", func() {
+		It("should retry the default status codes", func() {
+			p := &RetryPolicy{}
+			for _, code := range DefaultRetryableStatusCodes {
+				Expect(p.RetryableStatus(code)).To(BeTrue())
+			}
+			Expect(p.RetryableStatus(http.StatusBadRequest)).To(BeFalse())
+			Expect(p.RetryableStatus(http.StatusForbidden)).To(BeFalse())
+			Expect(p.RetryableStatus(http.StatusNotFound)).To(BeFalse())
+		})
+		It("should retry the configured status codes only", func() {
+			p := &RetryPolicy{RetryableStatusCodes: []int{http.StatusConflict}}
+			Expect(p.RetryableStatus(http.StatusConflict)).To(BeTrue())
+			Expect(p.RetryableStatus(http.StatusServiceUnavailable)).To(BeFalse())
+		})
+	})
+	Describe("RetryableMethod", func() {
+		It("should retry the idempotent methods by default", func() {
+			p := &RetryPolicy{}
+			Expect(p.RetryableMethod(http.MethodGet)).To(BeTrue())
+			Expect(p.RetryableMethod("delete")).To(BeTrue())
+			Expect(p.RetryableMethod(http.MethodPut)).To(BeTrue())
+			Expect(p.RetryableMethod(http.MethodPost)).To(BeFalse())
+			Expect(p.RetryableMethod(http.MethodPatch)).To(BeFalse())
+		})
+		It("should retry the configured methods only", func() {
+			p := &RetryPolicy{RetryableMethods: []string{http.MethodPost}}
+			Expect(p.RetryableMethod(http.MethodPost)).To(BeTrue())
+			Expect(p.RetryableMethod(http.MethodGet)).To(BeFalse())
+		})
+	})
+})
+
+var _ = Describe("RetryAfter", func() {
+	response := func(status int, retryAfter string) *http.Response {
+		return &http.Response{StatusCode: status, Header: http.Header{"Retry-After": {retryAfter}}}
+	}
+
+	It("should parse a number of seconds", func() {
+		d, ok := RetryAfter(response(http.StatusTooManyRequests, "30"))
+		Expect(ok).To(BeTrue())
+		Expect(d).To(Equal(30 * time.Second))
+	})
+	It("should parse an HTTP date", func() {
+		date := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+		d, ok := RetryAfter(response(http.StatusServiceUnavailable, date))
+		Expect(ok).To(BeTrue())
+		Expect(d).To(BeNumerically("~", time.Minute, 2*time.Second))
+	})
+	It("should ignore the other responses and the invalid values", func() {
+		_, ok := RetryAfter(response(http.StatusInternalServerError, "30"))
+		Expect(ok).To(BeFalse())
+		_, ok = RetryAfter(response(http.StatusTooManyRequests, "soon"))
+		Expect(ok).To(BeFalse())
+		_, ok = RetryAfter(nil)
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -0,0 +1,86 @@
+package session
+
+import (
+	gohttp "net/http"
+	"sync"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/authentication"
+	"github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/rest"
+)
+
+//sessionTokens holds the latest IAM tokens obtained by the clients created
+//from a session and from its copies
+type sessionTokens struct {
+	lock         sync.Mutex
+	accessToken  string
+	refreshToken string
+}
+
+func (t *sessionTokens) set(e bluemix.TokenEvent) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.accessToken = e.AccessToken
+	t.refreshToken = e.RefreshToken
+}
+
+func (t *sessionTokens) get() (string, string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.accessToken, t.refreshToken
+}
+
+//trackTokens makes the session record the tokens obtained with the config,
+//and with its copies made by the service clients, before calling the
+//OnTokenRefresh of the config
+func (s *Session) trackTokens() {
+	s.tokens = &sessionTokens{}
+	tokens, onRefresh := s.tokens, s.Config.OnTokenRefresh
+	s.Config.OnTokenRefresh = func(e bluemix.TokenEvent) {
+		tokens.set(e)
+		if onRefresh != nil {
+			onRefresh(e)
+		}
+	}
+}
+
+//IAMTokens returns the latest IAM tokens of the session: the ones last
+//obtained or refreshed by the clients created from the session or its copies,
+//else the ones of the config
+func (s *Session) IAMTokens() (accessToken, refreshToken string) {
+	if s.tokens != nil {
+		accessToken, refreshToken = s.tokens.get()
+	}
+	if accessToken == "" {
+		accessToken, refreshToken = s.Config.IAMAccessToken, s.Config.IAMRefreshToken
+	}
+	return accessToken, refreshToken
+}
+
+//Authenticate logs in with the credentials of the config when it holds no IAM
+//access token. The tokens are set on the config, so the service clients
+//created from the session, and from the copies made afterwards such as
+//WithTarget, reuse them instead of logging in again. It must not be called
+//while clients are being created from the session.
+func (s *Session) Authenticate() error {
+	c := s.Config
+	if c.IAMAccessToken != "" {
+		return nil
+	}
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.NewHTTPClient(c)
+	}
+	iam, err := authentication.NewIAMAuthRepository(c, &rest.Client{
+		DefaultHeader: gohttp.Header{
+			"X-Original-User-Agent": []string{c.UserAgent},
+			"User-Agent":            []string{http.UserAgent()},
+		},
+		HTTPClient: httpClient,
+	})
+	if err != nil {
+		return err
+	}
+	return authentication.PopulateTokens(iam, c)
+}
@@ -0,0 +1,89 @@
+package session
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+)
+
+//ErrCodeInvalidCredentialsFile ...
+const ErrCodeInvalidCredentialsFile = "InvalidCredentialsFile"
+
+//apiKeyFile is the file written by ibmcloud iam api-key-create --file
+type apiKeyFile struct {
+	APIKey string `json:"apikey"`
+}
+
+//cliConfig is the part of the config.json of the ibmcloud CLI read by New
+type cliConfig struct {
+	Region          string
+	IAMToken        string
+	IAMRefreshToken string
+	ResourceGroup   struct {
+		GUID string
+	}
+}
+
+//readAPIKeyFile returns the API key of the JSON file at path
+func readAPIKeyFile(path string) (string, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", bmxerror.New(ErrCodeInvalidCredentialsFile, fmt.Sprintf("The API key file could not be read: %v", err))
+	}
+	var f apiKeyFile
+	if err := json.Unmarshal(raw, &f); err != nil {
+		return "", bmxerror.New(ErrCodeInvalidCredentialsFile, fmt.Sprintf("The API key file %s could not be read: %v", path, err))
+	}
+	if f.APIKey == "" {
+		return "", bmxerror.New(ErrCodeInvalidCredentialsFile, fmt.Sprintf("The API key file %s has no apikey", path))
+	}
+	return f.APIKey, nil
+}
+
+//CLIConfigPath returns the path of the config of the ibmcloud CLI, under
+//IBMCLOUD_HOME or else the home directory of the user
+func CLIConfigPath() (string, error) {
+	home := os.Getenv("IBMCLOUD_HOME")
+	if home == "" {
+		var err error
+		if home, err = os.UserHomeDir(); err != nil {
+			return "", err
+		}
+	}
+	return filepath.Join(home, ".bluemix", "config.json"), nil
+}
+
+//readCLIConfig returns the login of the ibmcloud CLI, nil when the CLI is not
+//logged in
+func readCLIConfig(path string) (*cliConfig, error) {
+	raw, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, bmxerror.New(ErrCodeInvalidCredentialsFile, fmt.Sprintf("The ibmcloud CLI config could not be read: %v", err))
+	}
+	var cli cliConfig
+	if err := json.Unmarshal(raw, &cli); err != nil {
+		return nil, bmxerror.New(ErrCodeInvalidCredentialsFile, fmt.Sprintf("The ibmcloud CLI config %s could not be read: %v", path, err))
+	}
+	if cli.IAMToken == "" && cli.IAMRefreshToken == "" {
+		return nil, nil
+	}
+	if cli.IAMToken != "" && !strings.HasPrefix(cli.IAMToken, "Bearer ") {
+		cli.IAMToken = "Bearer " + cli.IAMToken
+	}
+	return &cli, nil
+}
+
+//hasCredentials reports whether the config holds credentials to log in
+func hasCredentials(c *bluemix.Config) bool {
+	return (c.IBMID != "" && c.IBMIDPassword != "") || c.BluemixAPIKey != "" || c.TrustedProfileAuth() ||
+		c.IAMTokenRefresher != nil || c.IAMAccessToken != "" || c.IAMRefreshToken != ""
+}
@@ -0,0 +1,109 @@
+package session
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Credentials files", func() {
+	vars := []string{"IBMCLOUD_HOME", "IC_API_KEY", "IBMCLOUD_API_KEY", "BM_API_KEY", "BLUEMIX_API_KEY",
+		"IC_API_KEY_FILE", "IBMCLOUD_API_KEY_FILE", "IC_IAM_TOKEN", "IBMCLOUD_IAM_TOKEN",
+		"IC_IAM_REFRESH_TOKEN", "IBMCLOUD_IAM_REFRESH_TOKEN", "IC_REGION", "IBMCLOUD_REGION"}
+	var dir string
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "credentials")
+		Expect(err).NotTo(HaveOccurred())
+		for _, v := range vars {
+			os.Unsetenv(v)
+		}
+		os.Setenv("IBMCLOUD_HOME", dir)
+	})
+	AfterEach(func() {
+		for _, v := range vars {
+			os.Unsetenv(v)
+		}
+		os.RemoveAll(dir)
+	})
+
+	writeFile := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		Expect(os.MkdirAll(filepath.Dir(path), 0700)).To(Succeed())
+		Expect(ioutil.WriteFile(path, []byte(content), 0600)).To(Succeed())
+		return path
+	}
+	writeCLIConfig := func() {
+		writeFile(".bluemix/config.json", `{
+			"Region": "eu-de",
+			"IAMToken": "Bearer access",
+			"IAMRefreshToken": "refresh",
+			"ResourceGroup": {"GUID": "rg-1", "Name": "default"}
+		}`)
+	}
+
+	Describe("APIKeyFile", func() {
+		It("should read the API key of the file", func() {
+			path := writeFile("apikey.json", `{"name":"ci","apikey":"key-1","createdAt":"2026-01-01"}`)
+			sess, err := New(&bluemix.Config{APIKeyFile: path})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sess.Config.BluemixAPIKey).To(Equal("key-1"))
+		})
+		It("should read the file of the environment", func() {
+			os.Setenv("IBMCLOUD_API_KEY_FILE", writeFile("apikey.json", `{"apikey":"key-2"}`))
+			sess, err := New(&bluemix.Config{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sess.Config.BluemixAPIKey).To(Equal("key-2"))
+		})
+		It("should prefer the API key of the config", func() {
+			sess, err := New(&bluemix.Config{BluemixAPIKey: "key", APIKeyFile: filepath.Join(dir, "missing.json")})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sess.Config.BluemixAPIKey).To(Equal("key"))
+		})
+		It("should fail when the file has no API key", func() {
+			path := writeFile("apikey.json", `{"name":"ci"}`)
+			_, err := New(&bluemix.Config{APIKeyFile: path})
+			Expect(err).To(HaveOccurred())
+			Expect(err.(bmxerror.Error).Code()).To(Equal(ErrCodeInvalidCredentialsFile))
+		})
+	})
+
+	Describe("UseCLILogin", func() {
+		It("should use the login of the CLI", func() {
+			writeCLIConfig()
+			sess, err := New(&bluemix.Config{UseCLILogin: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sess.Config.IAMAccessToken).To(Equal("Bearer access"))
+			Expect(sess.Config.IAMRefreshToken).To(Equal("refresh"))
+			Expect(sess.Config.Region).To(Equal("eu-de"))
+			Expect(sess.Config.ResourceGroup).To(Equal("rg-1"))
+		})
+		It("should prefer the settings of the config and the environment", func() {
+			writeCLIConfig()
+			os.Setenv("IC_REGION", "jp-tok")
+			sess, err := New(&bluemix.Config{UseCLILogin: true, BluemixAPIKey: "key", ResourceGroup: "rg-2"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sess.Config.IAMAccessToken).To(BeEmpty())
+			Expect(sess.Config.Region).To(Equal("jp-tok"))
+			Expect(sess.Config.ResourceGroup).To(Equal("rg-2"))
+		})
+		It("should ignore the CLI when not logged in", func() {
+			sess, err := New(&bluemix.Config{UseCLILogin: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sess.Config.IAMAccessToken).To(BeEmpty())
+			Expect(sess.Config.Region).To(Equal("us-south"))
+		})
+		It("should not read the CLI config unless asked", func() {
+			writeCLIConfig()
+			sess, err := New(&bluemix.Config{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sess.Config.IAMAccessToken).To(BeEmpty())
+		})
+	})
+})
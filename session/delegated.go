@@ -0,0 +1,32 @@
+package session
+
+import (
+	gohttp "net/http"
+	"time"
+
+	"github.com/IBM-Cloud/bluemix-go/authentication"
+	"github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM-Cloud/bluemix-go/rest"
+)
+
+//DelegatedRefreshToken returns a delegated refresh token of the API key of the
+//session for the client IDs of the receiver services, such as "kms" for Key
+//Protect or "hpcs", which mint the tokens of the account scoped to them from
+//it. A zero expiry keeps the default validity set by IAM.
+func (s *Session) DelegatedRefreshToken(expiry time.Duration, receiverClientIDs ...string) (string, error) {
+	config := s.Config.Copy()
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.NewHTTPClient(config)
+	}
+	iam, err := authentication.NewIAMAuthRepository(config, &rest.Client{
+		DefaultHeader: gohttp.Header{
+			"X-Original-User-Agent": []string{config.UserAgent},
+			"User-Agent":            []string{http.UserAgent()},
+		},
+		HTTPClient: config.HTTPClient,
+	})
+	if err != nil {
+		return "", err
+	}
+	return iam.DelegatedRefreshToken(expiry, receiverClientIDs...)
+}
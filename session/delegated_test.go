@@ -0,0 +1,33 @@
+package session
+
+import (
+	"net/http"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DelegatedRefreshToken", func() {
+	It("should exchange the API key of the session", func() {
+		server := ghttp.NewServer()
+		defer server.Close()
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/identity/token"),
+				ghttp.VerifyFormKV("apikey", "key"),
+				ghttp.VerifyFormKV("receiver_client_ids", "kms"),
+				ghttp.RespondWith(http.StatusOK, `{"delegated_refresh_token":"delegated"}`),
+			),
+		)
+		endpoint := server.URL()
+		sess, err := New(&bluemix.Config{BluemixAPIKey: "key", TokenProviderEndpoint: &endpoint})
+		Expect(err).NotTo(HaveOccurred())
+		token, err := sess.DelegatedRefreshToken(0, "kms")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token).To(Equal("delegated"))
+	})
+})
@@ -0,0 +1,53 @@
+package session
+
+import (
+	"os"
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/trace"
+)
+
+//DefaultEnvPrefixes are the prefixes of the environment variables read by New,
+//by order of precedence: IC_API_KEY wins over IBMCLOUD_API_KEY, which wins over
+//BM_API_KEY and then BLUEMIX_API_KEY
+var DefaultEnvPrefixes = []string{"IC_", "IBMCLOUD_", "BM_", "BLUEMIX_"}
+
+type envReader struct {
+	prefixes []string
+	logger   trace.StructuredLogger
+}
+
+func newEnvReader(prefixes []string, logger trace.StructuredLogger) envReader {
+	if len(prefixes) == 0 {
+		prefixes = DefaultEnvPrefixes
+	}
+	return envReader{prefixes: prefixes, logger: logger}
+}
+
+//lookup returns the value of the first variable set among names, by order of
+//precedence of the prefixes, ignoring the names whose prefix is not honored.
+//The variables set to another value than the one used are traced, not their
+//values since they may be secrets.
+func (e envReader) lookup(names []string, defaultValue string) string {
+	var value, from string
+	for _, prefix := range e.prefixes {
+		for _, name := range names {
+			if !strings.HasPrefix(name, prefix) {
+				continue
+			}
+			v := os.Getenv(name)
+			if v == "" {
+				continue
+			}
+			if from == "" {
+				value, from = v, name
+			} else if v != value {
+				trace.For(e.logger).Warn("Ignoring an environment variable, its value differs from the one taking precedence", "ignored", name, "used", from)
+			}
+		}
+	}
+	if from == "" {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,51 @@
+package session
+
+import (
+	"os"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Environment variables", func() {
+	vars := []string{"IC_REGION", "IBMCLOUD_REGION", "BM_REGION", "BLUEMIX_REGION"}
+	BeforeEach(func() {
+		for _, v := range vars {
+			os.Unsetenv(v)
+		}
+	})
+	AfterEach(func() {
+		for _, v := range vars {
+			os.Unsetenv(v)
+		}
+	})
+
+	It("should prefer the IC_ variables", func() {
+		os.Setenv("BLUEMIX_REGION", "eu-de")
+		os.Setenv("IC_REGION", "eu-gb")
+		os.Setenv("IBMCLOUD_REGION", "jp-tok")
+		sess, err := New(&bluemix.Config{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sess.Config.Region).To(Equal("eu-gb"))
+	})
+	It("should follow the order of the configured prefixes", func() {
+		os.Setenv("IC_REGION", "eu-gb")
+		os.Setenv("BM_REGION", "eu-de")
+		sess, err := New(&bluemix.Config{EnvPrefixes: []string{"BM_", "IC_"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sess.Config.Region).To(Equal("eu-de"))
+	})
+	It("should ignore the prefixes not configured", func() {
+		os.Setenv("BLUEMIX_REGION", "eu-de")
+		sess, err := New(&bluemix.Config{EnvPrefixes: []string{"IC_", "IBMCLOUD_"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sess.Config.Region).To(Equal("us-south"))
+	})
+	It("should ignore the names whose prefix is unknown", func() {
+		os.Setenv("IBMCLOUD_REGION", "jp-tok")
+		Expect(newEnvReader([]string{"IC_"}, nil).lookup(vars, "us-south")).To(Equal("us-south"))
+		Expect(newEnvReader(nil, nil).lookup(vars, "us-south")).To(Equal("jp-tok"))
+	})
+})
@@ -0,0 +1,125 @@
+package session
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+)
+
+//ErrCodeInvalidSavedSession ...
+const ErrCodeInvalidSavedSession = "InvalidSavedSession"
+
+const savedSessionVersion = 1
+
+//savedSession is the state written by Save
+type savedSession struct {
+	Version               int     `json:"version"`
+	IAMAccessToken        string  `json:"iam_access_token,omitempty"`
+	IAMRefreshToken       string  `json:"iam_refresh_token,omitempty"`
+	UAAAccessToken        string  `json:"uaa_access_token,omitempty"`
+	UAARefreshToken       string  `json:"uaa_refresh_token,omitempty"`
+	Region                string  `json:"region,omitempty"`
+	ResourceGroup         string  `json:"resource_group,omitempty"`
+	Visibility            string  `json:"visibility,omitempty"`
+	EndpointsFile         string  `json:"endpoints_file,omitempty"`
+	Endpoint              *string `json:"endpoint,omitempty"`
+	TokenProviderEndpoint *string `json:"token_provider_endpoint,omitempty"`
+	//TargetHeaders are the account and resource group headers set by WithTarget
+	TargetHeaders map[string]string `json:"target_headers,omitempty"`
+}
+
+//Save writes the tokens, the target and the endpoints of the session as JSON,
+//for Load to restore the session without logging in again. The IAM tokens are
+//the latest ones of the session, see IAMTokens, Save logs in with
+//Authenticate when the session has none yet. The API key and the password are
+//not written, but the tokens give access to the account: the output must only
+//be readable by the user.
+func (s *Session) Save(w io.Writer) error {
+	c := s.Config
+	accessToken, refreshToken := s.IAMTokens()
+	if accessToken == "" && hasCredentials(c) {
+		if err := s.Authenticate(); err != nil {
+			return err
+		}
+		accessToken, refreshToken = s.IAMTokens()
+	}
+	var targetHeaders map[string]string
+	for _, h := range []string{accountHeader, resourceGroupHeader} {
+		if v := c.DefaultHeaders.Get(h); v != "" {
+			if targetHeaders == nil {
+				targetHeaders = map[string]string{}
+			}
+			targetHeaders[h] = v
+		}
+	}
+	return json.NewEncoder(w).Encode(savedSession{
+		Version:               savedSessionVersion,
+		IAMAccessToken:        accessToken,
+		IAMRefreshToken:       refreshToken,
+		UAAAccessToken:        c.UAAAccessToken,
+		UAARefreshToken:       c.UAARefreshToken,
+		Region:                c.Region,
+		ResourceGroup:         c.ResourceGroup,
+		Visibility:            c.Visibility,
+		EndpointsFile:         c.EndpointsFile,
+		Endpoint:              c.Endpoint,
+		TokenProviderEndpoint: c.TokenProviderEndpoint,
+		TargetHeaders:         targetHeaders,
+	})
+}
+
+//Load returns the session saved with Save. The settings given in configs take
+//precedence over the saved ones, the others are completed as by New.
+func Load(r io.Reader, configs ...*bluemix.Config) (*Session, error) {
+	var saved savedSession
+	if err := json.NewDecoder(r).Decode(&saved); err != nil {
+		return nil, bmxerror.New(ErrCodeInvalidSavedSession, fmt.Sprintf("The saved session could not be read: %v", err))
+	}
+	if saved.Version != savedSessionVersion {
+		return nil, bmxerror.New(ErrCodeInvalidSavedSession, fmt.Sprintf("Unsupported saved session version %d", saved.Version))
+	}
+	c := &bluemix.Config{}
+	if len(configs) > 0 {
+		c = configs[0]
+	}
+	if c.IAMAccessToken == "" && c.IAMRefreshToken == "" {
+		c.IAMAccessToken = saved.IAMAccessToken
+		c.IAMRefreshToken = saved.IAMRefreshToken
+	}
+	if c.UAAAccessToken == "" && c.UAARefreshToken == "" {
+		c.UAAAccessToken = saved.UAAAccessToken
+		c.UAARefreshToken = saved.UAARefreshToken
+	}
+	if c.Region == "" {
+		c.Region = saved.Region
+	}
+	if c.ResourceGroup == "" {
+		c.ResourceGroup = saved.ResourceGroup
+	}
+	if c.Visibility == "" {
+		c.Visibility = saved.Visibility
+	}
+	if c.EndpointsFile == "" {
+		c.EndpointsFile = saved.EndpointsFile
+	}
+	if c.Endpoint == nil {
+		c.Endpoint = saved.Endpoint
+	}
+	if c.TokenProviderEndpoint == nil {
+		c.TokenProviderEndpoint = saved.TokenProviderEndpoint
+	}
+	for h, v := range saved.TargetHeaders {
+		if c.DefaultHeaders.Get(h) != "" {
+			continue
+		}
+		if c.DefaultHeaders == nil {
+			c.DefaultHeaders = http.Header{}
+		}
+		c.DefaultHeaders.Set(h, v)
+	}
+	return New(c)
+}
@@ -0,0 +1,86 @@
+package session_test
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	. "github.com/IBM-Cloud/bluemix-go/session"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Save and Load", func() {
+	It("should restore the tokens, the target and the endpoints", func() {
+		endpoint := "https://containers.example.com"
+		sess, err := New(&bluemix.Config{
+			BluemixAPIKey:   "secret-api-key",
+			IAMAccessToken:  "Bearer access",
+			IAMRefreshToken: "refresh",
+			Region:          "eu-de",
+			ResourceGroup:   "rg1",
+			Visibility:      "private",
+			Endpoint:        &endpoint,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		var buf bytes.Buffer
+		Expect(sess.Save(&buf)).To(Succeed())
+		Expect(buf.String()).NotTo(ContainSubstring("secret-api-key"))
+
+		restored, err := Load(&buf)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restored.Config.IAMAccessToken).To(Equal("Bearer access"))
+		Expect(restored.Config.IAMRefreshToken).To(Equal("refresh"))
+		Expect(restored.Config.Region).To(Equal("eu-de"))
+		Expect(restored.Config.ResourceGroup).To(Equal("rg1"))
+		Expect(restored.Config.Visibility).To(Equal("private"))
+		Expect(*restored.Config.Endpoint).To(Equal(endpoint))
+		Expect(restored.Config.EndpointLocator).NotTo(BeNil())
+	})
+
+	It("should save the tokens and the target of an API key session", func() {
+		server := ghttp.NewServer()
+		defer server.Close()
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/identity/token"),
+				ghttp.VerifyFormKV("apikey", "secret-api-key"),
+				ghttp.RespondWith(http.StatusOK, `{"access_token": "access", "refresh_token": "refresh", "token_type": "Bearer", "expiration": 4102444800}`),
+			),
+		)
+		endpoint := server.URL()
+		sess, err := New(&bluemix.Config{BluemixAPIKey: "secret-api-key", TokenProviderEndpoint: &endpoint})
+		Expect(err).NotTo(HaveOccurred())
+		var buf bytes.Buffer
+		Expect(sess.WithTarget("acc1", "rg1", "").Save(&buf)).To(Succeed())
+		Expect(buf.String()).NotTo(ContainSubstring("secret-api-key"))
+
+		restored, err := Load(&buf)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restored.Config.IAMAccessToken).To(Equal("Bearer access"))
+		Expect(restored.Config.IAMRefreshToken).To(Equal("refresh"))
+		Expect(restored.Config.ResourceGroup).To(Equal("rg1"))
+		Expect(restored.Config.DefaultHeaders.Get("X-Auth-Resource-Account")).To(Equal("acc1"))
+		Expect(restored.Config.DefaultHeaders.Get("X-Auth-Resource-Group")).To(Equal("rg1"))
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("should prefer the settings of the given config", func() {
+		saved := `{"version":1,"iam_access_token":"Bearer access","region":"eu-de"}`
+		restored, err := Load(strings.NewReader(saved), &bluemix.Config{Region: "us-east"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restored.Config.Region).To(Equal("us-east"))
+		Expect(restored.Config.IAMAccessToken).To(Equal("Bearer access"))
+	})
+
+	It("should reject an unknown version", func() {
+		_, err := Load(strings.NewReader(`{"version":2}`))
+		Expect(err).To(HaveOccurred())
+		Expect(err.(bmxerror.Error).Code()).To(Equal(ErrCodeInvalidSavedSession))
+	})
+})
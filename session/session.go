@@ -2,10 +2,13 @@ package session
 
 import (
 	"fmt"
+	"net/http"
 	"strconv"
 	"time"
 
 	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/bluemix-go/crn"
 	"github.com/IBM-Cloud/bluemix-go/endpoints"
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/bluemix-go/trace"
@@ -14,6 +17,8 @@ import (
 //Session ...
 type Session struct {
 	Config *bluemix.Config
+
+	tokens *sessionTokens
 }
 
 //New ...
@@ -28,6 +33,12 @@ func New(configs ...*bluemix.Config) (*Session, error) {
 	sess := &Session{
 		Config: c,
 	}
+	sess.trackTokens()
+
+	if c.Debug {
+		trace.Logger = trace.NewLogger("true")
+	}
+	env := newEnvReader(c.EnvPrefixes, c.Logger)
 
 	if len(c.IBMID) == 0 {
 		c.IBMID = helpers.EnvFallBack([]string{"IBMID"}, "")
@@ -38,19 +49,63 @@ func New(configs ...*bluemix.Config) (*Session, error) {
 	}
 
 	if len(c.BluemixAPIKey) == 0 {
-		c.BluemixAPIKey = helpers.EnvFallBack([]string{"IC_API_KEY", "IBMCLOUD_API_KEY", "BM_API_KEY", "BLUEMIX_API_KEY"}, "")
+		c.BluemixAPIKey = env.lookup([]string{"IC_API_KEY", "IBMCLOUD_API_KEY", "BM_API_KEY", "BLUEMIX_API_KEY"}, "")
+	}
+
+	if len(c.APIKeyFile) == 0 {
+		c.APIKeyFile = env.lookup([]string{"IC_API_KEY_FILE", "IBMCLOUD_API_KEY_FILE"}, "")
+	}
+
+	if len(c.BluemixAPIKey) == 0 && len(c.APIKeyFile) != 0 {
+		apiKey, err := readAPIKeyFile(c.APIKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		c.BluemixAPIKey = apiKey
 	}
 
 	if len(c.IAMAccessToken) == 0 {
-		c.IAMAccessToken = helpers.EnvFallBack([]string{"IC_IAM_TOKEN", "IBMCLOUD_IAM_TOKEN"}, "")
+		c.IAMAccessToken = env.lookup([]string{"IC_IAM_TOKEN", "IBMCLOUD_IAM_TOKEN"}, "")
 	}
 
 	if len(c.IAMRefreshToken) == 0 {
-		c.IAMRefreshToken = helpers.EnvFallBack([]string{"IC_IAM_REFRESH_TOKEN", "IBMCLOUD_IAM_REFRESH_TOKEN"}, "")
+		c.IAMRefreshToken = env.lookup([]string{"IC_IAM_REFRESH_TOKEN", "IBMCLOUD_IAM_REFRESH_TOKEN"}, "")
+	}
+
+	if len(c.IAMTrustedProfileID) == 0 {
+		c.IAMTrustedProfileID = env.lookup([]string{"IC_IAM_PROFILE_ID", "IBMCLOUD_IAM_PROFILE_ID"}, "")
+	}
+
+	if len(c.CRTokenFile) == 0 {
+		c.CRTokenFile = env.lookup([]string{"IC_CR_TOKEN_FILE", "IBMCLOUD_CR_TOKEN_FILE"}, "")
+	}
+
+	var cli *cliConfig
+	if c.UseCLILogin && !hasCredentials(c) {
+		path, err := CLIConfigPath()
+		if err != nil {
+			return nil, err
+		}
+		if cli, err = readCLIConfig(path); err != nil {
+			return nil, err
+		}
+	}
+	if cli != nil {
+		c.IAMAccessToken = cli.IAMToken
+		c.IAMRefreshToken = cli.IAMRefreshToken
+		if len(c.ResourceGroup) == 0 {
+			c.ResourceGroup = cli.ResourceGroup.GUID
+		}
 	}
 
 	if len(c.Region) == 0 {
-		c.Region = helpers.EnvFallBack([]string{"IC_REGION", "IBMCLOUD_REGION", "BM_REGION", "BLUEMIX_REGION"}, "us-south")
+		c.Region = env.lookup([]string{"IC_REGION", "IBMCLOUD_REGION", "BM_REGION", "BLUEMIX_REGION"}, "")
+	}
+	if len(c.Region) == 0 && cli != nil {
+		c.Region = cli.Region
+	}
+	if len(c.Region) == 0 {
+		c.Region = "us-south"
 	}
 	if c.MaxRetries == nil {
 		c.MaxRetries = helpers.Int(3)
@@ -68,7 +123,7 @@ func New(configs ...*bluemix.Config) (*Session, error) {
 	}
 	if c.HTTPTimeout == 0 {
 		c.HTTPTimeout = 180 * time.Second
-		timeout := helpers.EnvFallBack([]string{"IC_TIMEOUT", "IBMCLOUD_TIMEOUT", "BM_TIMEOUT", "BLUEMIX_TIMEOUT"}, "180")
+		timeout := env.lookup([]string{"IC_TIMEOUT", "IBMCLOUD_TIMEOUT", "BM_TIMEOUT", "BLUEMIX_TIMEOUT"}, "180")
 		timeoutDuration, err := time.ParseDuration(fmt.Sprintf("%ss", timeout))
 		if err != nil {
 			fmt.Printf("IC_TIMEOUT or IBMCLOUD_TIMEOUT has invalid time format. Default timeout will be set to %q", c.HTTPTimeout)
@@ -78,20 +133,22 @@ func New(configs ...*bluemix.Config) (*Session, error) {
 		}
 	}
 	if len(c.Visibility) == 0 {
-		c.Visibility = helpers.EnvFallBack([]string{"IC_VISIBILITY", "IBMCLOUD_VISIBILITY"}, "public")
+		c.Visibility = env.lookup([]string{"IC_VISIBILITY", "IBMCLOUD_VISIBILITY"}, "public")
+	}
+	switch c.Visibility {
+	case "public", "private", "public-and-private":
+	default:
+		return nil, bmxerror.New(bluemix.ErrInvalidConfigurationCode,
+			fmt.Sprintf("Invalid visibility %q, it must be public, private or public-and-private", c.Visibility))
 	}
 	if len(c.EndpointsFile) == 0 {
-		c.EndpointsFile = helpers.EnvFallBack([]string{"IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_ENDPOINTS_FILE_PATH"}, "")
+		c.EndpointsFile = env.lookup([]string{"IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_ENDPOINTS_FILE_PATH"}, "")
 	}
 	if c.RetryDelay == nil {
 		c.RetryDelay = helpers.Duration(30 * time.Second)
 	}
 	if c.EndpointLocator == nil {
-		c.EndpointLocator = endpoints.NewEndpointLocator(c.Region, c.Visibility, c.EndpointsFile)
-	}
-
-	if c.Debug {
-		trace.Logger = trace.NewLogger("true")
+		c.EndpointLocator = newEndpointLocator(c, c.Region)
 	}
 	return sess, nil
 }
@@ -100,5 +157,80 @@ func New(configs ...*bluemix.Config) (*Session, error) {
 func (s *Session) Copy(mccpgs ...*bluemix.Config) *Session {
 	return &Session{
 		Config: s.Config.Copy(mccpgs...),
+		tokens: s.tokens,
 	}
 }
+
+//Diagnostics returns the recorder holding recent SDK traffic, or nil when
+//Config.Diagnostics was not set
+func (s *Session) Diagnostics() *trace.Recorder {
+	return s.Config.Diagnostics
+}
+
+//CopyForCRN returns a copy of the session targeted at the region the given
+//resource CRN belongs to. Any explicit endpoint on the copy is cleared so that
+//service clients resolve the endpoints of that region.
+func (s *Session) CopyForCRN(resourceCRN string) (*Session, error) {
+	c, err := crn.Parse(resourceCRN)
+	if err != nil {
+		return nil, err
+	}
+	region, err := c.RegionName()
+	if err != nil {
+		return nil, err
+	}
+	sess := s.Copy()
+	sess.Config.Region = region
+	sess.Config.Endpoint = nil
+	sess.Config.EndpointLocator = newEndpointLocator(sess.Config, region)
+	return sess, nil
+}
+
+//Target headers set by WithTarget, read by the services scoping their requests
+//to an account and a resource group such as the container service
+const (
+	accountHeader       = "X-Auth-Resource-Account"
+	resourceGroupHeader = "X-Auth-Resource-Group"
+)
+
+//WithTarget returns a copy of the session targeted at the account, the
+//resource group and the region, reusing the HTTP client and the tokens of the
+//session config. The config of an API key or trusted profile session holds no
+//token until Authenticate is called, until then every service client created
+//from the copy logs in again. The empty values keep the target of the session.
+//The non empty target headers given to the calls of the clients, such as the
+//ClusterTargetHeader of the container API, take precedence.
+func (s *Session) WithTarget(accountID, resourceGroup, region string) *Session {
+	sess := s.Copy()
+	c := sess.Config
+	if (accountID != "" || resourceGroup != "") && c.DefaultHeaders == nil {
+		c.DefaultHeaders = http.Header{}
+	}
+	if accountID != "" {
+		c.DefaultHeaders.Set(accountHeader, accountID)
+	}
+	if resourceGroup != "" {
+		c.ResourceGroup = resourceGroup
+		c.DefaultHeaders.Set(resourceGroupHeader, resourceGroup)
+	}
+	if region != "" && region != c.Region {
+		c.Region = region
+		c.Endpoint = nil
+		c.EndpointLocator = newEndpointLocator(c, region)
+	}
+	return sess
+}
+
+//newEndpointLocator returns the default locator of the region, returning the
+//ServiceEndpoints of the config first
+func newEndpointLocator(c *bluemix.Config, region string) endpoints.EndpointLocator {
+	locator := endpoints.NewEndpointLocator(region, c.Visibility, c.EndpointsFile)
+	if len(c.ServiceEndpoints) == 0 {
+		return locator
+	}
+	overrides := make(map[string]string, len(c.ServiceEndpoints))
+	for service, url := range c.ServiceEndpoints {
+		overrides[string(service)] = url
+	}
+	return endpoints.WithOverrides(locator, overrides)
+}
@@ -0,0 +1,138 @@
+package session_test
+
+import (
+	"net/http"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/bluemix-go/crn"
+	"github.com/IBM-Cloud/bluemix-go/helpers"
+	. "github.com/IBM-Cloud/bluemix-go/session"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Session", func() {
+	Describe("WithTarget", func() {
+		It("should target the account, resource group and region", func() {
+			endpoint := "https://example.com"
+			sess, err := New(&bluemix.Config{Region: "us-east", Endpoint: &endpoint, IAMAccessToken: "Bearer token"})
+			Expect(err).NotTo(HaveOccurred())
+			target := sess.WithTarget("account1", "rg1", "eu-de")
+			Expect(target.Config.IAMAccessToken).To(Equal("Bearer token"))
+			Expect(target.Config.HTTPClient).To(BeIdenticalTo(sess.Config.HTTPClient))
+			Expect(target.Config.DefaultHeaders.Get("X-Auth-Resource-Account")).To(Equal("account1"))
+			Expect(target.Config.DefaultHeaders.Get("X-Auth-Resource-Group")).To(Equal("rg1"))
+			Expect(target.Config.ResourceGroup).To(Equal("rg1"))
+			Expect(target.Config.Region).To(Equal("eu-de"))
+			Expect(target.Config.Endpoint).To(BeNil())
+			Expect(sess.Config.DefaultHeaders).To(BeEmpty())
+			Expect(sess.Config.Region).To(Equal("us-east"))
+		})
+		It("should keep the target of the session for the empty values", func() {
+			endpoint := "https://example.com"
+			sess, err := New(&bluemix.Config{Region: "us-east", Endpoint: &endpoint})
+			Expect(err).NotTo(HaveOccurred())
+			target := sess.WithTarget("account1", "", "")
+			Expect(target.Config.Region).To(Equal("us-east"))
+			Expect(*target.Config.Endpoint).To(Equal(endpoint))
+			Expect(target.Config.DefaultHeaders.Get("X-Auth-Resource-Group")).To(BeEmpty())
+		})
+		It("should send the target headers with the container calls", func() {
+			server := ghttp.NewServer()
+			defer server.Close()
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/v2/vpc/getClusters"),
+					func(w http.ResponseWriter, r *http.Request) {
+						Expect(r.Header["X-Auth-Resource-Account"]).To(Equal([]string{"account1"}))
+						Expect(r.Header["X-Auth-Resource-Group"]).To(Equal([]string{"rg1"}))
+					},
+					ghttp.RespondWith(http.StatusOK, `[]`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/v2/vpc/getClusters"),
+					func(w http.ResponseWriter, r *http.Request) {
+						Expect(r.Header["X-Auth-Resource-Account"]).To(Equal([]string{"account1"}))
+						Expect(r.Header["X-Auth-Resource-Group"]).To(Equal([]string{"rg2"}))
+					},
+					ghttp.RespondWith(http.StatusOK, `[]`),
+				),
+			)
+			endpoint := server.URL()
+			sess, err := New(&bluemix.Config{Endpoint: &endpoint, IAMAccessToken: "Bearer token", MaxRetries: helpers.Int(0)})
+			Expect(err).NotTo(HaveOccurred())
+			api, err := containerv2.New(sess.WithTarget("account1", "rg1", ""))
+			Expect(err).NotTo(HaveOccurred())
+			_, err = api.Clusters().List(containerv2.ClusterTargetHeader{Provider: "vpc-gen2"})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = api.Clusters().List(containerv2.ClusterTargetHeader{Provider: "vpc-gen2", ResourceGroup: "rg2"})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("CopyForCRN", func() {
+		table.DescribeTable("targets the region of the resource",
+			func(location, region string) {
+				endpoint := "https://example.com"
+				sess, err := New(&bluemix.Config{Region: "us-east", Endpoint: &endpoint})
+				Expect(err).NotTo(HaveOccurred())
+				copied, err := sess.CopyForCRN("crn:v1:bluemix:public:containers-kubernetes:" + location + ":a/account::cluster:c1")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(copied.Config.Region).To(Equal(region))
+				Expect(copied.Config.Endpoint).To(BeNil())
+				Expect(sess.Config.Region).To(Equal("us-east"))
+				Expect(*sess.Config.Endpoint).To(Equal(endpoint))
+				containerEndpoint, err := copied.Config.EndpointLocator.ContainerEndpoint()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(containerEndpoint).NotTo(BeEmpty())
+			},
+			table.Entry("region", "eu-gb", "eu-gb"),
+			table.Entry("zone", "us-south-3", "us-south"),
+			table.Entry("classic datacenter", "dal10", "us-south"),
+		)
+
+		It("should keep the service endpoints", func() {
+			sess, err := New(&bluemix.Config{
+				Region:           "us-east",
+				ServiceEndpoints: map[bluemix.ServiceName]string{bluemix.VpcContainerService: "https://containers.test.cloud.ibm.com/global"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sess.Config.EndpointLocator.ContainerEndpoint()).To(Equal("https://containers.test.cloud.ibm.com/global"))
+			copied, err := sess.CopyForCRN("crn:v1:bluemix:public:containers-kubernetes:eu-gb:a/account::cluster:c1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(copied.Config.EndpointLocator.ContainerEndpoint()).To(Equal("https://containers.test.cloud.ibm.com/global"))
+		})
+
+		It("should fail for global resources", func() {
+			sess, err := New(&bluemix.Config{Region: "us-east"})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = sess.CopyForCRN("crn:v1:bluemix:public:iam-identity::a/account::apikey:k1")
+			Expect(err).To(Equal(crn.ErrGlobalCRN))
+		})
+		It("should fail for datacenters of an unknown region", func() {
+			sess, err := New(&bluemix.Config{Region: "us-east"})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = sess.CopyForCRN("crn:v1:bluemix:public:containers-kubernetes:xyz01:a/account::cluster:c1")
+			Expect(err).To(Equal(crn.ErrUnknownDatacenter))
+		})
+	})
+})
+
+var _ = Describe("New", func() {
+	It("should reject an unknown visibility", func() {
+		_, err := New(&bluemix.Config{Visibility: "cloud"})
+		Expect(err).To(HaveOccurred())
+	})
+	It("should accept the private visibility", func() {
+		sess, err := New(&bluemix.Config{Region: "us-south", Visibility: "private"})
+		Expect(err).NotTo(HaveOccurred())
+		endpoint, err := sess.Config.EndpointLocator.ContainerEndpoint()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(endpoint).To(ContainSubstring("private.us-south.containers"))
+	})
+})
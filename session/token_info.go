@@ -0,0 +1,104 @@
+package session
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+)
+
+//ErrCodeInvalidIAMToken ...
+const ErrCodeInvalidIAMToken = "InvalidIAMToken"
+
+//TokenInfo holds the claims of the IAM access token used by the session
+type TokenInfo struct {
+	AccountID   string
+	IAMID       string
+	Subject     string
+	SubjectType string
+	Email       string
+	Name        string
+	ClientID    string
+	GrantType   string
+	Scopes      []string
+	IssuedAt    time.Time
+	Expiry      time.Time
+}
+
+//ExpiresIn returns the time left before the token expires, negative once expired
+func (t *TokenInfo) ExpiresIn() time.Duration {
+	return time.Until(t.Expiry)
+}
+
+//Expired ...
+func (t *TokenInfo) Expired() bool {
+	return !time.Now().Before(t.Expiry)
+}
+
+type iamTokenClaims struct {
+	IAMID       string `json:"iam_id"`
+	Subject     string `json:"sub"`
+	SubjectType string `json:"sub_type"`
+	Email       string `json:"email"`
+	Name        string `json:"name"`
+	ClientID    string `json:"client_id"`
+	GrantType   string `json:"grant_type"`
+	Scope       string `json:"scope"`
+	IssuedAt    int64  `json:"iat"`
+	Expiry      int64  `json:"exp"`
+	Account     struct {
+		BSS string `json:"bss"`
+	} `json:"account"`
+}
+
+//TokenInfo parses the latest IAM access token of the session, see IAMTokens,
+//logging in with Authenticate when the session has none yet. The token
+//signature is not verified, the claims are only meant to identify the caller.
+func (s *Session) TokenInfo() (*TokenInfo, error) {
+	accessToken, _ := s.IAMTokens()
+	if accessToken == "" && hasCredentials(s.Config) {
+		if err := s.Authenticate(); err != nil {
+			return nil, err
+		}
+		accessToken, _ = s.IAMTokens()
+	}
+	return ParseIAMToken(accessToken)
+}
+
+//ParseIAMToken returns the claims of an IAM access token, with or without the Bearer prefix
+func ParseIAMToken(token string) (*TokenInfo, error) {
+	token = strings.TrimSpace(token)
+	if len(token) > 7 && strings.EqualFold(token[:7], "bearer ") {
+		token = strings.TrimSpace(token[7:])
+	}
+	if token == "" {
+		return nil, bmxerror.New(ErrCodeInvalidIAMToken, "The session has no IAM access token")
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, bmxerror.New(ErrCodeInvalidIAMToken, "The IAM access token is not a JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, bmxerror.New(ErrCodeInvalidIAMToken, "The IAM access token payload can't be decoded: "+err.Error())
+	}
+	var claims iamTokenClaims
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return nil, bmxerror.New(ErrCodeInvalidIAMToken, "The IAM access token claims can't be parsed: "+err.Error())
+	}
+	return &TokenInfo{
+		AccountID:   claims.Account.BSS,
+		IAMID:       claims.IAMID,
+		Subject:     claims.Subject,
+		SubjectType: claims.SubjectType,
+		Email:       claims.Email,
+		Name:        claims.Name,
+		ClientID:    claims.ClientID,
+		GrantType:   claims.GrantType,
+		Scopes:      strings.Fields(claims.Scope),
+		IssuedAt:    time.Unix(claims.IssuedAt, 0),
+		Expiry:      time.Unix(claims.Expiry, 0),
+	}, nil
+}
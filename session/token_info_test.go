@@ -0,0 +1,137 @@
+package session_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM-Cloud/bluemix-go/authentication"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/bluemix-go/rest"
+	. "github.com/IBM-Cloud/bluemix-go/session"
+
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseIAMToken", func() {
+	token := func(claims string) string {
+		return "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(claims)) + ".c2lnbmF0dXJl"
+	}
+	claims := `{"iam_id": "IBMid-123", "sub": "user@ibm.com", "sub_type": "ServiceId", "email": "user@ibm.com", "name": "User",
+		"client_id": "bx", "grant_type": "urn:ibm:params:oauth:grant-type:apikey", "scope": "ibm openid",
+		"iat": 1700000000, "exp": 1700003600, "account": {"bss": "acc1"}}`
+
+	It("should return the claims of the token", func() {
+		info, err := ParseIAMToken(token(claims))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.AccountID).To(Equal("acc1"))
+		Expect(info.IAMID).To(Equal("IBMid-123"))
+		Expect(info.SubjectType).To(Equal("ServiceId"))
+		Expect(info.GrantType).To(Equal("urn:ibm:params:oauth:grant-type:apikey"))
+		Expect(info.Scopes).To(Equal([]string{"ibm", "openid"}))
+		Expect(info.IssuedAt).To(Equal(time.Unix(1700000000, 0)))
+		Expect(info.Expiry).To(Equal(time.Unix(1700003600, 0)))
+		Expect(info.Expired()).To(BeTrue())
+	})
+	It("should accept the Bearer prefix", func() {
+		info, err := ParseIAMToken("Bearer " + token(claims))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.AccountID).To(Equal("acc1"))
+		info, err = ParseIAMToken("bearer " + token(claims))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.AccountID).To(Equal("acc1"))
+	})
+	It("should report a token that is not expired", func() {
+		info, err := ParseIAMToken(token(`{"exp": 4102444800}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Expired()).To(BeFalse())
+		Expect(info.ExpiresIn()).To(BeNumerically(">", 0))
+	})
+	It("should reject malformed tokens", func() {
+		for _, t := range []string{"", "Bearer ", "not-a-jwt", "a.!!!.c", token("not json")} {
+			_, err := ParseIAMToken(t)
+			Expect(err).To(HaveOccurred())
+			Expect(err.(bmxerror.Error).Code()).To(Equal(ErrCodeInvalidIAMToken))
+		}
+	})
+})
+
+var _ = Describe("TokenInfo", func() {
+	var server *ghttp.Server
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+	tokenResponse := func(account string) string {
+		token := "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(`{"account": {"bss": "`+account+`"}}`)) + ".c2lnbmF0dXJl"
+		return fmt.Sprintf(`{"access_token": %q, "refresh_token": "refresh-%s", "token_type": "Bearer", "expiration": 4102444800}`, token, account)
+	}
+
+	It("should log in with the API key of the session", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/identity/token"),
+				ghttp.VerifyFormKV("apikey", "key"),
+				ghttp.RespondWith(http.StatusOK, tokenResponse("acc1")),
+			),
+		)
+		endpoint := server.URL()
+		sess, err := New(&bluemix.Config{BluemixAPIKey: "key", TokenProviderEndpoint: &endpoint})
+		Expect(err).NotTo(HaveOccurred())
+		info, err := sess.TokenInfo()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.AccountID).To(Equal("acc1"))
+		Expect(sess.Config.IAMAccessToken).To(HavePrefix("Bearer "))
+
+		_, err = sess.WithTarget("", "rg1", "").TokenInfo()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+	It("should return the tokens refreshed by the clients of the session", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/identity/token"),
+				ghttp.VerifyFormKV("apikey", "key"),
+				ghttp.RespondWith(http.StatusOK, tokenResponse("acc1")),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/identity/token"),
+				ghttp.VerifyFormKV("refresh_token", "refresh-acc1"),
+				ghttp.RespondWith(http.StatusOK, tokenResponse("acc2")),
+			),
+		)
+		endpoint := server.URL()
+		sess, err := New(&bluemix.Config{BluemixAPIKey: "key", TokenProviderEndpoint: &endpoint})
+		Expect(err).NotTo(HaveOccurred())
+
+		config := sess.Config.Copy()
+		iam, err := authentication.NewIAMAuthRepository(config, &rest.Client{HTTPClient: http.DefaultClient})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(authentication.PopulateTokens(iam, config)).To(Succeed())
+		info, err := sess.TokenInfo()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.AccountID).To(Equal("acc1"))
+
+		_, err = iam.RefreshToken()
+		Expect(err).NotTo(HaveOccurred())
+		info, err = sess.TokenInfo()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.AccountID).To(Equal("acc2"))
+		_, refreshToken := sess.IAMTokens()
+		Expect(refreshToken).To(Equal("refresh-acc2"))
+	})
+	It("should report a session without credentials", func() {
+		sess, err := New(&bluemix.Config{})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = sess.TokenInfo()
+		Expect(err).To(HaveOccurred())
+		Expect(err.(bmxerror.Error).Code()).To(Equal(ErrCodeInvalidIAMToken))
+	})
+})
@@ -0,0 +1,107 @@
+package bluemix
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+//CachedTokens are the IAM tokens stored in a TokenCache
+type CachedTokens struct {
+	IAMAccessToken  string    `json:"iam_access_token"`
+	IAMRefreshToken string    `json:"iam_refresh_token"`
+	Expiry          time.Time `json:"expiry"`
+}
+
+//TokenCache stores the IAM tokens obtained with an API key, so that sessions
+//reuse them instead of requesting new ones. The keys are hashes of the API key
+//and the IAM endpoint, never the API key itself.
+type TokenCache interface {
+	//Get returns false when the cache holds no tokens for the key
+	Get(key string) (CachedTokens, bool)
+	Set(key string, tokens CachedTokens) error
+}
+
+type memoryTokenCache struct {
+	mu     sync.Mutex
+	tokens map[string]CachedTokens
+}
+
+//NewMemoryTokenCache returns a cache keeping the tokens in memory, shared by
+//the sessions of the process using it
+func NewMemoryTokenCache() TokenCache {
+	return &memoryTokenCache{
+		tokens: map[string]CachedTokens{},
+	}
+}
+
+func (c *memoryTokenCache) Get(key string) (CachedTokens, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t, ok := c.tokens[key]
+	return t, ok
+}
+
+func (c *memoryTokenCache) Set(key string, tokens CachedTokens) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tokens[key] = tokens
+	return nil
+}
+
+type fileTokenCache struct {
+	dir string
+}
+
+//NewFileTokenCache returns a cache keeping the tokens in files only readable by
+//the user, so that they are reused across processes. When dir is empty the
+//files are kept under ~/.bluemix/iam_tokens.
+func NewFileTokenCache(dir string) (TokenCache, error) {
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		dir = filepath.Join(home, ".bluemix", "iam_tokens")
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &fileTokenCache{dir: dir}, nil
+}
+
+func (c *fileTokenCache) Get(key string) (CachedTokens, bool) {
+	var t CachedTokens
+	raw, err := ioutil.ReadFile(c.path(key))
+	if err != nil || json.Unmarshal(raw, &t) != nil {
+		return CachedTokens{}, false
+	}
+	return t, true
+}
+
+func (c *fileTokenCache) Set(key string, tokens CachedTokens) error {
+	raw, err := json.Marshal(tokens)
+	if err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(c.dir, key)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err = f.Write(raw); err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), c.path(key))
+}
+
+func (c *fileTokenCache) path(key string) string {
+	return filepath.Join(c.dir, filepath.Base(key)+".json")
+}
@@ -0,0 +1,15 @@
+package bluemix
+
+import "time"
+
+//TokenEvent describes the IAM tokens obtained by the SDK, see Config.OnTokenRefresh
+type TokenEvent struct {
+	//GrantType is the IAM grant used, such as "refresh_token" or
+	//"urn:ibm:params:oauth:grant-type:apikey", or "vpc-instance-identity" for
+	//the tokens of the VPC instance metadata service
+	GrantType    string
+	AccessToken  string
+	RefreshToken string
+	//Expiry is zero when the expiry of the token is unknown
+	Expiry time.Time
+}
@@ -0,0 +1,90 @@
+package trace
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+//Exchange is a single sanitized HTTP request/response pair kept by a Recorder
+type Exchange struct {
+	Method        string
+	URL           string
+	StatusCode    int
+	TransactionID string
+	Start         time.Time
+	Duration      time.Duration
+	Request       string
+	Response      string
+	Error         string
+}
+
+//Recorder keeps the last N HTTP exchanges in memory so that recent SDK
+//traffic can be dumped for support when an operation fails. It is safe for
+//concurrent use.
+type Recorder struct {
+	mu      sync.Mutex
+	entries []Exchange
+	next    int
+	full    bool
+}
+
+//NewRecorder returns a Recorder holding at most size exchanges
+func NewRecorder(size int) *Recorder {
+	if size < 1 {
+		size = 1
+	}
+	return &Recorder{
+		entries: make([]Exchange, size),
+	}
+}
+
+//Record adds e to the recorder, evicting the oldest exchange when full
+func (r *Recorder) Record(e Exchange) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries[r.next] = e
+	r.next = (r.next + 1) % len(r.entries)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+//Exchanges returns the recorded exchanges, oldest first
+func (r *Recorder) Exchanges() []Exchange {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.full {
+		return append([]Exchange(nil), r.entries[:r.next]...)
+	}
+	out := make([]Exchange, 0, len(r.entries))
+	out = append(out, r.entries[r.next:]...)
+	return append(out, r.entries[:r.next]...)
+}
+
+//Reset drops all recorded exchanges
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = make([]Exchange, len(r.entries))
+	r.next = 0
+	r.full = false
+}
+
+//Dump writes the recorded exchanges to w in a human readable form
+func (r *Recorder) Dump(w io.Writer) error {
+	for _, e := range r.Exchanges() {
+		_, err := fmt.Fprintf(w, "%s %s %s -> %d (%.0fms) Transaction-Id: %s\n",
+			e.Start.Format(time.RFC3339), e.Method, e.URL, e.StatusCode,
+			e.Duration.Seconds()*1000, e.TransactionID)
+		if err != nil {
+			return err
+		}
+		if e.Error != "" {
+			fmt.Fprintf(w, "ERROR: %s\n", e.Error)
+		}
+		fmt.Fprintf(w, "REQUEST:\n%s\nRESPONSE:\n%s\n\n", e.Request, e.Response)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package trace_test
+
+import (
+	"bytes"
+
+	. "github.com/IBM-Cloud/bluemix-go/trace"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Recorder", func() {
+	urls := func(exchanges []Exchange) []string {
+		out := []string{}
+		for _, e := range exchanges {
+			out = append(out, e.URL)
+		}
+		return out
+	}
+
+	It("should return the exchanges oldest first", func() {
+		r := NewRecorder(3)
+		r.Record(Exchange{URL: "/1"})
+		r.Record(Exchange{URL: "/2"})
+		Expect(urls(r.Exchanges())).To(Equal([]string{"/1", "/2"}))
+	})
+	It("should evict the oldest exchanges when full", func() {
+		r := NewRecorder(3)
+		for _, u := range []string{"/1", "/2", "/3", "/4", "/5"} {
+			r.Record(Exchange{URL: u})
+		}
+		Expect(urls(r.Exchanges())).To(Equal([]string{"/3", "/4", "/5"}))
+	})
+	It("should keep the order when wrapping exactly at the end", func() {
+		r := NewRecorder(2)
+		for _, u := range []string{"/1", "/2", "/3", "/4"} {
+			r.Record(Exchange{URL: u})
+		}
+		Expect(urls(r.Exchanges())).To(Equal([]string{"/3", "/4"}))
+	})
+	It("should drop every exchange on Reset", func() {
+		r := NewRecorder(2)
+		r.Record(Exchange{URL: "/1"})
+		r.Record(Exchange{URL: "/2"})
+		r.Record(Exchange{URL: "/3"})
+		r.Reset()
+		Expect(r.Exchanges()).To(BeEmpty())
+		r.Record(Exchange{URL: "/4"})
+		Expect(urls(r.Exchanges())).To(Equal([]string{"/4"}))
+	})
+	It("should dump the exchanges", func() {
+		r := NewRecorder(2)
+		r.Record(Exchange{Method: "GET", URL: "/v2/getCluster", StatusCode: 404, TransactionID: "tx-1", Error: "not found"})
+		var buf bytes.Buffer
+		Expect(r.Dump(&buf)).To(Succeed())
+		Expect(buf.String()).To(ContainSubstring("GET /v2/getCluster -> 404"))
+		Expect(buf.String()).To(ContainSubstring("Transaction-Id: tx-1"))
+		Expect(buf.String()).To(ContainSubstring("ERROR: not found"))
+	})
+})
@@ -0,0 +1,58 @@
+package trace
+
+import (
+	"fmt"
+	"strings"
+)
+
+//StructuredLogger is a leveled logger taking key/value pairs after the
+//message. A *slog.Logger implements it as is, a logr.Logger needs a small
+//adapter mapping Debug to V(1).Info and Warn to Info.
+type StructuredLogger interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+}
+
+//For returns l, or when l is nil a logger printing to the global Logger in
+//the format of the SDK traces, e.g. [WARN] message key=value
+func For(l StructuredLogger) StructuredLogger {
+	if l == nil {
+		return printerLogger{}
+	}
+	return l
+}
+
+//printerLogger prints to the global Logger of the moment of the call, so
+//that it follows the trace settings of the sessions
+type printerLogger struct{}
+
+func (printerLogger) Debug(msg string, keysAndValues ...interface{}) {
+	printEntry("DEBUG", msg, keysAndValues)
+}
+
+func (printerLogger) Info(msg string, keysAndValues ...interface{}) {
+	printEntry("INFO", msg, keysAndValues)
+}
+
+func (printerLogger) Warn(msg string, keysAndValues ...interface{}) {
+	printEntry("WARN", msg, keysAndValues)
+}
+
+func (printerLogger) Error(msg string, keysAndValues ...interface{}) {
+	printEntry("ERROR", msg, keysAndValues)
+}
+
+func printEntry(level, msg string, keysAndValues []interface{}) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "[%s] %s", level, msg)
+	for i := 0; i < len(keysAndValues); i += 2 {
+		if i+1 < len(keysAndValues) {
+			fmt.Fprintf(&b, " %v=%v", keysAndValues[i], keysAndValues[i+1])
+		} else {
+			fmt.Fprintf(&b, " %v", keysAndValues[i])
+		}
+	}
+	Logger.Println(b.String())
+}
@@ -0,0 +1,70 @@
+package trace_test
+
+import (
+	"fmt"
+
+	. "github.com/IBM-Cloud/bluemix-go/trace"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type linePrinter struct {
+	lines []string
+}
+
+func (p *linePrinter) Print(v ...interface{}) {
+	p.lines = append(p.lines, fmt.Sprint(v...))
+}
+
+func (p *linePrinter) Printf(format string, v ...interface{}) {
+	p.lines = append(p.lines, fmt.Sprintf(format, v...))
+}
+
+func (p *linePrinter) Println(v ...interface{}) {
+	p.lines = append(p.lines, fmt.Sprint(v...))
+}
+
+type recordingLogger struct {
+	entries []string
+}
+
+func (l *recordingLogger) Debug(msg string, kv ...interface{}) {
+	l.entries = append(l.entries, "debug "+msg)
+}
+
+func (l *recordingLogger) Info(msg string, kv ...interface{}) {
+	l.entries = append(l.entries, "info "+msg)
+}
+
+func (l *recordingLogger) Warn(msg string, kv ...interface{}) {
+	l.entries = append(l.entries, "warn "+msg)
+}
+
+func (l *recordingLogger) Error(msg string, kv ...interface{}) {
+	l.entries = append(l.entries, "error "+msg)
+}
+
+var _ = Describe("For", func() {
+	var saved Printer
+	var printer *linePrinter
+	BeforeEach(func() {
+		saved = Logger
+		printer = &linePrinter{}
+		Logger = printer
+	})
+	AfterEach(func() {
+		Logger = saved
+	})
+
+	It("should print to the global logger when no logger is given", func() {
+		For(nil).Warn("Request failed, retrying", "delay", "1s", "odd")
+		Expect(printer.lines).To(Equal([]string{"[WARN] Request failed, retrying delay=1s odd"}))
+	})
+	It("should return the given logger", func() {
+		l := &recordingLogger{}
+		For(l).Info("hello")
+		Expect(l.entries).To(Equal([]string{"info hello"}))
+		Expect(printer.lines).To(BeEmpty())
+	})
+})